@@ -9,6 +9,7 @@ import (
 	"maps"
 	"net/http"
 	"os"
+	"slices"
 	"strings"
 	"sync"
 
@@ -32,14 +33,18 @@ type AWSClient struct {
 	Region            string
 	ServicePackages   map[string]ServicePackage
 
+	assumeRoleARN             string // From provider configuration.
 	awsConfig                 *aws.Config
 	clients                   map[string]any
 	conns                     map[string]any
+	defaultTimeouts           []DefaultTimeouts // From provider configuration.
 	endpoints                 map[string]string // From provider configuration.
 	httpClient                *http.Client
 	lock                      sync.Mutex
 	logger                    baselogging.Logger
 	partition                 endpoints.Partition
+	preventReplacementOf      []string // From provider configuration.
+	profile                   string   // From provider configuration.
 	session                   *session_sdkv1.Session
 	s3ExpressClient           *s3.Client
 	s3UsePathStyle            bool   // From provider configuration.
@@ -138,6 +143,21 @@ func (c *AWSClient) S3ExpressClient(ctx context.Context) *s3.Client {
 	return c.s3ExpressClient
 }
 
+// AssumeRoleARN returns the ARN of the last role assumed via the provider's assume_role configuration, if any.
+func (c *AWSClient) AssumeRoleARN(context.Context) string {
+	return c.assumeRoleARN
+}
+
+// PreventsReplacementOf returns whether the provider configuration prevents replacement of resources of the specified type.
+func (c *AWSClient) PreventsReplacementOf(_ context.Context, typeName string) bool {
+	return slices.Contains(c.preventReplacementOf, typeName)
+}
+
+// Profile returns the shared configuration profile from the provider configuration, if any.
+func (c *AWSClient) Profile(context.Context) string {
+	return c.profile
+}
+
 // S3UsePathStyle returns the s3_force_path_style provider configuration value.
 func (c *AWSClient) S3UsePathStyle(context.Context) bool {
 	return c.s3UsePathStyle
@@ -33,6 +33,7 @@ type Config struct {
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
+	DefaultTimeouts                []DefaultTimeouts
 	EC2MetadataServiceEnableState  imds.ClientEnableState
 	EC2MetadataServiceEndpoint     string
 	EC2MetadataServiceEndpointMode string
@@ -44,6 +45,7 @@ type Config struct {
 	Insecure                       bool
 	MaxRetries                     int
 	NoProxy                        string
+	PreventReplacementOf           []string
 	Profile                        string
 	Region                         string
 	RetryMode                      aws.RetryMode
@@ -208,7 +210,11 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	}
 
 	client.AccountID = accountID
+	if n := len(c.AssumeRole); n > 0 {
+		client.assumeRoleARN = c.AssumeRole[n-1].RoleARN
+	}
 	client.defaultTagsConfig = c.DefaultTagsConfig
+	client.defaultTimeouts = c.DefaultTimeouts
 	client.ignoreTagsConfig = c.IgnoreTagsConfig
 	client.Region = c.Region
 	client.SetHTTPClient(ctx, session.Config.HTTPClient) // Must be called while client.Session is nil.
@@ -220,6 +226,8 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.conns = make(map[string]any, 0)
 	client.endpoints = c.Endpoints
 	client.logger = logger
+	client.preventReplacementOf = c.PreventReplacementOf
+	client.profile = c.Profile
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
 	client.stsRegion = c.STSRegion
@@ -0,0 +1,174 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package connstest contains helpers for unit testing resources' CRUD logic without network access.
+//
+// A Fake serves AWS API operations from a table of handlers. An AWSClient created from a Fake sends every
+// AWS SDK for Go v2 API call to the Fake, and a resource's lifecycle can then be run in-process
+// using SDKv2Resource or FrameworkResource.
+package connstest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+const (
+	// AccountID is the AWS account ID of AWSClients created from a Fake.
+	AccountID = "123456789012"
+	// Region is the AWS Region of AWSClients created from a Fake.
+	Region = "us-west-2" //lintignore:AWSAT003
+)
+
+// Call is an AWS API operation call received by a Fake.
+type Call struct {
+	ServiceID     string
+	OperationName string
+	Input         any
+}
+
+type handler func(context.Context, any) (any, error)
+
+// Fake is a fake AWS API. Operations are served by handlers registered using Handle.
+// Calls to operations without a handler fail.
+type Fake struct {
+	calls    []Call
+	handlers map[string]handler
+	mu       sync.Mutex
+}
+
+// NewFake returns a new Fake with no handlers.
+func NewFake() *Fake {
+	return &Fake{
+		handlers: make(map[string]handler),
+	}
+}
+
+// Handle registers the handler for the specified AWS API operation, replacing any existing handler.
+// The service ID is the AWS SDK for Go v2 service's ServiceID, e.g. "SQS", and the operation name is
+// the API client method's name, e.g. "CreateQueue".
+// The handler must return a non-nil output if it does not return an error.
+func Handle[I, O any](f *Fake, serviceID, operationName string, h func(context.Context, I) (O, error)) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.handlers[handlerKey(serviceID, operationName)] = func(ctx context.Context, input any) (any, error) {
+		v, ok := input.(I)
+		if !ok {
+			var zero I
+			return nil, fmt.Errorf("%s %s input: %T, want %T", serviceID, operationName, input, zero)
+		}
+
+		return h(ctx, v)
+	}
+}
+
+// Calls returns the AWS API operation calls received so far.
+func (f *Fake) Calls() []Call {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]Call(nil), f.calls...)
+}
+
+// CallCount returns the number of calls received so far to the specified AWS API operation.
+func (f *Fake) CallCount(serviceID, operationName string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	n := 0
+	for _, call := range f.calls {
+		if call.ServiceID == serviceID && call.OperationName == operationName {
+			n++
+		}
+	}
+
+	return n
+}
+
+// Config returns an AWS SDK for Go v2 configuration whose API clients send all calls to the Fake.
+// Requests that reach the HTTP client, which should never happen, fail.
+func (f *Fake) Config() aws.Config {
+	return aws.Config{
+		APIOptions:  []func(*middleware.Stack) error{f.addMiddleware},
+		Credentials: aws.AnonymousCredentials{},
+		HTTPClient: &http.Client{
+			Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+				return nil, fmt.Errorf("unexpected HTTP request: %s %s", r.Method, r.URL)
+			}),
+		},
+		Region: Region,
+	}
+}
+
+// AWSClient returns an AWSClient whose AWS SDK for Go v2 API clients for the specified service packages send all calls to the Fake.
+func (f *Fake) AWSClient(ctx context.Context, servicePackages ...conns.ServicePackage) *conns.AWSClient {
+	return conns.NewAWSClientForTesting(ctx, AccountID, Region, f.Config(), servicePackages...)
+}
+
+func (f *Fake) addMiddleware(stack *middleware.Stack) error {
+	// Added last in the Initialize step, after input validation and before serialization.
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("connstest.Fake", func(ctx context.Context, in middleware.InitializeInput, _ middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+		var metadata middleware.Metadata
+
+		output, err := f.call(ctx, awsmiddleware.GetServiceID(ctx), awsmiddleware.GetOperationName(ctx), in.Parameters)
+		if err != nil {
+			return middleware.InitializeOutput{}, metadata, err
+		}
+
+		return middleware.InitializeOutput{Result: output}, metadata, nil
+	}), middleware.After)
+}
+
+func (f *Fake) call(ctx context.Context, serviceID, operationName string, input any) (any, error) {
+	f.mu.Lock()
+	f.calls = append(f.calls, Call{
+		ServiceID:     serviceID,
+		OperationName: operationName,
+		Input:         input,
+	})
+	h, ok := f.handlers[handlerKey(serviceID, operationName)]
+	f.mu.Unlock()
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected call: %s %s", serviceID, operationName)
+	}
+
+	output, err := h(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	if v := reflect.ValueOf(output); !v.IsValid() || (v.Kind() == reflect.Pointer && v.IsNil()) {
+		return nil, fmt.Errorf("%s %s handler returned no output", serviceID, operationName)
+	}
+
+	return output, nil
+}
+
+// APIError returns an AWS API error with the specified code and message.
+func APIError(code, message string) error {
+	return &smithy.GenericAPIError{
+		Code:    code,
+		Message: message,
+	}
+}
+
+func handlerKey(serviceID, operationName string) string {
+	return serviceID + "." + operationName
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
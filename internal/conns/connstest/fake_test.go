@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connstest_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns/connstest"
+	tfsqs "github.com/hashicorp/terraform-provider-aws/internal/service/sqs"
+)
+
+func TestFake(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fake := connstest.NewFake()
+	connstest.Handle(fake, sqs.ServiceID, "GetQueueUrl", func(_ context.Context, input *sqs.GetQueueUrlInput) (*sqs.GetQueueUrlOutput, error) {
+		switch name := aws.ToString(input.QueueName); name {
+		case "test":
+			return &sqs.GetQueueUrlOutput{QueueUrl: aws.String("https://example.com/" + name)}, nil
+		case "nil":
+			return nil, nil
+		default:
+			return nil, connstest.APIError("AWS.SimpleQueueService.NonExistentQueue", "The specified queue does not exist.")
+		}
+	})
+	conn := fake.AWSClient(ctx, tfsqs.ServicePackage(ctx)).SQSClient(ctx)
+
+	output, err := conn.GetQueueUrl(ctx, &sqs.GetQueueUrlInput{QueueName: aws.String("test")})
+	if err != nil {
+		t.Fatalf("GetQueueUrl: %s", err)
+	}
+	if got, want := aws.ToString(output.QueueUrl), "https://example.com/test"; got != want {
+		t.Errorf("QueueUrl = %q, want %q", got, want)
+	}
+
+	if _, err := conn.GetQueueUrl(ctx, &sqs.GetQueueUrlInput{QueueName: aws.String("missing")}); !tfawserr.ErrCodeEquals(err, "AWS.SimpleQueueService.NonExistentQueue") {
+		t.Errorf("GetQueueUrl: got error %v, want NonExistentQueue", err)
+	}
+
+	if _, err := conn.GetQueueUrl(ctx, &sqs.GetQueueUrlInput{QueueName: aws.String("nil")}); err == nil {
+		t.Error("GetQueueUrl with no output: expected error")
+	}
+
+	if _, err := conn.ListQueues(ctx, &sqs.ListQueuesInput{}); err == nil {
+		t.Error("ListQueues without handler: expected error")
+	}
+
+	if _, err := conn.GetQueueUrl(ctx, &sqs.GetQueueUrlInput{}); err == nil {
+		t.Error("GetQueueUrl with invalid input: expected error")
+	}
+
+	if got, want := fake.CallCount(sqs.ServiceID, "GetQueueUrl"), 3; got != want {
+		t.Errorf("GetQueueUrl calls = %d, want %d", got, want)
+	}
+	if got, want := len(fake.Calls()), 4; got != want {
+		t.Errorf("calls = %d, want %d", got, want)
+	}
+}
@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connstest
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// FrameworkResource runs a Plugin Framework resource's lifecycle in-process.
+// The resource's methods are called directly, so provider interceptors such as transparent tagging
+// and attribute plan modifiers are not run.
+type FrameworkResource struct {
+	resource resource.Resource
+	schema   schema.Schema
+	state    *tfsdk.State
+}
+
+// NewFrameworkResource returns a FrameworkResource for the specified resource, initially with no state.
+// The resource is configured with the specified AWSClient.
+func NewFrameworkResource(ctx context.Context, r resource.Resource, meta *conns.AWSClient) (*FrameworkResource, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v, ok := r.(resource.ResourceWithConfigure); ok {
+		response := resource.ConfigureResponse{}
+		v.Configure(ctx, resource.ConfigureRequest{ProviderData: meta}, &response)
+		diags.Append(response.Diagnostics...)
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	response := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &response)
+	diags.Append(response.Diagnostics...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return &FrameworkResource{
+		resource: r,
+		schema:   response.Schema,
+	}, diags
+}
+
+// Apply plans and applies the specified configuration, in the form of JSON-compatible values,
+// creating the resource if it has no state and otherwise updating it.
+// When planning, null Computed attributes are unknown on create and keep their prior state values on update.
+func (r *FrameworkResource) Apply(ctx context.Context, config map[string]any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	b, err := json.Marshal(config)
+	if err != nil {
+		diags.AddError("encoding configuration", err.Error())
+		return diags
+	}
+
+	configVal, err := tftypes.ValueFromJSONWithOpts(b, r.schema.Type().TerraformType(ctx), tftypes.ValueFromJSONOpts{})
+	if err != nil {
+		diags.AddError("decoding configuration", err.Error())
+		return diags
+	}
+
+	planVal, err := r.proposedNewState(ctx, configVal)
+	if err != nil {
+		diags.AddError("planning", err.Error())
+		return diags
+	}
+
+	tfConfig := tfsdk.Config{Schema: r.schema, Raw: configVal}
+	plan := tfsdk.Plan{Schema: r.schema, Raw: planVal}
+	state := r.nullState(ctx)
+	if r.state != nil {
+		state = *r.state
+	}
+
+	if v, ok := r.resource.(resource.ResourceWithModifyPlan); ok {
+		response := resource.ModifyPlanResponse{Plan: plan}
+		v.ModifyPlan(ctx, resource.ModifyPlanRequest{Config: tfConfig, Plan: plan, State: state}, &response)
+		diags.Append(response.Diagnostics...)
+		if diags.HasError() {
+			return diags
+		}
+		plan = response.Plan
+	}
+
+	if r.state == nil {
+		response := resource.CreateResponse{State: r.nullState(ctx)}
+		r.resource.Create(ctx, resource.CreateRequest{Config: tfConfig, Plan: plan}, &response)
+		diags.Append(response.Diagnostics...)
+		if !response.State.Raw.IsNull() {
+			r.state = &response.State
+		}
+
+		return diags
+	}
+
+	response := resource.UpdateResponse{State: state}
+	r.resource.Update(ctx, resource.UpdateRequest{Config: tfConfig, Plan: plan, State: state}, &response)
+	diags.Append(response.Diagnostics...)
+	r.state = &response.State
+
+	return diags
+}
+
+// Read refreshes the resource's state. The state is removed if the resource no longer exists.
+func (r *FrameworkResource) Read(ctx context.Context) diag.Diagnostics {
+	if r.state == nil {
+		return nil
+	}
+
+	response := resource.ReadResponse{State: *r.state}
+	r.resource.Read(ctx, resource.ReadRequest{State: *r.state}, &response)
+	if response.Diagnostics.HasError() {
+		return response.Diagnostics
+	}
+
+	if response.State.Raw.IsNull() {
+		r.state = nil
+	} else {
+		r.state = &response.State
+	}
+
+	return response.Diagnostics
+}
+
+// Destroy deletes the resource and removes its state.
+func (r *FrameworkResource) Destroy(ctx context.Context) diag.Diagnostics {
+	if r.state == nil {
+		return nil
+	}
+
+	response := resource.DeleteResponse{State: *r.state}
+	r.resource.Delete(ctx, resource.DeleteRequest{State: *r.state}, &response)
+	if !response.Diagnostics.HasError() {
+		r.state = nil
+	}
+
+	return response.Diagnostics
+}
+
+// Exists returns whether the resource has state.
+func (r *FrameworkResource) Exists() bool {
+	return r.state != nil
+}
+
+// Get reads the resource's state into the specified target, e.g. the resource's model.
+func (r *FrameworkResource) Get(ctx context.Context, target any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if r.state == nil {
+		diags.AddError("reading state", "resource has no state")
+		return diags
+	}
+
+	return r.state.Get(ctx, target)
+}
+
+func (r *FrameworkResource) nullState(ctx context.Context) tfsdk.State {
+	return tfsdk.State{
+		Schema: r.schema,
+		Raw:    tftypes.NewValue(r.schema.Type().TerraformType(ctx), nil),
+	}
+}
+
+// proposedNewState returns the planned new state for the specified configuration, without running plan modifiers.
+func (r *FrameworkResource) proposedNewState(ctx context.Context, configVal tftypes.Value) (tftypes.Value, error) {
+	return tftypes.Transform(configVal, func(p *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+		if !v.IsNull() {
+			return v, nil
+		}
+
+		attribute, err := r.schema.AttributeAtTerraformPath(ctx, p)
+		if err != nil || !attribute.IsComputed() {
+			// Not an attribute, e.g. a block.
+			return v, nil
+		}
+
+		if r.state != nil {
+			if prior, _, err := tftypes.WalkAttributePath(r.state.Raw, p); err == nil {
+				if prior, ok := prior.(tftypes.Value); ok {
+					return prior, nil
+				}
+			}
+		}
+
+		return tftypes.NewValue(v.Type(), tftypes.UnknownValue), nil
+	})
+}
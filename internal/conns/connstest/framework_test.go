@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connstest_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns/connstest"
+)
+
+func TestFrameworkResource(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	things := make(map[string]string)
+	r, diags := connstest.NewFrameworkResource(ctx, &thingResource{things: things}, connstest.NewFake().AWSClient(ctx))
+	if diags.HasError() {
+		t.Fatalf("NewFrameworkResource: %v", diags)
+	}
+
+	if diags := r.Apply(ctx, map[string]any{"name": "test", "value": "one"}); diags.HasError() {
+		t.Fatalf("creating: %v", diags)
+	}
+
+	var data thingResourceModel
+	if diags := r.Get(ctx, &data); diags.HasError() {
+		t.Fatalf("reading state: %v", diags)
+	}
+	if got, want := data.ID.ValueString(), "thing-test"; got != want {
+		t.Errorf("id = %q, want %q", got, want)
+	}
+
+	if diags := r.Apply(ctx, map[string]any{"name": "test", "value": "two"}); diags.HasError() {
+		t.Fatalf("updating: %v", diags)
+	}
+	if got, want := things["thing-test"], "two"; got != want {
+		t.Errorf("value = %q, want %q", got, want)
+	}
+
+	delete(things, "thing-test")
+	if diags := r.Read(ctx); diags.HasError() {
+		t.Fatalf("reading: %v", diags)
+	}
+	if r.Exists() {
+		t.Error("state not removed")
+	}
+}
+
+// thingResource is a resource that stores its value in a map.
+type thingResource struct {
+	things map[string]string
+}
+
+type thingResourceModel struct {
+	ID    types.String `tfsdk:"id"`
+	Name  types.String `tfsdk:"name"`
+	Value types.String `tfsdk:"value"`
+}
+
+func (r *thingResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_thing"
+}
+
+func (r *thingResource) Schema(_ context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":    schema.StringAttribute{Computed: true},
+			"name":  schema.StringAttribute{Required: true},
+			"value": schema.StringAttribute{Required: true},
+		},
+	}
+}
+
+func (r *thingResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data thingResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.ID.IsUnknown() {
+		response.Diagnostics.AddError("planning", "id is not unknown")
+		return
+	}
+
+	data.ID = types.StringValue("thing-" + data.Name.ValueString())
+	r.things[data.ID.ValueString()] = data.Value.ValueString()
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *thingResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data thingResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	v, ok := r.things[data.ID.ValueString()]
+	if !ok {
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Value = types.StringValue(v)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *thingResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var data thingResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	r.things[data.ID.ValueString()] = data.Value.ValueString()
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *thingResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data thingResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	delete(r.things, data.ID.ValueString())
+}
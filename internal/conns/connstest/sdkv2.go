@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connstest
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+
+	"github.com/hashicorp/go-cty/cty"
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// SDKv2Resource runs a Plugin SDKv2 resource's lifecycle in-process.
+// The resource's CRUD handlers are called directly, so provider interceptors such as transparent tagging are not run.
+type SDKv2Resource struct {
+	meta     *conns.AWSClient
+	resource *schema.Resource
+	state    *terraform.InstanceState
+}
+
+// NewSDKv2Resource returns an SDKv2Resource for the specified resource, initially with no state.
+func NewSDKv2Resource(r *schema.Resource, meta *conns.AWSClient) *SDKv2Resource {
+	return &SDKv2Resource{
+		meta:     meta,
+		resource: r,
+	}
+}
+
+// Apply plans and applies the specified configuration, in the form of JSON-compatible values,
+// creating the resource if it has no state and otherwise updating or replacing it.
+func (r *SDKv2Resource) Apply(ctx context.Context, config map[string]any) diag.Diagnostics {
+	ty := r.resource.CoreConfigSchema().ImpliedType()
+
+	configVal, err := objectValue(config, ty)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	priorStateVal, err := r.stateValue(ty)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	priorState, err := r.resource.ShimInstanceStateFromValue(priorStateVal)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	priorState.RawConfig = configVal
+	priorState.RawPlan = configVal
+	priorState.RawState = priorStateVal
+
+	diff, err := r.resource.Diff(ctx, priorState, terraform.NewResourceConfigShimmed(configVal, r.resource.CoreConfigSchema()), r.meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if diff == nil || diff.Empty() {
+		return nil
+	}
+
+	state, diags := r.resource.Apply(ctx, priorState, diff, r.meta)
+	if state != nil || !diags.HasError() {
+		r.state = state
+	}
+
+	return diags
+}
+
+// Read refreshes the resource's state. The state is removed if the resource no longer exists.
+func (r *SDKv2Resource) Read(ctx context.Context) diag.Diagnostics {
+	if r.state == nil {
+		return nil
+	}
+
+	stateVal, err := r.stateValue(r.resource.CoreConfigSchema().ImpliedType())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	r.state.RawState = stateVal
+
+	state, diags := r.resource.RefreshWithoutUpgrade(ctx, r.state, r.meta)
+	if !diags.HasError() {
+		r.state = state
+	}
+
+	return diags
+}
+
+// Destroy deletes the resource and removes its state.
+func (r *SDKv2Resource) Destroy(ctx context.Context) diag.Diagnostics {
+	if r.state == nil {
+		return nil
+	}
+
+	_, diags := r.resource.Apply(ctx, r.state, &terraform.InstanceDiff{Destroy: true}, r.meta)
+	if !diags.HasError() {
+		r.state = nil
+	}
+
+	return diags
+}
+
+// Exists returns whether the resource has state.
+func (r *SDKv2Resource) Exists() bool {
+	return r.state != nil && r.state.ID != ""
+}
+
+// ID returns the resource's ID, or the empty string if the resource has no state.
+func (r *SDKv2Resource) ID() string {
+	if r.state == nil {
+		return ""
+	}
+
+	return r.state.ID
+}
+
+// Attributes returns the resource's state in flatmap form, e.g. "tags.%" and "tags.Name".
+func (r *SDKv2Resource) Attributes() map[string]string {
+	if r.state == nil {
+		return nil
+	}
+
+	return maps.Clone(r.state.Attributes)
+}
+
+func (r *SDKv2Resource) stateValue(ty cty.Type) (cty.Value, error) {
+	if r.state == nil {
+		return cty.NullVal(ty), nil
+	}
+
+	return r.state.AttrsAsObjectValue(ty)
+}
+
+// objectValue returns the value of the specified type of a map of JSON-compatible values.
+// Attributes not in the map are null.
+func objectValue(m map[string]any, ty cty.Type) (cty.Value, error) {
+	b, err := json.Marshal(m)
+	if err != nil {
+		return cty.NilVal, err
+	}
+
+	return ctyjson.Unmarshal(b, ty)
+}
@@ -4,6 +4,11 @@
 package conns
 
 import (
+	"context"
+	"net/http"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
@@ -16,3 +21,40 @@ func SetDefaultTagsConfig(client *AWSClient, d *tftags.DefaultConfig) {
 func SetIgnoreTagsConfig(client *AWSClient, i *tftags.IgnoreConfig) {
 	client.ignoreTagsConfig = i
 }
+
+// NewAWSClientForTesting returns an AWSClient for the specified account and Region whose AWS SDK for Go v2 API clients
+// are created from the specified configuration, e.g. one whose APIOptions serve canned responses.
+// It is only intended for use in tests
+func NewAWSClientForTesting(_ context.Context, accountID, region string, cfg aws.Config, servicePackages ...ServicePackage) *AWSClient {
+	client := &AWSClient{
+		AccountID:       accountID,
+		Region:          region,
+		ServicePackages: make(map[string]ServicePackage, len(servicePackages)),
+		awsConfig:       &cfg,
+		clients:         make(map[string]any, 0),
+		conns:           make(map[string]any, 0),
+	}
+
+	if v, ok := cfg.HTTPClient.(*http.Client); ok {
+		client.httpClient = v
+	}
+
+	if partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region); ok {
+		client.partition = partition
+	}
+
+	for _, sp := range servicePackages {
+		client.ServicePackages[sp.ServicePackageName()] = sp
+	}
+
+	return client
+}
+
+// SetClient sets the AWS SDK for Go v2 API client for the specified service package, e.g. one that sends requests to a fake.
+// It is only intended for use in tests
+func SetClient(client *AWSClient, servicePackageName string, v any) {
+	client.lock.Lock()
+	defer client.lock.Unlock()
+
+	client.clients[servicePackageName] = v
+}
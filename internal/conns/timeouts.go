@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"path"
+	"time"
+)
+
+// DefaultTimeouts represents a `default_timeouts` provider configuration block.
+// Zero durations are not set.
+type DefaultTimeouts struct {
+	ResourceType string // May contain wildcards, e.g. "aws_rds_*".
+	Create       time.Duration
+	Read         time.Duration
+	Update       time.Duration
+	Delete       time.Duration
+}
+
+// Matches returns whether the timeouts apply to the specified resource type.
+func (t DefaultTimeouts) Matches(typeName string) bool {
+	ok, err := path.Match(t.ResourceType, typeName)
+
+	return err == nil && ok
+}
+
+// ValidateResourceTypePattern returns an error if the specified `default_timeouts` resource_type pattern is malformed.
+func ValidateResourceTypePattern(pattern string) error {
+	_, err := path.Match(pattern, "")
+
+	return err
+}
+
+// DefaultTimeouts returns the default operation timeouts from the provider configuration for the specified resource type.
+// Where more than one `default_timeouts` block matches, each timeout is taken from the block with the longest
+// (most specific) `resource_type` that sets it.
+func (c *AWSClient) DefaultTimeouts(_ context.Context, typeName string) DefaultTimeouts {
+	timeouts := DefaultTimeouts{ResourceType: typeName}
+	var createLen, readLen, updateLen, deleteLen int // Length of the matching resource_type.
+
+	for _, v := range c.defaultTimeouts {
+		if !v.Matches(typeName) {
+			continue
+		}
+
+		n := len(v.ResourceType)
+		if v.Create > 0 && n > createLen {
+			timeouts.Create, createLen = v.Create, n
+		}
+		if v.Read > 0 && n > readLen {
+			timeouts.Read, readLen = v.Read, n
+		}
+		if v.Update > 0 && n > updateLen {
+			timeouts.Update, updateLen = v.Update, n
+		}
+		if v.Delete > 0 && n > deleteLen {
+			timeouts.Delete, deleteLen = v.Delete, n
+		}
+	}
+
+	return timeouts
+}
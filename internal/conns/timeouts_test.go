@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestAWSClientDefaultTimeouts(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	client := &AWSClient{
+		defaultTimeouts: []DefaultTimeouts{
+			{ResourceType: "*", Delete: 10 * time.Minute},
+			{ResourceType: "aws_rds_*", Create: time.Hour, Delete: time.Hour},
+			{ResourceType: "aws_rds_cluster", Create: 2 * time.Hour},
+			{ResourceType: "aws_rds_cluster_*", Update: 3 * time.Hour},
+		},
+	}
+
+	testCases := map[string]struct {
+		typeName string
+		want     DefaultTimeouts
+	}{
+		"exact": {
+			typeName: "aws_rds_cluster",
+			want:     DefaultTimeouts{ResourceType: "aws_rds_cluster", Create: 2 * time.Hour, Delete: time.Hour},
+		},
+		"prefix wildcard": {
+			typeName: "aws_rds_cluster_instance",
+			want:     DefaultTimeouts{ResourceType: "aws_rds_cluster_instance", Create: time.Hour, Update: 3 * time.Hour, Delete: time.Hour},
+		},
+		"wildcard": {
+			typeName: "aws_db_instance",
+			want:     DefaultTimeouts{ResourceType: "aws_db_instance", Delete: 10 * time.Minute},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := client.DefaultTimeouts(context.Background(), testCase.typeName), testCase.want; got != want {
+				t.Errorf("DefaultTimeouts(%q) = %+v, want %+v", testCase.typeName, got, want)
+			}
+		})
+	}
+}
+
+func TestAWSClientDefaultTimeouts_none(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	client := &AWSClient{}
+
+	if got, want := client.DefaultTimeouts(context.Background(), "aws_s3_bucket"), (DefaultTimeouts{ResourceType: "aws_s3_bucket"}); got != want {
+		t.Errorf("DefaultTimeouts() = %+v, want %+v", got, want)
+	}
+}
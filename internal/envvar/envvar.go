@@ -84,6 +84,12 @@ const (
 	AssumeRoleSessionName = "TF_AWS_ASSUME_ROLE_SESSION_NAME"
 )
 
+// Custom environment variables used for selecting the resources deleted by resource sweepers
+const (
+	// The path of a JSON file containing the selection policy
+	SweeperPolicyFile = "TF_AWS_SWEEPER_POLICY_FILE"
+)
+
 // GetWithDefault gets an environment variable value if non-empty or returns the default.
 func GetWithDefault(variable string, defaultValue string) string {
 	value := os.Getenv(variable)
@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// ValueFromJSON converts a JSON document to a framework value.
+// JSON objects are converted to objects and JSON arrays to tuples. Null object properties are omitted.
+func ValueFromJSON(ctx context.Context, s string) (attr.Value, error) {
+	decoder := json.NewDecoder(bytes.NewBufferString(s))
+	decoder.UseNumber()
+
+	var v any
+	if err := decoder.Decode(&v); err != nil {
+		return nil, err
+	}
+
+	tfValue, err := terraformValueFromJSON(v)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return ValueFromTerraform(ctx, tfValue)
+}
+
+func terraformValueFromJSON(v any) (tftypes.Value, error) {
+	switch v := v.(type) {
+	case nil:
+		return tftypes.NewValue(tftypes.String, nil), nil
+	case bool:
+		return tftypes.NewValue(tftypes.Bool, v), nil
+	case string:
+		return tftypes.NewValue(tftypes.String, v), nil
+	case json.Number:
+		f, _, err := big.ParseFloat(v.String(), 10, 512, big.ToNearestEven)
+
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+
+		return tftypes.NewValue(tftypes.Number, f), nil
+	case []any:
+		elementTypes := make([]tftypes.Type, 0, len(v))
+		elements := make([]tftypes.Value, 0, len(v))
+
+		for _, v := range v {
+			element, err := terraformValueFromJSON(v)
+
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+
+			elementTypes = append(elementTypes, element.Type())
+			elements = append(elements, element)
+		}
+
+		return tftypes.NewValue(tftypes.Tuple{ElementTypes: elementTypes}, elements), nil
+	case map[string]any:
+		attributeTypes := make(map[string]tftypes.Type, len(v))
+		attributes := make(map[string]tftypes.Value, len(v))
+
+		for k, v := range v {
+			if v == nil {
+				continue
+			}
+
+			attribute, err := terraformValueFromJSON(v)
+
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+
+			attributeTypes[k] = attribute.Type()
+			attributes[k] = attribute
+		}
+
+		return tftypes.NewValue(tftypes.Object{AttributeTypes: attributeTypes}, attributes), nil
+	default:
+		return tftypes.Value{}, fmt.Errorf("unsupported JSON type: %T", v)
+	}
+}
+
+// ValueFromTerraform converts a Terraform value to a framework value without any custom types.
+func ValueFromTerraform(ctx context.Context, v tftypes.Value) (attr.Value, error) {
+	typ, err := attrTypeFromTerraform(v.Type())
+
+	if err != nil {
+		return nil, err
+	}
+
+	return typ.ValueFromTerraform(ctx, v)
+}
+
+// attrTypeFromTerraform returns the framework type without any custom type for the specified Terraform type.
+func attrTypeFromTerraform(typ tftypes.Type) (attr.Type, error) {
+	switch {
+	case typ.Is(tftypes.Bool):
+		return types.BoolType, nil
+	case typ.Is(tftypes.DynamicPseudoType):
+		return types.DynamicType, nil
+	case typ.Is(tftypes.Number):
+		return types.NumberType, nil
+	case typ.Is(tftypes.String):
+		return types.StringType, nil
+	}
+
+	switch typ := typ.(type) {
+	case tftypes.List:
+		elemType, err := attrTypeFromTerraform(typ.ElementType)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return types.ListType{ElemType: elemType}, nil
+	case tftypes.Map:
+		elemType, err := attrTypeFromTerraform(typ.ElementType)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return types.MapType{ElemType: elemType}, nil
+	case tftypes.Object:
+		attrTypes := make(map[string]attr.Type, len(typ.AttributeTypes))
+
+		for k, v := range typ.AttributeTypes {
+			attrType, err := attrTypeFromTerraform(v)
+
+			if err != nil {
+				return nil, err
+			}
+
+			attrTypes[k] = attrType
+		}
+
+		return types.ObjectType{AttrTypes: attrTypes}, nil
+	case tftypes.Set:
+		elemType, err := attrTypeFromTerraform(typ.ElementType)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return types.SetType{ElemType: elemType}, nil
+	case tftypes.Tuple:
+		elemTypes := make([]attr.Type, 0, len(typ.ElementTypes))
+
+		for _, v := range typ.ElementTypes {
+			elemType, err := attrTypeFromTerraform(v)
+
+			if err != nil {
+				return nil, err
+			}
+
+			elemTypes = append(elemTypes, elemType)
+		}
+
+		return basetypes.TupleType{ElemTypes: elemTypes}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported type: %s", typ)
+}
@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+)
+
+func TestValueFromJSON(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	type testCase struct {
+		input       string
+		expected    attr.Value
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"string": {
+			input:    `"TEST"`,
+			expected: types.StringValue("TEST"),
+		},
+		"number": {
+			input:    `42`,
+			expected: types.NumberValue(big.NewFloat(42)),
+		},
+		"object": {
+			input: `{"Name": "test", "Enabled": true, "Missing": null, "Tags": [{"Key": "k", "Value": "v"}]}`,
+			expected: types.ObjectValueMust(
+				map[string]attr.Type{
+					"Enabled": types.BoolType,
+					"Name":    types.StringType,
+					"Tags": basetypes.TupleType{ElemTypes: []attr.Type{
+						types.ObjectType{AttrTypes: map[string]attr.Type{"Key": types.StringType, "Value": types.StringType}},
+					}},
+				},
+				map[string]attr.Value{
+					"Enabled": types.BoolValue(true),
+					"Name":    types.StringValue("test"),
+					"Tags": basetypes.NewTupleValueMust(
+						[]attr.Type{types.ObjectType{AttrTypes: map[string]attr.Type{"Key": types.StringType, "Value": types.StringType}}},
+						[]attr.Value{types.ObjectValueMust(
+							map[string]attr.Type{"Key": types.StringType, "Value": types.StringType},
+							map[string]attr.Value{"Key": types.StringValue("k"), "Value": types.StringValue("v")},
+						)},
+					),
+				},
+			),
+		},
+		"invalid": {
+			input:       `{`,
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := flex.ValueFromJSON(ctx, test.input)
+
+			if gotErr := err != nil; gotErr != test.expectError {
+				t.Fatalf("err %t, want %t (%v)", gotErr, test.expectError, err)
+			}
+
+			if test.expectError {
+				return
+			}
+
+			if !got.Equal(test.expected) {
+				t.Errorf("got %s, want %s", got, test.expected)
+			}
+		})
+	}
+}
+
+func TestValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"names": tftypes.List{ElementType: tftypes.String},
+		"tags":  tftypes.Map{ElementType: tftypes.String},
+	}}
+	input := tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"names": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "a"),
+		}),
+		"tags": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+	})
+
+	got, err := flex.ValueFromTerraform(ctx, input)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := types.ObjectValueMust(
+		map[string]attr.Type{
+			"names": types.ListType{ElemType: types.StringType},
+			"tags":  types.MapType{ElemType: types.StringType},
+		},
+		map[string]attr.Value{
+			"names": types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a")}),
+			"tags":  types.MapNull(types.StringType),
+		},
+	)
+
+	if !got.Equal(expected) {
+		t.Errorf("got %s, want %s", got, expected)
+	}
+}
@@ -0,0 +1,310 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package identity implements importing Plugin Framework resources by their declared identity.
+//
+// A resource declares its identity attributes with @IdentityAttribute annotations and,
+// optionally, how its ARN is formed with an @ArnIdentity annotation.
+// The import ID can then take any of the forms:
+//
+//   - A composite ID, the identity attribute values in declaration order joined by the separator (default ","),
+//     e.g. "my-cluster,a-12345678"
+//   - An attribute map, comma-separated name=value pairs, e.g. "cluster_name=my-cluster,association_id=a-12345678"
+//   - The resource's ARN, e.g. "arn:aws:eks:us-west-2:123456789012:podidentityassociation/my-cluster/a-12345678"
+package identity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+var placeholderRegexp = regexache.MustCompile(`\{([0-9A-Za-z_]+)\}`)
+
+// Separator returns the separator between the parts of a composite import ID.
+func Separator(identity *types.ServicePackageResourceIdentity) string {
+	if identity.Separator != "" {
+		return identity.Separator
+	}
+
+	return flex.ResourceIdSeparator
+}
+
+// Parse parses an import ID into attribute values.
+// All identity attributes have values unless the ID is an ARN and the ARN's resource format is not declared,
+// in which case only the ARN attribute has a value.
+func Parse(identity *types.ServicePackageResourceIdentity, id string) (map[string]string, error) {
+	if id == "" {
+		return nil, fmt.Errorf("empty import ID, expected %s", expectedFormats(identity))
+	}
+
+	// ARN.
+	if identity.ARNAttribute != "" && arn.IsARN(id) {
+		values := map[string]string{
+			identity.ARNAttribute: id,
+		}
+
+		if identity.ARNResource == "" {
+			return values, nil
+		}
+
+		v, err := arn.Parse(id)
+		if err != nil {
+			return nil, err
+		}
+
+		m, err := matchARNResource(identity.ARNResource, v.Resource)
+		if err != nil {
+			return nil, err
+		}
+		maps.Copy(values, m)
+
+		return values, nil
+	}
+
+	// Attribute map.
+	if name, _, ok := strings.Cut(id, "="); ok && isAttribute(identity, name) {
+		values := make(map[string]string)
+
+		for _, pair := range strings.Split(id, ",") {
+			name, value, ok := strings.Cut(pair, "=")
+			if !ok || !isAttribute(identity, name) {
+				return nil, fmt.Errorf("unexpected format for import ID (%s), expected name=value pairs for attributes %s", id, strings.Join(attributeNames(identity), ", "))
+			}
+			if _, ok := values[name]; ok {
+				return nil, fmt.Errorf("unexpected format for import ID (%s), attribute %s specified more than once", id, name)
+			}
+
+			values[name] = value
+		}
+
+		if err := validate(identity, id, values); err != nil {
+			return nil, err
+		}
+
+		return values, nil
+	}
+
+	// Composite ID.
+	// The last identity attribute's value may contain the separator.
+	n := len(identity.Attributes)
+	parts := strings.SplitN(id, Separator(identity), n)
+
+	if len(parts) != n {
+		return nil, fmt.Errorf("unexpected format for import ID (%s), expected %s", id, expectedFormats(identity))
+	}
+
+	values := make(map[string]string, n)
+	for i, name := range identity.Attributes {
+		values[name] = parts[i]
+	}
+
+	if err := validate(identity, id, values); err != nil {
+		return nil, err
+	}
+
+	return values, nil
+}
+
+// ID returns the value of the "id" attribute for the specified attribute values.
+// The empty string is returned if the value cannot be determined.
+func ID(identity *types.ServicePackageResourceIdentity, values map[string]string) string {
+	if identity.IDAttribute != "" {
+		return values[identity.IDAttribute]
+	}
+
+	parts := make([]string, 0, len(identity.Attributes))
+	for _, name := range identity.Attributes {
+		v, ok := values[name]
+		if !ok {
+			return ""
+		}
+		parts = append(parts, v)
+	}
+
+	return strings.Join(parts, Separator(identity))
+}
+
+// ImportState imports a resource by its identity, setting the identity attributes and, if the resource has one, the "id" attribute.
+func ImportState(ctx context.Context, identity *types.ServicePackageResourceIdentity, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	values, err := Parse(identity, request.ID)
+
+	if err != nil {
+		response.Diagnostics.AddError("Unexpected Import Identifier", err.Error())
+
+		return
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(values)) {
+		response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(name), values[name])...)
+	}
+
+	if _, ok := values[names.AttrID]; ok {
+		return
+	}
+
+	if _, diags := response.State.Schema.TypeAtPath(ctx, path.Root(names.AttrID)); diags.HasError() {
+		// No "id" attribute.
+		return
+	}
+
+	if id := ID(identity, values); id != "" {
+		response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrID), id)...)
+	}
+}
+
+// Validate returns an error if the identity is inconsistent with itself or with the resource's schema.
+func Validate(ctx context.Context, identity *types.ServicePackageResourceIdentity, resourceSchema schema.Schema) error {
+	var errs []error
+
+	if len(identity.Attributes) == 0 {
+		errs = append(errs, errors.New("no identity attributes"))
+	}
+
+	for _, name := range attributeNames(identity) {
+		attr, ok := resourceSchema.Attributes[name]
+		if !ok {
+			errs = append(errs, fmt.Errorf("identity attribute `%s` not defined in schema", name))
+			continue
+		}
+
+		if !attr.GetType().TerraformType(ctx).Is(tftypes.String) {
+			errs = append(errs, fmt.Errorf("identity attribute `%s` is not a string", name))
+		}
+	}
+
+	if identity.IDAttribute != "" && !slices.Contains(identity.Attributes, identity.IDAttribute) {
+		errs = append(errs, fmt.Errorf("id attribute `%s` is not an identity attribute", identity.IDAttribute))
+	}
+
+	if identity.ARNResource != "" {
+		if identity.ARNAttribute == "" {
+			errs = append(errs, errors.New("ARN resource format specified without ARN attribute"))
+		}
+
+		var placeholders []string
+		for _, m := range placeholderRegexp.FindAllStringSubmatch(identity.ARNResource, -1) {
+			if !slices.Contains(identity.Attributes, m[1]) {
+				errs = append(errs, fmt.Errorf("ARN resource format placeholder {%s} is not an identity attribute", m[1]))
+			}
+			placeholders = append(placeholders, m[1])
+		}
+
+		for _, name := range identity.Attributes {
+			if !slices.Contains(placeholders, name) {
+				errs = append(errs, fmt.Errorf("identity attribute `%s` missing from ARN resource format", name))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// ExampleARNResource returns an ARN resource part in the declared format for the specified attribute values.
+func ExampleARNResource(identity *types.ServicePackageResourceIdentity, values map[string]string) string {
+	return placeholderRegexp.ReplaceAllStringFunc(identity.ARNResource, func(s string) string {
+		return values[strings.Trim(s, "{}")]
+	})
+}
+
+// validate returns an error if any identity attribute is missing or empty.
+func validate(identity *types.ServicePackageResourceIdentity, id string, values map[string]string) error {
+	var missing []string
+
+	for _, name := range identity.Attributes {
+		if values[name] == "" {
+			missing = append(missing, name)
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("unexpected format for import ID (%s), no value for %s", id, strings.Join(missing, ", "))
+	}
+
+	return nil
+}
+
+// matchARNResource matches an ARN's resource part against the declared format, returning the identity attribute values.
+// The last placeholder in the format may match values containing "/".
+func matchARNResource(format, resource string) (map[string]string, error) {
+	var (
+		attrs []string
+		expr  strings.Builder
+	)
+
+	matches := placeholderRegexp.FindAllStringSubmatchIndex(format, -1)
+	expr.WriteString("^")
+	start := 0
+	for i, m := range matches {
+		expr.WriteString(regexp.QuoteMeta(format[start:m[0]]))
+		if i == len(matches)-1 {
+			expr.WriteString("(.+)")
+		} else {
+			expr.WriteString("([^/:]+)")
+		}
+		attrs = append(attrs, format[m[2]:m[3]])
+		start = m[1]
+	}
+	expr.WriteString(regexp.QuoteMeta(format[start:]))
+	expr.WriteString("$")
+
+	m := regexache.MustCompile(expr.String()).FindStringSubmatch(resource)
+	if m == nil {
+		return nil, fmt.Errorf("unexpected format for ARN resource (%s), expected %s", resource, format)
+	}
+
+	values := make(map[string]string, len(attrs))
+	for i, name := range attrs {
+		values[name] = m[i+1]
+	}
+
+	return values, nil
+}
+
+func isAttribute(identity *types.ServicePackageResourceIdentity, name string) bool {
+	return slices.Contains(identity.Attributes, name) || (identity.ARNAttribute != "" && name == identity.ARNAttribute)
+}
+
+// attributeNames returns the names of the identity attributes and any ARN attribute.
+func attributeNames(identity *types.ServicePackageResourceIdentity) []string {
+	attrs := slices.Clone(identity.Attributes)
+
+	if identity.ARNAttribute != "" && !slices.Contains(attrs, identity.ARNAttribute) {
+		attrs = append(attrs, identity.ARNAttribute)
+	}
+
+	return attrs
+}
+
+func expectedFormats(identity *types.ServicePackageResourceIdentity) string {
+	var pairs []string
+	for _, name := range identity.Attributes {
+		pairs = append(pairs, name+"=<"+name+">")
+	}
+
+	formats := []string{
+		strings.Join(identity.Attributes, Separator(identity)),
+	}
+	if len(identity.Attributes) > 1 {
+		formats = append(formats, strings.Join(pairs, ","))
+	}
+	if identity.ARNAttribute != "" {
+		formats = append(formats, "an ARN")
+	}
+
+	return strings.Join(formats, " or ")
+}
@@ -0,0 +1,318 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identity_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/identity"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	podIdentityAssociation := &types.ServicePackageResourceIdentity{
+		Attributes:   []string{"cluster_name", "association_id"},
+		IDAttribute:  "association_id",
+		ARNAttribute: "association_arn",
+		ARNResource:  "podidentityassociation/{cluster_name}/{association_id}",
+	}
+	object := &types.ServicePackageResourceIdentity{
+		Attributes: []string{"bucket", "key"},
+		Separator:  "/",
+	}
+	arnOnly := &types.ServicePackageResourceIdentity{
+		Attributes:   []string{"name"},
+		ARNAttribute: "arn",
+	}
+
+	testCases := map[string]struct {
+		identity       *types.ServicePackageResourceIdentity
+		id             string
+		expectedValues map[string]string
+		expectError    bool
+	}{
+		"composite": {
+			identity: podIdentityAssociation,
+			id:       "my-cluster,a-12345678",
+			expectedValues: map[string]string{
+				"cluster_name":   "my-cluster",
+				"association_id": "a-12345678",
+			},
+		},
+		"composite too few parts": {
+			identity:    podIdentityAssociation,
+			id:          "a-12345678",
+			expectError: true,
+		},
+		"composite empty part": {
+			identity:    podIdentityAssociation,
+			id:          ",a-12345678",
+			expectError: true,
+		},
+		"composite last part contains separator": {
+			identity: object,
+			id:       "my-bucket/path/to/key",
+			expectedValues: map[string]string{
+				"bucket": "my-bucket",
+				"key":    "path/to/key",
+			},
+		},
+		"attribute map": {
+			identity: podIdentityAssociation,
+			id:       "association_id=a-12345678,cluster_name=my-cluster",
+			expectedValues: map[string]string{
+				"cluster_name":   "my-cluster",
+				"association_id": "a-12345678",
+			},
+		},
+		"attribute map missing attribute": {
+			identity:    podIdentityAssociation,
+			id:          "cluster_name=my-cluster",
+			expectError: true,
+		},
+		"attribute map unknown attribute": {
+			identity:    podIdentityAssociation,
+			id:          "cluster_name=my-cluster,namespace=default",
+			expectError: true,
+		},
+		"attribute map duplicate attribute": {
+			identity:    podIdentityAssociation,
+			id:          "cluster_name=my-cluster,cluster_name=other,association_id=a-12345678",
+			expectError: true,
+		},
+		"ARN": {
+			identity: podIdentityAssociation,
+			id:       "arn:aws:eks:us-west-2:123456789012:podidentityassociation/my-cluster/a-12345678",
+			expectedValues: map[string]string{
+				"association_arn": "arn:aws:eks:us-west-2:123456789012:podidentityassociation/my-cluster/a-12345678",
+				"cluster_name":    "my-cluster",
+				"association_id":  "a-12345678",
+			},
+		},
+		"ARN wrong resource": {
+			identity:    podIdentityAssociation,
+			id:          "arn:aws:eks:us-west-2:123456789012:cluster/my-cluster",
+			expectError: true,
+		},
+		"ARN without resource format": {
+			identity: arnOnly,
+			id:       "arn:aws:example:us-west-2:123456789012:thing/my-thing",
+			expectedValues: map[string]string{
+				"arn": "arn:aws:example:us-west-2:123456789012:thing/my-thing",
+			},
+		},
+		"ARN not declared": {
+			identity: object,
+			id:       "arn:aws:s3:::my-bucket/key",
+			expectedValues: map[string]string{
+				"bucket": "arn:aws:s3:::my-bucket",
+				"key":    "key",
+			},
+		},
+		"empty": {
+			identity:    podIdentityAssociation,
+			id:          "",
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			values, err := identity.Parse(testCase.identity, testCase.id)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("Parse(%q) err %t, want %t (%v)", testCase.id, got, want, err)
+			}
+
+			if diff := cmp.Diff(values, testCase.expectedValues); diff != "" {
+				t.Errorf("unexpected values diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestID(t *testing.T) {
+	t.Parallel()
+
+	values := map[string]string{
+		"cluster_name":   "my-cluster",
+		"association_id": "a-12345678",
+	}
+
+	testCases := map[string]struct {
+		identity *types.ServicePackageResourceIdentity
+		expected string
+	}{
+		"composite": {
+			identity: &types.ServicePackageResourceIdentity{Attributes: []string{"cluster_name", "association_id"}},
+			expected: "my-cluster,a-12345678",
+		},
+		"separator": {
+			identity: &types.ServicePackageResourceIdentity{Attributes: []string{"cluster_name", "association_id"}, Separator: ":"},
+			expected: "my-cluster:a-12345678",
+		},
+		"id attribute": {
+			identity: &types.ServicePackageResourceIdentity{Attributes: []string{"cluster_name", "association_id"}, IDAttribute: "association_id"},
+			expected: "a-12345678",
+		},
+		"missing value": {
+			identity: &types.ServicePackageResourceIdentity{Attributes: []string{"cluster_name", "name"}},
+			expected: "",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := identity.ID(testCase.identity, values), testCase.expected; got != want {
+				t.Errorf("ID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestImportState(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"association_arn": schema.StringAttribute{Computed: true},
+			"association_id":  schema.StringAttribute{Computed: true},
+			"cluster_name":    schema.StringAttribute{Required: true},
+			"id":              schema.StringAttribute{Computed: true},
+		},
+	}
+	spec := &types.ServicePackageResourceIdentity{
+		Attributes: []string{"cluster_name", "association_id"},
+	}
+
+	if err := identity.Validate(ctx, spec, s); err != nil {
+		t.Fatalf("Validate: %s", err)
+	}
+
+	response := resource.ImportStateResponse{
+		State: tfsdk.State{
+			Schema: s,
+			Raw:    tftypes.NewValue(s.Type().TerraformType(ctx), nil),
+		},
+	}
+	identity.ImportState(ctx, spec, resource.ImportStateRequest{ID: "association_id=a-12345678,cluster_name=my-cluster"}, &response)
+
+	if response.Diagnostics.HasError() {
+		t.Fatalf("ImportState: %v", response.Diagnostics)
+	}
+
+	for name, want := range map[string]string{
+		"association_id": "a-12345678",
+		"cluster_name":   "my-cluster",
+		"id":             "my-cluster,a-12345678",
+	} {
+		var got fwtypes.String
+		response.Diagnostics.Append(response.State.GetAttribute(ctx, path.Root(name), &got)...)
+		if got.ValueString() != want {
+			t.Errorf("%s = %q, want %q", name, got.ValueString(), want)
+		}
+	}
+
+	var arn fwtypes.String
+	response.Diagnostics.Append(response.State.GetAttribute(ctx, path.Root("association_arn"), &arn)...)
+	if !arn.IsNull() {
+		t.Errorf("association_arn = %s, want null", arn)
+	}
+
+	response = resource.ImportStateResponse{
+		State: tfsdk.State{
+			Schema: s,
+			Raw:    tftypes.NewValue(s.Type().TerraformType(ctx), nil),
+		},
+	}
+	identity.ImportState(ctx, spec, resource.ImportStateRequest{ID: "my-cluster"}, &response)
+
+	if !response.Diagnostics.HasError() {
+		t.Error("ImportState with invalid ID: expected error")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"arn":    schema.StringAttribute{Computed: true},
+			"count":  schema.Int64Attribute{Optional: true},
+			"id":     schema.StringAttribute{Computed: true},
+			"name":   schema.StringAttribute{Required: true},
+			"parent": schema.StringAttribute{Required: true},
+		},
+	}
+
+	testCases := map[string]struct {
+		identity    *types.ServicePackageResourceIdentity
+		expectError bool
+	}{
+		"valid": {
+			identity: &types.ServicePackageResourceIdentity{
+				Attributes:   []string{"parent", "name"},
+				IDAttribute:  "name",
+				ARNAttribute: "arn",
+				ARNResource:  "parent/{parent}/thing/{name}",
+			},
+		},
+		"no attributes": {
+			identity:    &types.ServicePackageResourceIdentity{},
+			expectError: true,
+		},
+		"attribute not in schema": {
+			identity:    &types.ServicePackageResourceIdentity{Attributes: []string{"missing"}},
+			expectError: true,
+		},
+		"attribute not a string": {
+			identity:    &types.ServicePackageResourceIdentity{Attributes: []string{"count"}},
+			expectError: true,
+		},
+		"id attribute not an identity attribute": {
+			identity:    &types.ServicePackageResourceIdentity{Attributes: []string{"name"}, IDAttribute: "parent"},
+			expectError: true,
+		},
+		"ARN resource without ARN attribute": {
+			identity:    &types.ServicePackageResourceIdentity{Attributes: []string{"name"}, ARNResource: "thing/{name}"},
+			expectError: true,
+		},
+		"ARN resource missing attribute": {
+			identity:    &types.ServicePackageResourceIdentity{Attributes: []string{"parent", "name"}, ARNAttribute: "arn", ARNResource: "thing/{name}"},
+			expectError: true,
+		},
+		"ARN resource unknown placeholder": {
+			identity:    &types.ServicePackageResourceIdentity{Attributes: []string{"name"}, ARNAttribute: "arn", ARNResource: "{region}/thing/{name}"},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := identity.Validate(ctx, testCase.identity, s)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("Validate err %t, want %t (%v)", got, want, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package identitytest contains helpers for testing resources' declared identities.
+// It is used by tests generated from @IdentityAttribute annotations.
+package identitytest
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/identity"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+type servicePackage interface {
+	FrameworkResources(context.Context) []*types.ServicePackageFrameworkResource
+}
+
+type factory = func(context.Context) (resource.ResourceWithConfigure, error)
+
+// ImportState tests that the resource created by the specified factory can be imported by its declared identity
+// in each of the supported import ID forms, and that invalid import IDs are rejected.
+func ImportState(t *testing.T, sp servicePackage, f factory) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	var spec *types.ServicePackageResourceIdentity
+	for _, v := range sp.FrameworkResources(ctx) {
+		if reflect.ValueOf(v.Factory).Pointer() == reflect.ValueOf(f).Pointer() {
+			spec = v.Identity
+			break
+		}
+	}
+
+	if spec == nil {
+		t.Fatal("resource has no declared identity")
+	}
+
+	r, err := f(ctx)
+	if err != nil {
+		t.Fatalf("creating resource: %s", err)
+	}
+
+	var schemaResponse resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("reading schema: %v", schemaResponse.Diagnostics)
+	}
+	s := schemaResponse.Schema
+
+	if err := identity.Validate(ctx, spec, s); err != nil {
+		t.Fatalf("invalid identity: %s", err)
+	}
+
+	values := make(map[string]string, len(spec.Attributes))
+	var parts, pairs []string
+	for i, name := range spec.Attributes {
+		v := fmt.Sprintf("%s-%d", strings.ReplaceAll(name, "_", "-"), i)
+		values[name] = v
+		parts = append(parts, v)
+		pairs = append(pairs, name+"="+v)
+	}
+
+	_, diags := s.TypeAtPath(ctx, path.Root(names.AttrID))
+	hasID := !diags.HasError()
+
+	importState := func(t *testing.T, id string) resource.ImportStateResponse {
+		t.Helper()
+
+		response := resource.ImportStateResponse{
+			State: tfsdk.State{
+				Schema: s,
+				Raw:    tftypes.NewValue(s.Type().TerraformType(ctx), nil),
+			},
+		}
+		identity.ImportState(ctx, spec, resource.ImportStateRequest{ID: id}, &response)
+
+		return response
+	}
+
+	check := func(t *testing.T, id string, expected map[string]string) {
+		t.Helper()
+
+		response := importState(t, id)
+		if response.Diagnostics.HasError() {
+			t.Fatalf("importing %q: %v", id, response.Diagnostics)
+		}
+
+		if hasID {
+			if _, ok := expected[names.AttrID]; !ok {
+				if v := identity.ID(spec, expected); v != "" {
+					expected[names.AttrID] = v
+				}
+			}
+		}
+
+		for name, want := range expected {
+			var got fwtypes.String
+			if diags := response.State.GetAttribute(ctx, path.Root(name), &got); diags.HasError() {
+				t.Fatalf("reading %s: %v", name, diags)
+			}
+
+			if got.ValueString() != want {
+				t.Errorf("importing %q: %s = %q, want %q", id, name, got.ValueString(), want)
+			}
+		}
+	}
+
+	t.Run("composite", func(t *testing.T) {
+		check(t, strings.Join(parts, identity.Separator(spec)), maps.Clone(values))
+	})
+
+	t.Run("attribute map", func(t *testing.T) {
+		check(t, strings.Join(pairs, ","), maps.Clone(values))
+	})
+
+	if spec.ARNAttribute != "" {
+		t.Run("ARN", func(t *testing.T) {
+			resourcePart := "example"
+			expected := make(map[string]string)
+
+			if spec.ARNResource != "" {
+				resourcePart = identity.ExampleARNResource(spec, values)
+				expected = maps.Clone(values)
+			}
+
+			arn := "arn:aws:example:us-west-2:123456789012:" + resourcePart //lintignore:AWSAT003,AWSAT005
+			expected[spec.ARNAttribute] = arn
+
+			check(t, arn, expected)
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		ids := []string{""}
+		if len(parts) > 1 {
+			ids = append(ids, parts[0], strings.Join(pairs[:1], ","))
+		}
+
+		for _, id := range ids {
+			if response := importState(t, id); !response.Diagnostics.HasError() {
+				t.Errorf("importing %q: expected error", id)
+			}
+		}
+	})
+}
@@ -0,0 +1,186 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// NewSDKv2StateUpgrader returns a state upgrader for state written by the Plugin SDKv2 implementation of a resource
+// that has been migrated to the Plugin Framework.
+// priorSchema is the Plugin Framework equivalent of the resource's Plugin SDKv2 schema and must have the same structure
+// (attributes, blocks and their types) as the resource's current schema.
+//
+// Plugin SDKv2 writes zero values for unset Optional attributes and can write a single empty element for unset
+// `MaxItems: 1` blocks. These are upgraded to null values and empty blocks respectively.
+// The top-level `id` attribute is always retained.
+func NewSDKv2StateUpgrader(priorSchema schema.Schema) resource.StateUpgrader {
+	return resource.StateUpgrader{
+		PriorSchema: &priorSchema,
+		StateUpgrader: func(ctx context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse) {
+			if request.State == nil {
+				response.Diagnostics.AddError("upgrading Plugin SDKv2 state", "missing prior state")
+
+				return
+			}
+
+			raw, err := upgradeSDKv2StateValue(priorSchema, request.State.Raw)
+
+			if err != nil {
+				response.Diagnostics.AddError("upgrading Plugin SDKv2 state", err.Error())
+
+				return
+			}
+
+			response.State.Raw = raw
+		},
+	}
+}
+
+func upgradeSDKv2StateValue(s schema.Schema, val tftypes.Value) (tftypes.Value, error) {
+	return tftypes.Transform(val, func(p *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+		if !v.IsKnown() {
+			return v, nil
+		}
+
+		switch element := sdkv2SchemaElementAtPath(s, p).(type) {
+		case schema.Attribute:
+			if steps := p.Steps(); len(steps) == 1 && steps[0].Equal(tftypes.AttributeName(names.AttrID)) {
+				return v, nil
+			}
+
+			if element.IsOptional() && !element.IsComputed() && isZeroValue(v) {
+				return tftypes.NewValue(v.Type(), nil), nil
+			}
+
+		case schema.ListNestedBlock, schema.SetNestedBlock:
+			if v.IsNull() {
+				return tftypes.NewValue(v.Type(), []tftypes.Value{}), nil
+			}
+
+			var elements []tftypes.Value
+			if err := v.As(&elements); err != nil {
+				return v, err
+			}
+
+			if len(elements) == 1 && isEmptyObjectValue(elements[0]) {
+				return tftypes.NewValue(v.Type(), []tftypes.Value{}), nil
+			}
+		}
+
+		return v, nil
+	})
+}
+
+// sdkv2SchemaElementAtPath returns the schema.Attribute or schema.Block at the specified path.
+// nil is returned for the root of the schema, for nested block objects and for paths within attribute values.
+func sdkv2SchemaElementAtPath(s schema.Schema, p *tftypes.AttributePath) any {
+	var element any
+	attributes, blocks := s.Attributes, s.Blocks
+
+	for _, step := range p.Steps() {
+		switch step := step.(type) {
+		case tftypes.AttributeName:
+			if v, ok := attributes[string(step)]; ok {
+				element = v
+				attributes, blocks = nil, nil
+				continue
+			}
+
+			if v, ok := blocks[string(step)]; ok {
+				element = v
+				attributes, blocks = nil, nil
+
+				if v, ok := v.(schema.SingleNestedBlock); ok {
+					attributes, blocks = v.Attributes, v.Blocks
+				}
+
+				continue
+			}
+
+			return nil
+
+		case tftypes.ElementKeyInt, tftypes.ElementKeyString, tftypes.ElementKeyValue:
+			switch v := element.(type) {
+			case schema.ListNestedBlock:
+				attributes, blocks = v.NestedObject.Attributes, v.NestedObject.Blocks
+			case schema.SetNestedBlock:
+				attributes, blocks = v.NestedObject.Attributes, v.NestedObject.Blocks
+			default:
+				return nil
+			}
+
+			element = nil
+		}
+	}
+
+	return element
+}
+
+// isZeroValue returns whether the specified known, non-null value is its type's zero value.
+func isZeroValue(v tftypes.Value) bool {
+	if v.IsNull() || !v.IsKnown() {
+		return false
+	}
+
+	typ := v.Type()
+
+	switch {
+	case typ.Is(tftypes.String):
+		var s string
+		return v.As(&s) == nil && s == ""
+
+	case typ.Is(tftypes.Number):
+		var n big.Float
+		return v.As(&n) == nil && n.Sign() == 0
+
+	case typ.Is(tftypes.Bool):
+		var b bool
+		return v.As(&b) == nil && !b
+
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}), typ.Is(tftypes.Tuple{}):
+		var elements []tftypes.Value
+		return v.As(&elements) == nil && len(elements) == 0
+
+	case typ.Is(tftypes.Map{}):
+		var elements map[string]tftypes.Value
+		return v.As(&elements) == nil && len(elements) == 0
+	}
+
+	return false
+}
+
+// isEmptyObjectValue returns whether the specified object value's attributes are all null or empty collections.
+func isEmptyObjectValue(v tftypes.Value) bool {
+	if v.IsNull() || !v.IsKnown() || !v.Type().Is(tftypes.Object{}) {
+		return false
+	}
+
+	var attributes map[string]tftypes.Value
+	if err := v.As(&attributes); err != nil {
+		return false
+	}
+
+	for _, v := range attributes {
+		if v.IsNull() {
+			continue
+		}
+
+		if typ := v.Type(); typ.Is(tftypes.List{}) || typ.Is(tftypes.Set{}) || typ.Is(tftypes.Map{}) {
+			if isZeroValue(v) {
+				continue
+			}
+		}
+
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,208 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSDKv2StateUpgrader(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"arn": schema.StringAttribute{
+				Computed: true,
+			},
+			"description": schema.StringAttribute{
+				Optional: true,
+			},
+			"enabled": schema.BoolAttribute{
+				Optional: true,
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"port": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+			},
+			"subnet_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"logging": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"bucket": schema.StringAttribute{
+							Optional: true,
+						},
+						"prefix": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+			"rule": schema.SetNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"priority": schema.Int64Attribute{
+							Optional: true,
+						},
+						"value": schema.StringAttribute{
+							Required: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"filter": schema.ListNestedBlock{
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"prefix": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+
+	typ := s.Type().TerraformType(ctx).(tftypes.Object)
+	loggingType := typ.AttributeTypes["logging"].(tftypes.List)
+	ruleType := typ.AttributeTypes["rule"].(tftypes.Set)
+	ruleObjectType := ruleType.ElementType.(tftypes.Object)
+	filterType := ruleObjectType.AttributeTypes["filter"].(tftypes.List)
+	timeoutsType := typ.AttributeTypes["timeouts"]
+
+	rule := func(priority int64, value string, filter []tftypes.Value) tftypes.Value {
+		return tftypes.NewValue(ruleObjectType, map[string]tftypes.Value{
+			"filter":   tftypes.NewValue(filterType, filter),
+			"priority": tftypes.NewValue(tftypes.Number, priority),
+			"value":    tftypes.NewValue(tftypes.String, value),
+		})
+	}
+	emptyFilter := tftypes.NewValue(filterType.ElementType, map[string]tftypes.Value{
+		"prefix": tftypes.NewValue(tftypes.String, ""),
+	})
+
+	priorState := tftypes.NewValue(typ, map[string]tftypes.Value{
+		"arn":         tftypes.NewValue(tftypes.String, ""),
+		"description": tftypes.NewValue(tftypes.String, ""),
+		"enabled":     tftypes.NewValue(tftypes.Bool, false),
+		"id":          tftypes.NewValue(tftypes.String, "example"),
+		"logging": tftypes.NewValue(loggingType, []tftypes.Value{
+			tftypes.NewValue(loggingType.ElementType, map[string]tftypes.Value{
+				"bucket": tftypes.NewValue(tftypes.String, ""),
+				"prefix": tftypes.NewValue(tftypes.String, ""),
+			}),
+		}),
+		"name":       tftypes.NewValue(tftypes.String, "example"),
+		"port":       tftypes.NewValue(tftypes.Number, 0),
+		"rule":       tftypes.NewValue(ruleType, []tftypes.Value{rule(10, "a", []tftypes.Value{emptyFilter})}),
+		"subnet_ids": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{}),
+		"timeouts":   tftypes.NewValue(timeoutsType, nil),
+	})
+
+	upgrader := NewSDKv2StateUpgrader(s)
+	request := resource.UpgradeStateRequest{
+		State: &tfsdk.State{Schema: s, Raw: priorState},
+	}
+	response := resource.UpgradeStateResponse{
+		State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(typ, nil)},
+	}
+
+	upgrader.StateUpgrader(ctx, request, &response)
+
+	if response.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", response.Diagnostics)
+	}
+
+	want := tftypes.NewValue(typ, map[string]tftypes.Value{
+		"arn":         tftypes.NewValue(tftypes.String, ""),
+		"description": tftypes.NewValue(tftypes.String, nil),
+		"enabled":     tftypes.NewValue(tftypes.Bool, nil),
+		"id":          tftypes.NewValue(tftypes.String, "example"),
+		"logging":     tftypes.NewValue(loggingType, []tftypes.Value{}),
+		"name":        tftypes.NewValue(tftypes.String, "example"),
+		"port":        tftypes.NewValue(tftypes.Number, 0),
+		"rule": tftypes.NewValue(ruleType, []tftypes.Value{
+			tftypes.NewValue(ruleObjectType, map[string]tftypes.Value{
+				"filter":   tftypes.NewValue(filterType, []tftypes.Value{}),
+				"priority": tftypes.NewValue(tftypes.Number, 10),
+				"value":    tftypes.NewValue(tftypes.String, "a"),
+			}),
+		}),
+		"subnet_ids": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+		"timeouts":   tftypes.NewValue(timeoutsType, nil),
+	})
+
+	if diff := cmp.Diff(response.State.Raw, want); diff != "" {
+		t.Errorf("unexpected diff (+want, -got): %s", diff)
+	}
+}
+
+func TestSDKv2StateUpgraderNullBlocks(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"logging": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"bucket": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	typ := s.Type().TerraformType(ctx).(tftypes.Object)
+	loggingType := typ.AttributeTypes["logging"]
+
+	got, err := upgradeSDKv2StateValue(s, tftypes.NewValue(typ, map[string]tftypes.Value{
+		"id":      tftypes.NewValue(tftypes.String, ""),
+		"logging": tftypes.NewValue(loggingType, nil),
+	}))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := tftypes.NewValue(typ, map[string]tftypes.Value{
+		"id":      tftypes.NewValue(tftypes.String, ""),
+		"logging": tftypes.NewValue(loggingType, []tftypes.Value{}),
+	})
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+want, -got): %s", diff)
+	}
+}
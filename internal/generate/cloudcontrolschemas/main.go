@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+// +build generate
+
+// Generates the bundled snapshot of CloudFormation resource schemas used by the Cloud Control API resource
+// when DescribeType is unavailable. Requires AWS credentials.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+)
+
+func main() {
+	const (
+		dir          = `schemas`
+		typesFile    = `types.txt`
+		versionFile  = `VERSION`
+		relativePath = `internal/service/cloudcontrol/`
+	)
+	g := common.NewGenerator()
+	ctx := context.Background()
+
+	typeNames, err := readTypeNames(path.Join(dir, typesFile))
+	if err != nil {
+		g.Fatalf("error reading %s: %s", typesFile, err)
+	}
+
+	cfg, err := config.LoadDefaultConfig(ctx)
+	if err != nil {
+		g.Fatalf("error loading AWS configuration: %s", err)
+	}
+
+	conn := cloudformation.NewFromConfig(cfg)
+
+	for _, typeName := range typeNames {
+		filename := path.Join(dir, strings.ReplaceAll(typeName, "::", "_")+".json")
+
+		g.Infof("Generating %s%s", relativePath, filename)
+
+		output, err := conn.DescribeType(ctx, &cloudformation.DescribeTypeInput{
+			Type:     awstypes.RegistryTypeResource,
+			TypeName: aws.String(typeName),
+		})
+		if err != nil {
+			g.Fatalf("error reading CloudFormation Type (%s): %s", typeName, err)
+		}
+
+		var body bytes.Buffer
+		if err := json.Indent(&body, []byte(aws.ToString(output.Schema)), "", "  "); err != nil {
+			g.Fatalf("error formatting CloudFormation Type (%s) schema: %s", typeName, err)
+		}
+		body.WriteString("\n")
+
+		d := g.NewUnformattedFileDestination(filename)
+
+		if err := d.BufferBytes(body.Bytes()); err != nil {
+			g.Fatalf("generating file (%s): %s", filename, err)
+		}
+
+		if err := d.Write(); err != nil {
+			g.Fatalf("generating file (%s): %s", filename, err)
+		}
+	}
+
+	filename := path.Join(dir, versionFile)
+
+	g.Infof("Generating %s%s", relativePath, filename)
+
+	d := g.NewUnformattedFileDestination(filename)
+
+	if err := d.BufferBytes([]byte(time.Now().UTC().Format(time.DateOnly) + "\n")); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+
+	if err := d.Write(); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+}
+
+// readTypeNames returns the resource type names listed in the specified file, ignoring blank lines and comments.
+func readTypeNames(filename string) ([]string, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var typeNames []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		typeNames = append(typeNames, line)
+	}
+
+	return typeNames, scanner.Err()
+}
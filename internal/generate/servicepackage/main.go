@@ -16,6 +16,7 @@ import (
 	"go/token"
 	"os"
 	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/YakDriver/regexache"
@@ -123,6 +124,12 @@ type ResourceDatum struct {
 	TransparentTagging      bool
 	TagsIdentifierAttribute string
 	TagsResourceType        string
+	IdentityAttributes      []string
+	IdentityIDAttribute     string
+	ARNIdentityAttribute    string
+	ARNIdentityResource     string
+	ImportIDSeparator       string
+	Stateful                bool
 }
 
 type ServiceDatum struct {
@@ -226,6 +233,67 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 		}
 	}
 
+	// Then for identity annotations.
+	for _, line := range funcDecl.Doc.List {
+		line := line.Text
+
+		m := annotation.FindStringSubmatch(line)
+		if len(m) == 0 {
+			continue
+		}
+
+		args := common.ParseArgs(m[3])
+
+		switch m[1] {
+		case "IdentityAttribute":
+			if len(args.Positional) == 0 {
+				v.errs = append(v.errs, fmt.Errorf("no identity attribute name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				continue
+			}
+
+			attr := namesgen.ConstOrQuote(args.Positional[0])
+			d.IdentityAttributes = append(d.IdentityAttributes, attr)
+
+			if s, ok := args.Keyword["setID"]; ok {
+				if b, err := strconv.ParseBool(s); err != nil {
+					v.errs = append(v.errs, fmt.Errorf("invalid setID value (%s): %s: %w", s, fmt.Sprintf("%s.%s", v.packageName, v.functionName), err))
+				} else if b {
+					if d.IdentityIDAttribute != "" {
+						v.errs = append(v.errs, fmt.Errorf("multiple identity attributes with setID: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					}
+
+					d.IdentityIDAttribute = attr
+				}
+			}
+		case "ArnIdentity":
+			if d.ARNIdentityAttribute != "" {
+				v.errs = append(v.errs, fmt.Errorf("multiple ArnIdentity annotations: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+			}
+
+			d.ARNIdentityAttribute = "names.AttrARN"
+			if len(args.Positional) > 0 {
+				d.ARNIdentityAttribute = namesgen.ConstOrQuote(args.Positional[0])
+			}
+
+			if attr, ok := args.Keyword["resource"]; ok {
+				d.ARNIdentityResource = attr
+			}
+		case "ImportIDSeparator":
+			if len(args.Positional) == 0 {
+				v.errs = append(v.errs, fmt.Errorf("no import ID separator: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				continue
+			}
+
+			d.ImportIDSeparator = args.Positional[0]
+		case "Stateful":
+			d.Stateful = true
+		}
+	}
+
+	if len(d.IdentityAttributes) == 0 && (d.ARNIdentityAttribute != "" || d.ImportIDSeparator != "") {
+		v.errs = append(v.errs, fmt.Errorf("no IdentityAttribute annotations: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+	}
+
 	for _, line := range funcDecl.Doc.List {
 		line := line.Text
 
@@ -240,6 +308,11 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 
 			switch annotationName := m[1]; annotationName {
 			case "FrameworkDataSource":
+				if len(d.IdentityAttributes) > 0 {
+					v.errs = append(v.errs, fmt.Errorf("identity annotations are only supported on Framework Resources: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+
 				if slices.ContainsFunc(v.frameworkDataSources, func(d ResourceDatum) bool { return d.FactoryName == v.functionName }) {
 					v.errs = append(v.errs, fmt.Errorf("duplicate Framework Data Source: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
 				} else {
@@ -252,6 +325,11 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 					v.frameworkResources = append(v.frameworkResources, d)
 				}
 			case "SDKDataSource":
+				if len(d.IdentityAttributes) > 0 {
+					v.errs = append(v.errs, fmt.Errorf("identity annotations are only supported on Framework Resources: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+
 				if len(args.Positional) == 0 {
 					v.errs = append(v.errs, fmt.Errorf("no type name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
 					continue
@@ -265,6 +343,11 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 					v.sdkDataSources[typeName] = d
 				}
 			case "SDKResource":
+				if len(d.IdentityAttributes) > 0 {
+					v.errs = append(v.errs, fmt.Errorf("identity annotations are only supported on Framework Resources: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+
 				if len(args.Positional) == 0 {
 					v.errs = append(v.errs, fmt.Errorf("no type name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
 					continue
@@ -277,7 +360,7 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				} else {
 					v.sdkResources[typeName] = d
 				}
-			case "Tags":
+			case "ArnIdentity", "IdentityAttribute", "ImportIDSeparator", "Stateful", "Tags":
 				// Handled above.
 			case "Testing":
 				// Ignored.
@@ -45,7 +45,7 @@ func main() {
 
 	servicePackage := os.Getenv("GOPACKAGE")
 
-	g.Infof("Generating tagging tests for internal/service/%s", servicePackage)
+	g.Infof("Generating tagging and identity tests for internal/service/%s", servicePackage)
 
 	var (
 		svc   serviceRecords
@@ -263,6 +263,31 @@ func main() {
 		}
 	}
 
+	for _, resource := range v.identityResources {
+		sourceName := resource.FileName
+		ext := filepath.Ext(sourceName)
+		sourceName = strings.TrimSuffix(sourceName, ext)
+		sourceName = strings.TrimSuffix(sourceName, "_")
+
+		resource.ProviderPackage = servicePackage
+
+		filename := fmt.Sprintf("%s_identity_gen_test.go", sourceName)
+
+		d := g.NewGoFileDestination(filename)
+		templates, err := template.New("identitytests").Parse(identityTestGoTmpl)
+		if err != nil {
+			g.Fatalf("parsing base Go test template: %s", err)
+		}
+
+		if err := d.BufferTemplateSet(templates, resource); err != nil {
+			g.Fatalf("error generating %q service package data: %s", servicePackage, err)
+		}
+
+		if err := d.Write(); err != nil {
+			g.Fatalf("generating file (%s): %s", filename, err)
+		}
+	}
+
 	filename := "tags_gen_test.go"
 
 	d := g.NewGoFileDestination(filename)
@@ -364,6 +389,7 @@ type ResourceDatum struct {
 	PackageProviderNameUpper         string
 	Name                             string
 	TypeName                         string
+	FactoryName                      string
 	DestroyTakesT                    bool
 	ExistsTypeName                   string
 	ExistsTakesT                     bool
@@ -451,6 +477,9 @@ var testTfTmpl string
 //go:embed tags_check.go.gtpl
 var tagsCheckTmpl string
 
+//go:embed identity_test.go.gtpl
+var identityTestGoTmpl string
+
 // Annotation processing.
 var (
 	annotation = regexp.MustCompile(`^//\s*@([0-9A-Za-z]+)(\((.*)\))?\s*$`) // nosemgrep:ci.calling-regexp.MustCompile-directly
@@ -468,7 +497,8 @@ type visitor struct {
 	functionName string
 	packageName  string
 
-	taggedResources []ResourceDatum
+	taggedResources   []ResourceDatum
+	identityResources []ResourceDatum
 }
 
 // processDir scans a single service package directory and processes contained Go sources files.
@@ -512,11 +542,14 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 
 	// Look first for tagging annotations.
 	d := ResourceDatum{
+		FactoryName:      v.functionName,
 		FileName:         v.fileName,
 		additionalTfVars: make(map[string]string),
 	}
 	tagged := false
 	skip := false
+	hasIdentity := false
+	skipIdentity := false
 	generatorSeen := false
 	tlsKey := false
 	var tlsKeyCN string
@@ -570,6 +603,9 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 					d.Name = m[1]
 				}
 
+			case "IdentityAttribute":
+				hasIdentity = true
+
 			case "Tags":
 				tagged = true
 				args := common.ParseArgs(m[3])
@@ -642,6 +678,15 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 						generatorSeen = true
 					}
 				}
+				if attr, ok := args.Keyword["identityTest"]; ok {
+					if b, err := strconv.ParseBool(attr); err != nil {
+						v.errs = append(v.errs, fmt.Errorf("invalid identityTest value: %q at %s. Should be boolean value.", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+						continue
+					} else if !b {
+						v.g.Infof("Skipping identity test for %s.%s", v.packageName, v.functionName)
+						skipIdentity = true
+					}
+				}
 				if attr, ok := args.Keyword["importIgnore"]; ok {
 					d.ImportIgnore = strings.Split(attr, ";")
 
@@ -787,6 +832,14 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 		d.additionalTfVars["private_key_pem"] = "privateKeyPEM"
 	}
 
+	if hasIdentity && !skipIdentity && d.Implementation == implementationFramework && !d.IsDataSource {
+		if d.Name == "" {
+			v.errs = append(v.errs, fmt.Errorf("no name parameter set: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+			return
+		}
+		v.identityResources = append(v.identityResources, d)
+	}
+
 	if tagged {
 		if !skip {
 			if d.Name == "" {
@@ -34,5 +34,7 @@ func ProtoV5ProviderServerFactory(ctx context.Context) (func() tfprotov5.Provide
 		return nil, nil, err
 	}
 
-	return muxServer.ProviderServer, primary, nil
+	server := newReplacementProviderServer(muxServer.ProviderServer(), primary)
+
+	return func() tfprotov5.ProviderServer { return server }, primary, nil
 }
@@ -6,6 +6,7 @@ package fwprovider
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -16,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/identity"
 	"github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -359,16 +361,21 @@ func (r tagsDataSourceInterceptor) read(ctx context.Context, request datasource.
 type wrappedResource struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
 	bootstrapContext contextFunc
-	inner            resource.ResourceWithConfigure
-	interceptors     resourceInterceptors
-	meta             *conns.AWSClient
+	// identity is the resource's declared identity, used for import.
+	identity     *types.ServicePackageResourceIdentity
+	inner        resource.ResourceWithConfigure
+	interceptors resourceInterceptors
+	meta         *conns.AWSClient
+	typeName     string
 }
 
-func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors) resource.ResourceWithConfigure {
+func newWrappedResource(bootstrapContext contextFunc, typeName string, inner resource.ResourceWithConfigure, interceptors resourceInterceptors, resourceIdentity *types.ServicePackageResourceIdentity) resource.ResourceWithConfigure {
 	return &wrappedResource{
 		bootstrapContext: bootstrapContext,
+		identity:         resourceIdentity,
 		inner:            inner,
 		interceptors:     interceptors,
+		typeName:         typeName,
 	}
 }
 
@@ -428,9 +435,23 @@ func (w *wrappedResource) Configure(ctx context.Context, request resource.Config
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
 	w.inner.Configure(ctx, request, response)
+
+	if w.meta != nil {
+		if v, ok := w.inner.(resourceWithDefaultTimeouts); ok {
+			setDefaultTimeouts(v, w.meta.DefaultTimeouts(ctx, w.typeName))
+		}
+	}
 }
 
 func (w *wrappedResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	// A declared identity takes precedence over any resource-specific import.
+	if w.identity != nil {
+		ctx = w.bootstrapContext(ctx, w.meta)
+		identity.ImportState(ctx, w.identity, request, response)
+
+		return
+	}
+
 	if v, ok := w.inner.(resource.ResourceWithImportState); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
 		v.ImportState(ctx, request, response)
@@ -487,6 +508,31 @@ func (w *wrappedResource) MoveState(ctx context.Context) []resource.StateMover {
 	return nil
 }
 
+// resourceWithDefaultTimeouts is implemented by resources that embed framework.WithTimeouts.
+type resourceWithDefaultTimeouts interface {
+	SetDefaultCreateTimeout(time.Duration)
+	SetDefaultReadTimeout(time.Duration)
+	SetDefaultUpdateTimeout(time.Duration)
+	SetDefaultDeleteTimeout(time.Duration)
+}
+
+// setDefaultTimeouts overrides a resource's default operation timeouts with those from the provider configuration.
+// Timeouts set in the resource's `timeouts` block take precedence over the defaults.
+func setDefaultTimeouts(r resourceWithDefaultTimeouts, timeouts conns.DefaultTimeouts) {
+	if timeouts.Create > 0 {
+		r.SetDefaultCreateTimeout(timeouts.Create)
+	}
+	if timeouts.Read > 0 {
+		r.SetDefaultReadTimeout(timeouts.Read)
+	}
+	if timeouts.Update > 0 {
+		r.SetDefaultUpdateTimeout(timeouts.Update)
+	}
+	if timeouts.Delete > 0 {
+		r.SetDefaultDeleteTimeout(timeouts.Delete)
+	}
+}
+
 // tagsResourceInterceptor implements transparent tagging for resources.
 type tagsResourceInterceptor struct {
 	tags *types.ServicePackageResourceTags
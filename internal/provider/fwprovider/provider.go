@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/identity"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -93,6 +94,11 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "Comma-separated list of hosts that should not use HTTP or HTTPS proxies. Can also be set using the `NO_PROXY` or `no_proxy` environment variables.",
 			},
+			"prevent_replacement_of": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Resource types, e.g. `aws_db_instance`, for which a planned replacement is reported as an error instead of a warning.",
+			},
 			"profile": schema.StringAttribute{
 				Optional:    true,
 				Description: "The profile for API operations. If not set, the default profile\ncreated with `aws configure` will be used.",
@@ -266,6 +272,33 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"default_timeouts": schema.ListNestedBlock{
+				Description: "Configuration blocks with default operation timeouts for resources of matching types.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"create": schema.StringAttribute{
+							Optional:    true,
+							Description: "Default timeout for creating resources, e.g. `2h`.",
+						},
+						"delete": schema.StringAttribute{
+							Optional:    true,
+							Description: "Default timeout for deleting resources, e.g. `2h`.",
+						},
+						"read": schema.StringAttribute{
+							Optional:    true,
+							Description: "Default timeout for reading resources, e.g. `2h`.",
+						},
+						"resource_type": schema.StringAttribute{
+							Required:    true,
+							Description: "Resource type to which the timeouts apply, e.g. `aws_rds_cluster`. Can contain `*` wildcards, e.g. `aws_rds_*`.",
+						},
+						"update": schema.StringAttribute{
+							Optional:    true,
+							Description: "Default timeout for updating resources, e.g. `2h`.",
+						},
+					},
+				},
+			},
 			"endpoints": endpointsBlock(),
 			"ignore_tags": schema.ListNestedBlock{
 				Validators: []validator.List{
@@ -443,8 +476,20 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 				interceptors = append(interceptors, tagsResourceInterceptor{tags: v.Tags})
 			}
 
+			if v.Identity != nil {
+				// The resource has declared its identity.
+				// Ensure that it's consistent with the schema.
+				schemaResponse := resource.SchemaResponse{}
+				inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+				if err := identity.Validate(ctx, v.Identity, schemaResponse.Schema); err != nil {
+					errs = append(errs, fmt.Errorf("%s: %w", typeName, err))
+					continue
+				}
+			}
+
 			resources = append(resources, func() resource.Resource {
-				return newWrappedResource(bootstrapContext, inner, interceptors)
+				return newWrappedResource(bootstrapContext, typeName, inner, interceptors, v.Identity)
 			})
 		}
 	}
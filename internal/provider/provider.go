@@ -78,6 +78,40 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					},
 				},
 			},
+			"default_timeouts": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Configuration blocks with default operation timeouts for resources of matching types.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"create": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Default timeout for creating resources, e.g. `2h`.",
+						},
+						"delete": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Default timeout for deleting resources, e.g. `2h`.",
+						},
+						"read": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Default timeout for reading resources, e.g. `2h`.",
+						},
+						"resource_type": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Resource type to which the timeouts apply, e.g. `aws_rds_cluster`. Can contain `*` wildcards, e.g. `aws_rds_*`.",
+						},
+						"update": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Default timeout for updating resources, e.g. `2h`.",
+						},
+					},
+				},
+			},
 			"ec2_metadata_service_endpoint": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -152,6 +186,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Comma-separated list of hosts that should not use HTTP or HTTPS proxies. " +
 					"Can also be set using the `NO_PROXY` or `no_proxy` environment variables.",
 			},
+			"prevent_replacement_of": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+				Description: "Resource types, e.g. `aws_db_instance`, for which a planned replacement " +
+					"is reported as an error instead of a warning.",
+			},
 			"profile": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -565,6 +606,15 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.DefaultTagsConfig = expandDefaultTags(ctx, nil)
 	}
 
+	if v, ok := d.GetOk("default_timeouts"); ok && len(v.([]interface{})) > 0 {
+		timeouts, dx := expandDefaultTimeouts(ctx, cty.GetAttrPath("default_timeouts"), v.([]interface{}))
+		diags = append(diags, dx...)
+		if dx.HasError() {
+			return nil, diags
+		}
+		config.DefaultTimeouts = timeouts
+	}
+
 	v := d.Get("endpoints")
 	endpoints, dx := expandEndpoints(ctx, v.(*schema.Set).List())
 	diags = append(diags, dx...)
@@ -602,6 +652,10 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("prevent_replacement_of"); ok && v.(*schema.Set).Len() > 0 {
+		config.PreventReplacementOf = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
 	if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]interface{})) > 0 {
 		config.SharedCredentialsFiles = flex.ExpandStringValueList(v.([]interface{}))
 	}
@@ -631,9 +685,39 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		return nil, diags
 	}
 
+	if len(config.DefaultTimeouts) > 0 {
+		setDefaultTimeouts(ctx, provider.ResourcesMap, meta)
+	}
+
 	return meta, diags
 }
 
+// setDefaultTimeouts overrides resources' default operation timeouts with those from the provider configuration.
+// Only operations for which a resource declares a timeout are affected.
+// Timeouts set in a resource's `timeouts` block take precedence over the defaults.
+func setDefaultTimeouts(ctx context.Context, resources map[string]*schema.Resource, meta *conns.AWSClient) {
+	for typeName, r := range resources {
+		if r.Timeouts == nil {
+			continue
+		}
+
+		defaults := meta.DefaultTimeouts(ctx, typeName)
+		set := func(timeout **time.Duration, v time.Duration) {
+			if *timeout != nil && v > 0 {
+				*timeout = &v
+			}
+		}
+
+		// Copy so that any timeouts shared between resources are left unchanged.
+		timeouts := *r.Timeouts
+		set(&timeouts.Create, defaults.Create)
+		set(&timeouts.Read, defaults.Read)
+		set(&timeouts.Update, defaults.Update)
+		set(&timeouts.Delete, defaults.Delete)
+		r.Timeouts = &timeouts
+	}
+}
+
 func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
@@ -870,6 +954,59 @@ func expandAssumeRoleWithWebIdentity(_ context.Context, tfMap map[string]interfa
 	return &assumeRole
 }
 
+func expandDefaultTimeouts(_ context.Context, path cty.Path, tfList []interface{}) ([]conns.DefaultTimeouts, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var apiObjects []conns.DefaultTimeouts
+
+	for i, tfMapRaw := range tfList {
+		path := path.IndexInt(i)
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			diags = append(diags, errs.NewAttributeRequiredError(path, "resource_type"))
+			continue
+		}
+
+		apiObject := conns.DefaultTimeouts{}
+
+		if v, ok := tfMap["resource_type"].(string); ok && v != "" {
+			if err := conns.ValidateResourceTypePattern(v); err != nil {
+				diags = append(diags, errs.NewInvalidValueAttributeErrorf(path.GetAttr("resource_type"), "invalid pattern %q: %s", v, err))
+				continue
+			}
+
+			apiObject.ResourceType = v
+		} else {
+			diags = append(diags, errs.NewAttributeRequiredError(path, "resource_type"))
+			continue
+		}
+
+		for _, v := range []struct {
+			key     string
+			timeout *time.Duration
+		}{
+			{"create", &apiObject.Create},
+			{"delete", &apiObject.Delete},
+			{"read", &apiObject.Read},
+			{"update", &apiObject.Update},
+		} {
+			k, timeout := v.key, v.timeout
+			if v, ok := tfMap[k].(string); ok && v != "" {
+				d, err := time.ParseDuration(v)
+				if err != nil {
+					diags = append(diags, errs.NewInvalidValueAttributeErrorf(path.GetAttr(k), "invalid duration %q: %s", v, err))
+					continue
+				}
+
+				*timeout = d
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects, diags
+}
+
 func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftags.DefaultConfig {
 	tags := make(map[string]interface{})
 	for _, ev := range os.Environ() {
@@ -8,11 +8,14 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -422,6 +425,81 @@ func TestExpandIgnoreTags(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestExpandDefaultTimeouts(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testcases := map[string]struct {
+		tfList        []interface{}
+		expected      []conns.DefaultTimeouts
+		expectedDiags diag.Diagnostics
+	}{
+		"valid": {
+			tfList: []interface{}{
+				map[string]interface{}{
+					"resource_type": "aws_rds_cluster",
+					"create":        "2h",
+					"delete":        "90m",
+					"read":          "",
+					"update":        "",
+				},
+				map[string]interface{}{
+					"resource_type": "aws_rds_*",
+					"create":        "",
+					"delete":        "",
+					"read":          "",
+					"update":        "1h",
+				},
+			},
+			expected: []conns.DefaultTimeouts{
+				{ResourceType: "aws_rds_cluster", Create: 2 * time.Hour, Delete: 90 * time.Minute},
+				{ResourceType: "aws_rds_*", Update: time.Hour},
+			},
+		},
+		"empty resource_type": {
+			tfList: []interface{}{
+				map[string]interface{}{
+					"resource_type": "",
+					"create":        "2h",
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				errs.NewAttributeRequiredError(cty.GetAttrPath("default_timeouts").IndexInt(0), "resource_type"),
+			},
+		},
+		"invalid duration": {
+			tfList: []interface{}{
+				map[string]interface{}{
+					"resource_type": "aws_rds_cluster",
+					"create":        "2 hours",
+				},
+			},
+			expected: []conns.DefaultTimeouts{
+				{ResourceType: "aws_rds_cluster"},
+			},
+			expectedDiags: diag.Diagnostics{
+				errs.NewInvalidValueAttributeErrorf(cty.GetAttrPath("default_timeouts").IndexInt(0).GetAttr("create"), "invalid duration %q: %s", "2 hours", `time: unknown unit " hours" in duration "2 hours"`),
+			},
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			results, diags := expandDefaultTimeouts(ctx, cty.GetAttrPath("default_timeouts"), testcase.tfList)
+
+			if diff := cmp.Diff(diags, testcase.expectedDiags, cmp.Comparer(sdkdiag.Comparer)); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(testcase.expected, results); diff != "" {
+				t.Errorf("unexpected default_timeouts diff: %s", diff)
+			}
+		})
+	}
+}
+
 func stashEnv() []string {
 	env := os.Environ()
 	os.Clearenv()
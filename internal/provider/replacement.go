@@ -0,0 +1,309 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"math/big"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// replacementProviderServer wraps a provider server and adds diagnostics to planned replacements of
+// stateful resources and of resources listed in the `prevent_replacement_of` provider argument.
+// Replacements are detected after planning so that all causes, e.g. ForceNew, RequiresReplace plan modifiers
+// and CustomizeDiff functions, are covered for both Plugin SDKv2 and Plugin Framework resources.
+type replacementProviderServer struct {
+	tfprotov5.ProviderServer
+	primary interface{ Meta() any }
+
+	once     sync.Once
+	schemas  map[string]*tfprotov5.Schema
+	stateful map[string]bool
+}
+
+func newReplacementProviderServer(server tfprotov5.ProviderServer, primary interface{ Meta() any }) tfprotov5.ProviderServer {
+	return &replacementProviderServer{
+		ProviderServer: server,
+		primary:        primary,
+	}
+}
+
+func (s *replacementProviderServer) PlanResourceChange(ctx context.Context, request *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	response, err := s.ProviderServer.PlanResourceChange(ctx, request)
+
+	if err != nil || response == nil || len(response.RequiresReplace) == 0 {
+		return response, err
+	}
+
+	if slices.ContainsFunc(response.Diagnostics, func(d *tfprotov5.Diagnostic) bool {
+		return d.Severity == tfprotov5.DiagnosticSeverityError
+	}) {
+		return response, nil
+	}
+
+	// The provider's parsed configuration is available through the primary provider's Meta() method.
+	meta, ok := s.primary.Meta().(*conns.AWSClient)
+	if !ok {
+		return response, nil
+	}
+
+	s.init(ctx, meta)
+
+	typeName := request.TypeName
+	prevent := meta.PreventsReplacementOf(ctx, typeName)
+	if !prevent && !s.stateful[typeName] {
+		return response, nil
+	}
+
+	schema, ok := s.schemas[typeName]
+	if !ok {
+		return response, nil
+	}
+
+	diags, err := replacementDiagnostics(typeName, schema, request.PriorState, response.PlannedState, response.RequiresReplace, prevent)
+
+	if err != nil {
+		tflog.Warn(ctx, "reporting resource replacement", map[string]any{
+			"tf_aws.resource_type": typeName,
+			"error":                err.Error(),
+		})
+
+		return response, nil
+	}
+
+	response.Diagnostics = append(response.Diagnostics, diags...)
+
+	return response, nil
+}
+
+// init determines the stateful resource types and caches the resource schemas.
+func (s *replacementProviderServer) init(ctx context.Context, meta *conns.AWSClient) {
+	s.once.Do(func() {
+		s.stateful = make(map[string]bool)
+
+		for _, sp := range meta.ServicePackages {
+			for _, v := range sp.SDKResources(ctx) {
+				if v.Stateful {
+					s.stateful[v.TypeName] = true
+				}
+			}
+
+			for _, v := range sp.FrameworkResources(ctx) {
+				if !v.Stateful {
+					continue
+				}
+
+				inner, err := v.Factory(ctx)
+
+				if err != nil {
+					continue
+				}
+
+				metadataResponse := resource.MetadataResponse{}
+				inner.Metadata(ctx, resource.MetadataRequest{}, &metadataResponse)
+				s.stateful[metadataResponse.TypeName] = true
+			}
+		}
+
+		response, err := s.ProviderServer.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+
+		if err != nil {
+			tflog.Warn(ctx, "reading provider schema", map[string]any{
+				"error": err.Error(),
+			})
+
+			return
+		}
+
+		if response != nil {
+			s.schemas = response.ResourceSchemas
+		}
+	})
+}
+
+// replacementDiagnostics returns a diagnostic for each of the attribute changes that require replacement of the resource.
+// If prevent is true the diagnostics are errors, otherwise they're warnings.
+func replacementDiagnostics(typeName string, schema *tfprotov5.Schema, priorState, plannedState *tfprotov5.DynamicValue, paths []*tftypes.AttributePath, prevent bool) ([]*tfprotov5.Diagnostic, error) {
+	if priorState == nil || plannedState == nil {
+		return nil, nil
+	}
+
+	typ := schema.ValueType()
+
+	prior, err := priorState.Unmarshal(typ)
+
+	if err != nil {
+		return nil, fmt.Errorf("decoding prior state: %w", err)
+	}
+
+	planned, err := plannedState.Unmarshal(typ)
+
+	if err != nil {
+		return nil, fmt.Errorf("decoding planned state: %w", err)
+	}
+
+	// Neither create nor destroy is a replacement.
+	if prior.IsNull() || planned.IsNull() {
+		return nil, nil
+	}
+
+	var diags []*tfprotov5.Diagnostic
+
+	for _, path := range paths {
+		name := formatAttributePath(path)
+		oldValue, newValue := "(sensitive value)", "(sensitive value)"
+		if !isSensitiveAttributePath(schema.Block, path) {
+			oldValue, newValue = formatValueAtPath(prior, path), formatValueAtPath(planned, path)
+		}
+
+		if prevent {
+			diags = append(diags, &tfprotov5.Diagnostic{
+				Severity:  tfprotov5.DiagnosticSeverityError,
+				Summary:   "Resource replacement prevented",
+				Detail:    fmt.Sprintf("Changing %s from %s to %s requires replacement of this %s, which the provider's prevent_replacement_of argument doesn't allow.\n\nRevert the change or remove %s from prevent_replacement_of to allow the replacement.", name, oldValue, newValue, typeName, typeName),
+				Attribute: path,
+			})
+		} else {
+			diags = append(diags, &tfprotov5.Diagnostic{
+				Severity:  tfprotov5.DiagnosticSeverityWarning,
+				Summary:   "Stateful resource will be replaced",
+				Detail:    fmt.Sprintf("Changing %s from %s to %s requires replacement of this %s. Replacement destroys the existing resource and any data it holds.\n\nTo make such plans fail instead, add %s to the provider's prevent_replacement_of argument.", name, oldValue, newValue, typeName, typeName),
+				Attribute: path,
+			})
+		}
+	}
+
+	return diags, nil
+}
+
+// isSensitiveAttributePath returns whether the specified path is within a sensitive attribute.
+func isSensitiveAttributePath(block *tfprotov5.SchemaBlock, path *tftypes.AttributePath) bool {
+	for _, step := range path.Steps() {
+		name, ok := step.(tftypes.AttributeName)
+		if !ok {
+			// Element keys select an object of the current nested block.
+			continue
+		}
+
+		if block == nil {
+			return false
+		}
+
+		if i := slices.IndexFunc(block.Attributes, func(v *tfprotov5.SchemaAttribute) bool { return v.Name == string(name) }); i >= 0 {
+			return block.Attributes[i].Sensitive
+		}
+
+		i := slices.IndexFunc(block.BlockTypes, func(v *tfprotov5.SchemaNestedBlock) bool { return v.TypeName == string(name) })
+		if i < 0 {
+			return false
+		}
+
+		block = block.BlockTypes[i].Block
+	}
+
+	return false
+}
+
+// formatAttributePath returns the specified path in Terraform's attribute reference syntax, e.g. `rule[0].name`.
+func formatAttributePath(path *tftypes.AttributePath) string {
+	var sb strings.Builder
+
+	for _, step := range path.Steps() {
+		switch step := step.(type) {
+		case tftypes.AttributeName:
+			if sb.Len() > 0 {
+				sb.WriteString(".")
+			}
+			sb.WriteString(string(step))
+		case tftypes.ElementKeyInt:
+			sb.WriteString("[" + strconv.FormatInt(int64(step), 10) + "]")
+		case tftypes.ElementKeyString:
+			sb.WriteString("[" + strconv.Quote(string(step)) + "]")
+		case tftypes.ElementKeyValue:
+			sb.WriteString("[" + formatValue(tftypes.Value(step)) + "]")
+		}
+	}
+
+	return sb.String()
+}
+
+func formatValueAtPath(val tftypes.Value, path *tftypes.AttributePath) string {
+	v, _, err := tftypes.WalkAttributePath(val, path)
+
+	if err != nil {
+		return "null"
+	}
+
+	if v, ok := v.(tftypes.Value); ok {
+		return formatValue(v)
+	}
+
+	return "null"
+}
+
+// formatValue returns the specified value in a form similar to Terraform's plan output.
+func formatValue(v tftypes.Value) string {
+	if !v.IsKnown() {
+		return "(known after apply)"
+	}
+
+	if v.IsNull() {
+		return "null"
+	}
+
+	switch typ := v.Type(); {
+	case typ.Is(tftypes.String):
+		var s string
+		if err := v.As(&s); err == nil {
+			return strconv.Quote(s)
+		}
+
+	case typ.Is(tftypes.Number):
+		var n big.Float
+		if err := v.As(&n); err == nil {
+			return n.Text('f', -1)
+		}
+
+	case typ.Is(tftypes.Bool):
+		var b bool
+		if err := v.As(&b); err == nil {
+			return strconv.FormatBool(b)
+		}
+
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}), typ.Is(tftypes.Tuple{}):
+		var elements []tftypes.Value
+		if err := v.As(&elements); err == nil {
+			s := make([]string, len(elements))
+			for i, v := range elements {
+				s[i] = formatValue(v)
+			}
+
+			return "[" + strings.Join(s, ", ") + "]"
+		}
+
+	case typ.Is(tftypes.Map{}), typ.Is(tftypes.Object{}):
+		var elements map[string]tftypes.Value
+		if err := v.As(&elements); err == nil {
+			s := make([]string, 0, len(elements))
+			for _, k := range slices.Sorted(maps.Keys(elements)) {
+				s = append(s, k+" = "+formatValue(elements[k]))
+			}
+
+			return "{" + strings.Join(s, ", ") + "}"
+		}
+	}
+
+	return v.String()
+}
@@ -0,0 +1,212 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestReplacementDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	schema := &tfprotov5.Schema{
+		Block: &tfprotov5.SchemaBlock{
+			Attributes: []*tfprotov5.SchemaAttribute{
+				{Name: "allocated_storage", Type: tftypes.Number, Optional: true},
+				{Name: "engine", Type: tftypes.String, Required: true},
+				{Name: names.AttrID, Type: tftypes.String, Computed: true},
+				{Name: "password", Type: tftypes.String, Optional: true, Sensitive: true},
+			},
+			BlockTypes: []*tfprotov5.SchemaNestedBlock{
+				{
+					TypeName: "rule",
+					Nesting:  tfprotov5.SchemaNestedBlockNestingModeList,
+					Block: &tfprotov5.SchemaBlock{
+						Attributes: []*tfprotov5.SchemaAttribute{
+							{Name: "name", Type: tftypes.String, Required: true},
+						},
+					},
+				},
+			},
+		},
+	}
+	typ := schema.ValueType().(tftypes.Object)
+	ruleType := typ.AttributeTypes["rule"].(tftypes.List).ElementType
+
+	newState := func(t *testing.T, engine, password, rule string) *tfprotov5.DynamicValue {
+		t.Helper()
+
+		v, err := tfprotov5.NewDynamicValue(typ, tftypes.NewValue(typ, map[string]tftypes.Value{
+			"allocated_storage": tftypes.NewValue(tftypes.Number, 20),
+			"engine":            tftypes.NewValue(tftypes.String, engine),
+			names.AttrID:        tftypes.NewValue(tftypes.String, "db-1"),
+			"password":          tftypes.NewValue(tftypes.String, password),
+			"rule": tftypes.NewValue(typ.AttributeTypes["rule"], []tftypes.Value{
+				tftypes.NewValue(ruleType, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, rule),
+				}),
+			}),
+		}))
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return &v
+	}
+
+	nullState := func(t *testing.T) *tfprotov5.DynamicValue {
+		t.Helper()
+
+		v, err := tfprotov5.NewDynamicValue(typ, tftypes.NewValue(typ, nil))
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return &v
+	}
+
+	testCases := map[string]struct {
+		prior, planned func(*testing.T) *tfprotov5.DynamicValue
+		paths          []*tftypes.AttributePath
+		prevent        bool
+		wantSeverity   tfprotov5.DiagnosticSeverity
+		wantDetails    []string
+	}{
+		"create": {
+			prior:   nullState,
+			planned: func(t *testing.T) *tfprotov5.DynamicValue { return newState(t, "mysql", "secret", "a") },
+			paths:   []*tftypes.AttributePath{tftypes.NewAttributePath().WithAttributeName("engine")},
+		},
+		"destroy": {
+			prior:   func(t *testing.T) *tfprotov5.DynamicValue { return newState(t, "mysql", "secret", "a") },
+			planned: nullState,
+			paths:   []*tftypes.AttributePath{tftypes.NewAttributePath().WithAttributeName("engine")},
+		},
+		"warning": {
+			prior:        func(t *testing.T) *tfprotov5.DynamicValue { return newState(t, "mysql", "secret", "a") },
+			planned:      func(t *testing.T) *tfprotov5.DynamicValue { return newState(t, "postgres", "secret", "a") },
+			paths:        []*tftypes.AttributePath{tftypes.NewAttributePath().WithAttributeName("engine")},
+			wantSeverity: tfprotov5.DiagnosticSeverityWarning,
+			wantDetails:  []string{`Changing engine from "mysql" to "postgres" requires replacement of this aws_db_instance.`},
+		},
+		"error": {
+			prior:        func(t *testing.T) *tfprotov5.DynamicValue { return newState(t, "mysql", "secret", "a") },
+			planned:      func(t *testing.T) *tfprotov5.DynamicValue { return newState(t, "postgres", "secret", "a") },
+			paths:        []*tftypes.AttributePath{tftypes.NewAttributePath().WithAttributeName("engine")},
+			prevent:      true,
+			wantSeverity: tfprotov5.DiagnosticSeverityError,
+			wantDetails:  []string{`Changing engine from "mysql" to "postgres" requires replacement of this aws_db_instance, which the provider's prevent_replacement_of argument doesn't allow.`},
+		},
+		"sensitive": {
+			prior:        func(t *testing.T) *tfprotov5.DynamicValue { return newState(t, "mysql", "secret", "a") },
+			planned:      func(t *testing.T) *tfprotov5.DynamicValue { return newState(t, "mysql", "hunter2", "a") },
+			paths:        []*tftypes.AttributePath{tftypes.NewAttributePath().WithAttributeName("password")},
+			wantSeverity: tfprotov5.DiagnosticSeverityWarning,
+			wantDetails:  []string{"Changing password from (sensitive value) to (sensitive value) requires"},
+		},
+		"nested": {
+			prior:        func(t *testing.T) *tfprotov5.DynamicValue { return newState(t, "mysql", "secret", "a") },
+			planned:      func(t *testing.T) *tfprotov5.DynamicValue { return newState(t, "postgres", "secret", "b") },
+			paths:        []*tftypes.AttributePath{tftypes.NewAttributePath().WithAttributeName("engine"), tftypes.NewAttributePath().WithAttributeName("rule").WithElementKeyInt(0).WithAttributeName("name")},
+			wantSeverity: tfprotov5.DiagnosticSeverityWarning,
+			wantDetails: []string{
+				`Changing engine from "mysql" to "postgres" requires`,
+				`Changing rule[0].name from "a" to "b" requires`,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags, err := replacementDiagnostics("aws_db_instance", schema, testCase.prior(t), testCase.planned(t), testCase.paths, testCase.prevent)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := len(diags), len(testCase.wantDetails); got != want {
+				t.Fatalf("got %d diagnostics, want %d", got, want)
+			}
+
+			for i, diag := range diags {
+				if got, want := diag.Severity, testCase.wantSeverity; got != want {
+					t.Errorf("diagnostic %d: got severity %v, want %v", i, got, want)
+				}
+
+				if got, want := diag.Detail, testCase.wantDetails[i]; !strings.Contains(got, want) {
+					t.Errorf("diagnostic %d: got detail %q, want it to contain %q", i, got, want)
+				}
+
+				if got, want := diag.Attribute, testCase.paths[i]; !got.Equal(want) {
+					t.Errorf("diagnostic %d: got attribute %s, want %s", i, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestFormatValue(t *testing.T) {
+	t.Parallel()
+
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"b": tftypes.Bool, "a": tftypes.Number}}
+
+	testCases := map[string]struct {
+		value tftypes.Value
+		want  string
+	}{
+		"null": {
+			value: tftypes.NewValue(tftypes.String, nil),
+			want:  "null",
+		},
+		"unknown": {
+			value: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			want:  "(known after apply)",
+		},
+		"string": {
+			value: tftypes.NewValue(tftypes.String, `a "b"`),
+			want:  `"a \"b\""`,
+		},
+		"number": {
+			value: tftypes.NewValue(tftypes.Number, 1000000),
+			want:  "1000000",
+		},
+		"fraction": {
+			value: tftypes.NewValue(tftypes.Number, 0.5),
+			want:  "0.5",
+		},
+		"list": {
+			value: tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "x"),
+				tftypes.NewValue(tftypes.String, "y"),
+			}),
+			want: `["x", "y"]`,
+		},
+		"object": {
+			value: tftypes.NewValue(objectType, map[string]tftypes.Value{
+				"a": tftypes.NewValue(tftypes.Number, 1),
+				"b": tftypes.NewValue(tftypes.Bool, true),
+			}),
+			want: "{a = 1, b = true}",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := formatValue(testCase.value), testCase.want; got != want {
+				t.Errorf("formatValue() = %s, want %s", got, want)
+			}
+		})
+	}
+}
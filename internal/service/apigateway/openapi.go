@@ -0,0 +1,196 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apigateway
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// openAPIDocument is an OpenAPI 3 or Swagger 2 definition decoded from JSON or YAML.
+type openAPIDocument map[string]any
+
+// openAPIOperationMethods maps the keys of an OpenAPI Path Item Object that describe operations to HTTP methods.
+var openAPIOperationMethods = map[string]string{
+	"delete":                         "DELETE",
+	"get":                            "GET",
+	"head":                           "HEAD",
+	"options":                        "OPTIONS",
+	"patch":                          "PATCH",
+	"post":                           "POST",
+	"put":                            "PUT",
+	"trace":                          "TRACE",
+	"x-amazon-apigateway-any-method": "ANY",
+}
+
+// parseOpenAPIDocument decodes an OpenAPI 3 or Swagger 2 definition in JSON or YAML format.
+func parseOpenAPIDocument(body string) (openAPIDocument, error) {
+	var v any
+
+	if strings.HasPrefix(strings.TrimSpace(body), "{") {
+		if err := json.Unmarshal([]byte(body), &v); err != nil {
+			return nil, fmt.Errorf("decoding OpenAPI definition as JSON: %w", err)
+		}
+	} else {
+		if err := yaml.Unmarshal([]byte(body), &v); err != nil {
+			return nil, fmt.Errorf("decoding OpenAPI definition as YAML: %w", err)
+		}
+
+		v = normalizeYAMLValue(v)
+	}
+
+	doc, ok := v.(map[string]any)
+	if !ok {
+		return nil, errors.New("OpenAPI definition is not an object")
+	}
+
+	if _, ok := doc["openapi"]; !ok {
+		if _, ok := doc["swagger"]; !ok {
+			return nil, errors.New(`OpenAPI definition has neither an "openapi" nor a "swagger" field`)
+		}
+	}
+
+	return doc, nil
+}
+
+// normalizeYAMLValue converts the map[interface{}]interface{} values produced by the YAML decoder
+// into map[string]any so that YAML and JSON documents decode to the same representation.
+func normalizeYAMLValue(v any) any {
+	switch v := v.(type) {
+	case map[any]any:
+		m := make(map[string]any, len(v))
+		for k, v := range v {
+			m[fmt.Sprint(k)] = normalizeYAMLValue(v)
+		}
+		return m
+	case []any:
+		for i := range v {
+			v[i] = normalizeYAMLValue(v[i])
+		}
+		return v
+	case int:
+		return float64(v)
+	case int64:
+		return float64(v)
+	case uint64:
+		return float64(v)
+	default:
+		return v
+	}
+}
+
+// canonicalJSON returns the document as compact JSON with sorted object keys.
+func (doc openAPIDocument) canonicalJSON() ([]byte, error) {
+	return json.Marshal(map[string]any(doc))
+}
+
+// operations returns the document's operations as sorted "METHOD /path" strings.
+func (doc openAPIDocument) operations() []string {
+	paths, ok := doc["paths"].(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	var operations []string
+
+	for path, v := range paths {
+		item, ok := v.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		for k := range item {
+			if method, ok := openAPIOperationMethods[strings.ToLower(k)]; ok {
+				operations = append(operations, method+" "+path)
+			}
+		}
+	}
+
+	slices.Sort(operations)
+
+	return operations
+}
+
+// openAPIBodiesEquivalent returns whether two REST API bodies are semantically equivalent OpenAPI definitions,
+// ignoring formatting, key order and the choice of JSON or YAML.
+func openAPIBodiesEquivalent(old, new string) bool {
+	if old == new {
+		return true
+	}
+
+	oldDoc, err := parseOpenAPIDocument(old)
+	if err != nil {
+		return false
+	}
+
+	newDoc, err := parseOpenAPIDocument(new)
+	if err != nil {
+		return false
+	}
+
+	oldJSON, err := oldDoc.canonicalJSON()
+	if err != nil {
+		return false
+	}
+
+	newJSON, err := newDoc.canonicalJSON()
+	if err != nil {
+		return false
+	}
+
+	return string(oldJSON) == string(newJSON)
+}
+
+// openAPIBodyHash returns a SHA-256 hash of the canonical form of a REST API body.
+// Bodies that aren't OpenAPI definitions are hashed as is.
+func openAPIBodyHash(body string) string {
+	if body == "" {
+		return ""
+	}
+
+	b := []byte(body)
+
+	if doc, err := parseOpenAPIDocument(body); err == nil {
+		if v, err := doc.canonicalJSON(); err == nil {
+			b = v
+		}
+	}
+
+	hash := sha256.Sum256(b)
+
+	return hex.EncodeToString(hash[:])
+}
+
+// removedOpenAPIOperations returns the operations defined in old but not in new.
+// Nothing is returned unless both bodies are OpenAPI definitions.
+func removedOpenAPIOperations(old, new string) []string {
+	oldDoc, err := parseOpenAPIDocument(old)
+	if err != nil {
+		return nil
+	}
+
+	newDoc, err := parseOpenAPIDocument(new)
+	if err != nil {
+		return nil
+	}
+
+	newOperations := newDoc.operations()
+
+	var removed []string
+
+	for _, v := range oldDoc.operations() {
+		if _, found := slices.BinarySearch(newOperations, v); !found {
+			removed = append(removed, v)
+		}
+	}
+
+	return removed
+}
@@ -0,0 +1,228 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apigateway
+
+import (
+	"slices"
+	"testing"
+)
+
+const testOpenAPIBodyJSON = `{
+  "openapi": "3.0.1",
+  "info": {"title": "example", "version": "1.0"},
+  "paths": {
+    "/pets": {
+      "get": {"x-amazon-apigateway-integration": {"type": "MOCK", "timeoutInMillis": 29000}},
+      "post": {"x-amazon-apigateway-integration": {"type": "MOCK", "timeoutInMillis": 29000}}
+    },
+    "/pets/{id}": {
+      "x-amazon-apigateway-any-method": {"x-amazon-apigateway-integration": {"type": "MOCK", "timeoutInMillis": 29000}}
+    }
+  }
+}`
+
+const testOpenAPIBodyYAML = `
+openapi: 3.0.1
+info:
+  version: "1.0"
+  title: example
+paths:
+  /pets/{id}:
+    x-amazon-apigateway-any-method:
+      x-amazon-apigateway-integration:
+        timeoutInMillis: 29000
+        type: MOCK
+  /pets:
+    post:
+      x-amazon-apigateway-integration:
+        timeoutInMillis: 29000
+        type: MOCK
+    get:
+      x-amazon-apigateway-integration:
+        timeoutInMillis: 29000
+        type: MOCK
+`
+
+const testOpenAPIBodyYAMLGetOnly = `
+openapi: 3.0.1
+info:
+  version: "1.0"
+  title: example
+paths:
+  /pets:
+    get:
+      x-amazon-apigateway-integration:
+        timeoutInMillis: 29000
+        type: MOCK
+`
+
+func TestParseOpenAPIDocument(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		body               string
+		expectedOperations []string
+		expectError        bool
+	}{
+		"json": {
+			body:               testOpenAPIBodyJSON,
+			expectedOperations: []string{"ANY /pets/{id}", "GET /pets", "POST /pets"},
+		},
+		"yaml": {
+			body:               testOpenAPIBodyYAML,
+			expectedOperations: []string{"ANY /pets/{id}", "GET /pets", "POST /pets"},
+		},
+		"swagger": {
+			body:               `{"swagger": "2.0", "paths": {"/": {"GET": {}}}}`,
+			expectedOperations: []string{"GET /"},
+		},
+		"not openapi": {
+			body:        `{"title": "example"}`,
+			expectError: true,
+		},
+		"not an object": {
+			body:        `- openapi`,
+			expectError: true,
+		},
+		"invalid json": {
+			body:        `{"openapi": `,
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			doc, err := parseOpenAPIDocument(testCase.body)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("err = %v, expectError = %t", err, want)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if got, want := doc.operations(), testCase.expectedOperations; !slices.Equal(got, want) {
+				t.Errorf("operations = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestOpenAPIBodiesEquivalent(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		old      string
+		new      string
+		expected bool
+	}{
+		"identical": {
+			old:      testOpenAPIBodyJSON,
+			new:      testOpenAPIBodyJSON,
+			expected: true,
+		},
+		"json and yaml": {
+			old:      testOpenAPIBodyJSON,
+			new:      testOpenAPIBodyYAML,
+			expected: true,
+		},
+		"key order and whitespace": {
+			old:      `{"openapi": "3.0.1", "info": {"title": "example", "version": "1.0"}}`,
+			new:      `{"info":{"version":"1.0","title":"example"},"openapi":"3.0.1"}`,
+			expected: true,
+		},
+		"operation removed": {
+			old:      testOpenAPIBodyYAML,
+			new:      testOpenAPIBodyYAMLGetOnly,
+			expected: false,
+		},
+		"not openapi": {
+			old:      `{"a": 1}`,
+			new:      `{"a":1}`,
+			expected: false,
+		},
+		"empty": {
+			old:      "",
+			new:      testOpenAPIBodyJSON,
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := openAPIBodiesEquivalent(testCase.old, testCase.new), testCase.expected; got != want {
+				t.Errorf("openAPIBodiesEquivalent = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestOpenAPIBodyHash(t *testing.T) {
+	t.Parallel()
+
+	if got := openAPIBodyHash(""); got != "" {
+		t.Errorf("openAPIBodyHash(\"\") = %q, want empty", got)
+	}
+
+	jsonHash, yamlHash := openAPIBodyHash(testOpenAPIBodyJSON), openAPIBodyHash(testOpenAPIBodyYAML)
+
+	if len(jsonHash) != 64 {
+		t.Errorf("openAPIBodyHash length = %d, want 64", len(jsonHash))
+	}
+
+	if jsonHash != yamlHash {
+		t.Errorf("JSON hash %q != YAML hash %q", jsonHash, yamlHash)
+	}
+
+	if got := openAPIBodyHash(testOpenAPIBodyYAMLGetOnly); got == yamlHash {
+		t.Errorf("hash of changed body = %q, want a different hash", got)
+	}
+
+	if got, want := openAPIBodyHash("not openapi"), openAPIBodyHash("not openapi"); got != want || got == "" {
+		t.Errorf("hash of non-OpenAPI body = %q, want stable non-empty hash", got)
+	}
+}
+
+func TestRemovedOpenAPIOperations(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		old      string
+		new      string
+		expected []string
+	}{
+		"none removed": {
+			old: testOpenAPIBodyJSON,
+			new: testOpenAPIBodyYAML,
+		},
+		"removed": {
+			old:      testOpenAPIBodyJSON,
+			new:      testOpenAPIBodyYAMLGetOnly,
+			expected: []string{"ANY /pets/{id}", "POST /pets"},
+		},
+		"added": {
+			old: testOpenAPIBodyYAMLGetOnly,
+			new: testOpenAPIBodyJSON,
+		},
+		"old not openapi": {
+			old: "",
+			new: testOpenAPIBodyJSON,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := removedOpenAPIOperations(testCase.old, testCase.new), testCase.expected; !slices.Equal(got, want) {
+				t.Errorf("removedOpenAPIOperations = %q, want %q", got, want)
+			}
+		})
+	}
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/apigateway/types"
 	awspolicy "github.com/hashicorp/awspolicyequivalence"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -69,6 +70,13 @@ func resourceRestAPI() *schema.Resource {
 			"body": {
 				Type:     schema.TypeString,
 				Optional: true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return openAPIBodiesEquivalent(old, new)
+				},
+			},
+			"body_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
 			},
 			names.AttrCreatedDate: {
 				Type:     schema.TypeString,
@@ -165,6 +173,11 @@ func resourceRestAPI() *schema.Resource {
 					return false
 				},
 			},
+			"removed_operations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"root_resource_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -173,7 +186,10 @@ func resourceRestAPI() *schema.Resource {
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceRestAPICustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -297,6 +313,7 @@ func resourceRestAPIRead(ctx context.Context, d *schema.ResourceData, meta inter
 	d.Set("api_key_source", api.ApiKeySource)
 	d.Set(names.AttrARN, apiARN(ctx, meta.(*conns.AWSClient), d.Id()))
 	d.Set("binary_media_types", api.BinaryMediaTypes)
+	d.Set("body_hash", openAPIBodyHash(d.Get("body").(string)))
 	d.Set(names.AttrCreatedDate, api.CreatedDate.Format(time.RFC3339))
 	d.Set(names.AttrDescription, api.Description)
 	d.Set("disable_execute_api_endpoint", api.DisableExecuteApiEndpoint)
@@ -499,6 +516,11 @@ func resourceRestAPIUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			}
 		}
 
+		if d.HasChange("body") {
+			o, n := d.GetChange("body")
+			d.Set("removed_operations", removedOpenAPIOperations(o.(string), n.(string)))
+		}
+
 		if d.HasChanges("body", names.AttrParameters) {
 			if body, ok := d.GetOk("body"); ok {
 				// Terraform implementation uses the `overwrite` mode by default.
@@ -568,6 +590,33 @@ func resourceRestAPIDelete(ctx context.Context, d *schema.ResourceData, meta int
 	return diags
 }
 
+func resourceRestAPICustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Diff suppression already hides bodies that are semantically equivalent.
+	if !d.HasChange("body") {
+		return nil
+	}
+
+	if !d.NewValueKnown("body") {
+		if err := d.SetNewComputed("removed_operations"); err != nil {
+			return err
+		}
+
+		return d.SetNewComputed("body_hash")
+	}
+
+	o, n := d.GetChange("body")
+
+	// Plan-time diagnostics can't be returned from CustomizeDiff, so removed operations are shown in the plan
+	// as the new value of a computed attribute.
+	if d.Id() != "" {
+		if err := d.SetNew("removed_operations", removedOpenAPIOperations(o.(string), n.(string))); err != nil {
+			return err
+		}
+	}
+
+	return d.SetNew("body_hash", openAPIBodyHash(n.(string)))
+}
+
 func findRestAPIByID(ctx context.Context, conn *apigateway.Client, id string) (*apigateway.GetRestApiOutput, error) {
 	input := &apigateway.GetRestApiInput{
 		RestApiId: aws.String(id),
@@ -284,7 +284,7 @@ func TestAccAPIGatewayRestAPI_APIKeySource_overrideBody(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_hash", "put_rest_api_mode", "removed_operations"},
 			},
 			// Verify updated API key source still overrides
 			{
@@ -329,7 +329,7 @@ func TestAccAPIGatewayRestAPI_APIKeySource_setByBody(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_hash", "put_rest_api_mode", "removed_operations"},
 			},
 		},
 	})
@@ -359,7 +359,7 @@ func TestAccAPIGatewayRestAPI_binaryMediaTypes(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_hash", "put_rest_api_mode", "removed_operations"},
 			},
 			{
 				Config: testAccRestAPIConfig_binaryMediaTypes1(rName, "application/octet"),
@@ -397,7 +397,7 @@ func TestAccAPIGatewayRestAPI_BinaryMediaTypes_overrideBody(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_hash", "put_rest_api_mode", "removed_operations"},
 			},
 			// Verify updated minimum compression size still overrides
 			{
@@ -445,7 +445,7 @@ func TestAccAPIGatewayRestAPI_BinaryMediaTypes_setByBody(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_hash", "put_rest_api_mode", "removed_operations"},
 			},
 		},
 	})
@@ -479,7 +479,7 @@ func TestAccAPIGatewayRestAPI_body(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_hash", "put_rest_api_mode", "removed_operations"},
 			},
 			{
 				Config: testAccRestAPIConfig_body(rName, "/update"),
@@ -495,6 +495,55 @@ func TestAccAPIGatewayRestAPI_body(t *testing.T) {
 	})
 }
 
+func TestAccAPIGatewayRestAPI_bodyEquivalent(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf apigateway.GetRestApiOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_api_gateway_rest_api.test"
+	var bodyHash string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.APIGatewayServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRESTAPIDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRestAPIConfig_body(rName, "/test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRESTAPIExists(ctx, resourceName, &conf),
+					resource.TestMatchResourceAttr(resourceName, "body_hash", regexache.MustCompile(`^[0-9a-f]{64}$`)),
+					resource.TestCheckResourceAttr(resourceName, "removed_operations.#", "0"),
+					func(s *terraform.State) error {
+						bodyHash = s.RootModule().Resources[resourceName].Primary.Attributes["body_hash"]
+						return nil
+					},
+				),
+			},
+			{
+				// The same definition in YAML, with different key order and formatting.
+				Config:   testAccRestAPIConfig_bodyYAML(rName, "/test"),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccRestAPIConfig_bodyYAML(rName, "/update"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRESTAPIExists(ctx, resourceName, &conf),
+					testAccCheckRestAPIRoutes(ctx, &conf, []string{"/", "/update"}),
+					resource.TestCheckResourceAttr(resourceName, "removed_operations.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "removed_operations.0", "GET /test"),
+					func(s *terraform.State) error {
+						if v := s.RootModule().Resources[resourceName].Primary.Attributes["body_hash"]; v == bodyHash {
+							return fmt.Errorf("body_hash (%s) not updated", v)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func TestAccAPIGatewayRestAPI_description(t *testing.T) {
 	ctx := acctest.Context(t)
 	var conf apigateway.GetRestApiOutput
@@ -518,7 +567,7 @@ func TestAccAPIGatewayRestAPI_description(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_hash", "put_rest_api_mode", "removed_operations"},
 			},
 			{
 				Config: testAccRestAPIConfig_description(rName, "description2"),
@@ -554,7 +603,7 @@ func TestAccAPIGatewayRestAPI_Description_overrideBody(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_hash", "put_rest_api_mode", "removed_operations"},
 			},
 			// Verify updated description still overrides
 			{
@@ -599,7 +648,7 @@ func TestAccAPIGatewayRestAPI_Description_setByBody(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_hash", "put_rest_api_mode", "removed_operations"},
 			},
 		},
 	})
@@ -667,7 +716,7 @@ func TestAccAPIGatewayRestAPI_DisableExecuteAPIEndpoint_overrideBody(t *testing.
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_hash", "put_rest_api_mode", "removed_operations"},
 			},
 			// Verify override can be unset (only for body set to false)
 			{
@@ -712,7 +761,7 @@ func TestAccAPIGatewayRestAPI_DisableExecuteAPIEndpoint_setByBody(t *testing.T)
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_hash", "put_rest_api_mode", "removed_operations"},
 			},
 		},
 	})
@@ -747,7 +796,7 @@ func TestAccAPIGatewayRestAPI_Endpoint_vpcEndpointIDs(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_hash", "put_rest_api_mode", "removed_operations"},
 			},
 			{
 				Config: testAccRestAPIConfig_endpointConfigurationVPCEndpointIds2(rName),
@@ -805,7 +854,7 @@ func TestAccAPIGatewayRestAPI_EndpointVPCEndpointIDs_overrideBody(t *testing.T)
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_hash", "put_rest_api_mode", "removed_operations"},
 			},
 			// Verify updated configuration value still overrides
 			{
@@ -862,7 +911,7 @@ func TestAccAPIGatewayRestAPI_EndpointVPCEndpointIDs_mergeBody(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_hash", "put_rest_api_mode", "removed_operations"},
 			},
 
 			// Verify updated endpoint configuration, and endpoint from OAS is discarded.
@@ -919,7 +968,7 @@ func TestAccAPIGatewayRestAPI_EndpointVPCEndpointIDs_overrideToMergeBody(t *test
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_hash", "put_rest_api_mode", "removed_operations"},
 			},
 
 			// Add the new attribute and verify works as desired.
@@ -937,7 +986,7 @@ func TestAccAPIGatewayRestAPI_EndpointVPCEndpointIDs_overrideToMergeBody(t *test
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_hash", "put_rest_api_mode", "removed_operations"},
 			},
 		},
 	})
@@ -969,7 +1018,7 @@ func TestAccAPIGatewayRestAPI_EndpointVPCEndpointIDs_setByBody(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_hash", "put_rest_api_mode", "removed_operations"},
 			},
 		},
 	})
@@ -998,7 +1047,7 @@ func TestAccAPIGatewayRestAPI_minimumCompressionSize(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_hash", "put_rest_api_mode", "removed_operations"},
 			},
 			{
 				Config: testAccRestAPIConfig_minimumCompressionSize(rName, "-1"), // -1 removes existing values
@@ -1041,7 +1090,7 @@ func TestAccAPIGatewayRestAPI_MinimumCompressionSize_overrideBody(t *testing.T)
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_hash", "put_rest_api_mode", "removed_operations"},
 			},
 			// Verify updated minimum compression size still overrides
 			{
@@ -1086,7 +1135,7 @@ func TestAccAPIGatewayRestAPI_MinimumCompressionSize_setByBody(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_hash", "put_rest_api_mode", "removed_operations"},
 			},
 		},
 	})
@@ -1116,7 +1165,7 @@ func TestAccAPIGatewayRestAPI_Name_overrideBody(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_hash", "put_rest_api_mode", "removed_operations"},
 			},
 			// Verify updated name still overrides
 			{
@@ -1168,7 +1217,7 @@ func TestAccAPIGatewayRestAPI_FailOnWarnings(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_hash", "put_rest_api_mode", "removed_operations"},
 			},
 			// Verify invalid body fails update, when fail_on_warnings is true
 			{
@@ -1352,7 +1401,7 @@ func TestAccAPIGatewayRestAPI_Policy_setByBody(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_hash", "put_rest_api_mode", "removed_operations"},
 			},
 		},
 	})
@@ -2247,6 +2296,36 @@ resource "aws_api_gateway_rest_api" "test" {
 `, rName, basePath)
 }
 
+func testAccRestAPIConfig_bodyYAML(rName string, basePath string) string {
+	return fmt.Sprintf(`
+resource "aws_api_gateway_rest_api" "test" {
+  name = %[1]q
+
+  body = <<-EOT
+swagger: "2.0"
+schemes:
+  - https
+info:
+  version: "2017-04-20T04:08:08Z"
+  title: test
+paths:
+  %[2]s:
+    get:
+      x-amazon-apigateway-integration:
+        uri: https://api.example.com/
+        type: HTTP
+        httpMethod: GET
+        responses:
+          default:
+            statusCode: 200
+      responses:
+        "200":
+          description: OK
+EOT
+}
+`, rName, basePath)
+}
+
 func testAccRestAPIConfig_description(rName string, description string) string {
 	return fmt.Sprintf(`
 resource "aws_api_gateway_rest_api" "test" {
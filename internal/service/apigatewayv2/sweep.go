@@ -17,7 +17,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_apigatewayv2_api", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_apigatewayv2_api", &resource.Sweeper{
 		Name: "aws_apigatewayv2_api",
 		F:    sweepAPIs,
 		Dependencies: []string{
@@ -25,12 +25,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_apigatewayv2_api_mapping", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_apigatewayv2_api_mapping", &resource.Sweeper{
 		Name: "aws_apigatewayv2_api_mapping",
 		F:    sweepAPIMappings,
 	})
 
-	resource.AddTestSweepers("aws_apigatewayv2_domain_name", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_apigatewayv2_domain_name", &resource.Sweeper{
 		Name: "aws_apigatewayv2_domain_name",
 		F:    sweepDomainNames,
 		Dependencies: []string{
@@ -38,7 +38,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_apigatewayv2_vpc_link", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_apigatewayv2_vpc_link", &resource.Sweeper{
 		Name: "aws_apigatewayv2_vpc_link",
 		F:    sweepVPCLinks,
 	})
@@ -19,7 +19,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_appconfig_application", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_appconfig_application", &resource.Sweeper{
 		Name: "aws_appconfig_application",
 		F:    sweepApplications,
 		Dependencies: []string{
@@ -29,7 +29,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_appconfig_configuration_profile", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_appconfig_configuration_profile", &resource.Sweeper{
 		Name: "aws_appconfig_configuration_profile",
 		F:    sweepConfigurationProfiles,
 		Dependencies: []string{
@@ -38,12 +38,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_appconfig_deployment_strategy", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_appconfig_deployment_strategy", &resource.Sweeper{
 		Name: "aws_appconfig_deployment_strategy",
 		F:    sweepDeploymentStrategies,
 	})
 
-	resource.AddTestSweepers("aws_appconfig_environment", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_appconfig_environment", &resource.Sweeper{
 		Name: "aws_appconfig_environment",
 		F:    sweepEnvironments,
 		Dependencies: []string{
@@ -51,12 +51,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_appconfig_hosted_configuration_version", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_appconfig_hosted_configuration_version", &resource.Sweeper{
 		Name: "aws_appconfig_hosted_configuration_version",
 		F:    sweepHostedConfigurationVersions,
 	})
 
-	resource.AddTestSweepers("aws_appconfig_extension_association", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_appconfig_extension_association", &resource.Sweeper{
 		Name: "aws_appconfig_extension_association",
 		F:    sweepExtensionAssociations,
 	})
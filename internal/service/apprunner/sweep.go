@@ -16,7 +16,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_apprunner_auto_scaling_configuration_version", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_apprunner_auto_scaling_configuration_version", &resource.Sweeper{
 		Name: "aws_apprunner_auto_scaling_configuration_version",
 		F:    sweepAutoScalingConfigurationVersions,
 		Dependencies: []string{
@@ -24,7 +24,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_apprunner_connection", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_apprunner_connection", &resource.Sweeper{
 		Name: "aws_apprunner_connection",
 		F:    sweepConnections,
 		Dependencies: []string{
@@ -32,7 +32,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_apprunner_service", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_apprunner_service", &resource.Sweeper{
 		Name: "aws_apprunner_service",
 		F:    sweepServices,
 	})
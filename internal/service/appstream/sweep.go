@@ -16,22 +16,22 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_appstream_directory_config", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_appstream_directory_config", &resource.Sweeper{
 		Name: "aws_appstream_directory_config",
 		F:    sweepDirectoryConfigs,
 	})
 
-	resource.AddTestSweepers("aws_appstream_fleet", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_appstream_fleet", &resource.Sweeper{
 		Name: "aws_appstream_fleet",
 		F:    sweepFleets,
 	})
 
-	resource.AddTestSweepers("aws_appstream_image_builder", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_appstream_image_builder", &resource.Sweeper{
 		Name: "aws_appstream_image_builder",
 		F:    sweepImageBuilders,
 	})
 
-	resource.AddTestSweepers("aws_appstream_stack", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_appstream_stack", &resource.Sweeper{
 		Name: "aws_appstream_stack",
 		F:    sweepStacks,
 	})
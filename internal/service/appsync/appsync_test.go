@@ -39,6 +39,7 @@ func TestAccAppSync_serial(t *testing.T) {
 			acctest.CtDisappears:        testAccGraphQLAPI_disappears,
 			"tags":                      testAccGraphQLAPI_tags,
 			"schema":                    testAccGraphQLAPI_schema,
+			"schemaInvalid":             testAccGraphQLAPI_schemaInvalid,
 			"apiType":                   testAccGraphQLAPI_apiType,
 			"authenticationType":        testAccGraphQLAPI_authenticationType,
 			"AuthenticationType_apiKey": testAccGraphQLAPI_AuthenticationType_apiKey,
@@ -83,6 +84,7 @@ func TestAccAppSync_serial(t *testing.T) {
 		"Resolver": {
 			acctest.CtBasic:      testAccResolver_basic,
 			"code":               testAccResolver_code,
+			"codeEvaluation":     testAccResolver_codeEvaluation,
 			acctest.CtDisappears: testAccResolver_disappears,
 			"dataSource":         testAccResolver_dataSource,
 			"DataSource_lambda":  testAccResolver_DataSource_lambda,
@@ -92,6 +94,7 @@ func TestAccAppSync_serial(t *testing.T) {
 			"pipeline":           testAccResolver_pipeline,
 			"caching":            testAccResolver_caching,
 			"sync":               testAccResolver_syncConfig,
+			"undefinedField":     testAccResolver_undefinedField,
 		},
 		"ApiCache": {
 			acctest.CtBasic:      testAccAPICache_basic,
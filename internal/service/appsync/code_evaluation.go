@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appsync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/appsync"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appsync/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	codeEvaluationFunctionRequest  = "request"
+	codeEvaluationFunctionResponse = "response"
+)
+
+func codeEvaluationFunction_Values() []string {
+	return []string{
+		codeEvaluationFunctionRequest,
+		codeEvaluationFunctionResponse,
+	}
+}
+
+// codeEvaluationSchema returns the schema for the plan-time "code_evaluation" block shared by resolvers and functions.
+func codeEvaluationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeList,
+		Optional:     true,
+		RequiredWith: []string{"code"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"context": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateFunc:     validation.StringIsJSON,
+					DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+				},
+				"function": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringInSlice(codeEvaluationFunction_Values(), false),
+				},
+			},
+		},
+	}
+}
+
+// customizeDiffEvaluateCode runs any configured code evaluations against APPSYNC_JS code during planning.
+func customizeDiffEvaluateCode(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChanges("code", "code_evaluation", "runtime") {
+		return nil
+	}
+
+	if !d.NewValueKnown("code") || !d.NewValueKnown("code_evaluation") || !d.NewValueKnown("runtime") {
+		return nil
+	}
+
+	code := d.Get("code").(string)
+	tfList := d.Get("code_evaluation").([]interface{})
+
+	if code == "" || len(tfList) == 0 {
+		return nil
+	}
+
+	runtime := expandRuntime(d.Get("runtime").([]interface{}))
+
+	if runtime == nil || runtime.Name != awstypes.RuntimeNameAppsyncJs {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).AppSyncClient(ctx)
+
+	var errs []error
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		input := &appsync.EvaluateCodeInput{
+			Code:    aws.String(code),
+			Context: aws.String(tfMap["context"].(string)),
+			Runtime: runtime,
+		}
+
+		if v, ok := tfMap["function"].(string); ok && v != "" {
+			input.Function = aws.String(v)
+		}
+
+		output, err := conn.EvaluateCode(ctx, input)
+
+		if err != nil {
+			errs = append(errs, fmt.Errorf("code_evaluation.%d: evaluating AppSync code: %w", i, err))
+			continue
+		}
+
+		if output.Error != nil {
+			errs = append(errs, fmt.Errorf("code_evaluation.%d: %w", i, newEvaluateCodeError(output.Error)))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func newEvaluateCodeError(apiObject *awstypes.EvaluateCodeErrorDetail) error {
+	var sb strings.Builder
+
+	sb.WriteString(aws.ToString(apiObject.Message))
+
+	for _, v := range apiObject.CodeErrors {
+		sb.WriteString("\n  ")
+
+		if location := v.Location; location != nil {
+			fmt.Fprintf(&sb, "line %d, column %d: ", location.Line, location.Column)
+		}
+
+		if errorType := aws.ToString(v.ErrorType); errorType != "" {
+			fmt.Fprintf(&sb, "%s: ", errorType)
+		}
+
+		sb.WriteString(aws.ToString(v.Value))
+	}
+
+	return errors.New(sb.String())
+}
@@ -58,6 +58,7 @@ func resourceFunction() *schema.Resource {
 				RequiredWith: []string{"runtime"},
 				ValidateFunc: validation.StringLenBetween(1, 32768),
 			},
+			"code_evaluation": codeEvaluationSchema(),
 			"data_source": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -149,6 +150,8 @@ func resourceFunction() *schema.Resource {
 				},
 			},
 		},
+
+		CustomizeDiff: customizeDiffEvaluateCode,
 	}
 }
 
@@ -15,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/appsync"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/appsync/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -44,7 +45,11 @@ func resourceGraphQLAPI() *schema.Resource {
 		DeleteWithoutTimeout: resourceGraphQLAPIDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("skip_schema_validation", false)
+
+				return []*schema.ResourceData{d}, nil
+			},
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -274,6 +279,11 @@ func resourceGraphQLAPI() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"skip_schema_validation": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"uris": {
@@ -321,8 +331,25 @@ func resourceGraphQLAPI() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceGraphQLAPICustomizeDiff,
+			verify.SetTagsDiff,
+		),
+	}
+}
+
+func resourceGraphQLAPICustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange(names.AttrSchema) || !d.NewValueKnown(names.AttrSchema) || d.Get("skip_schema_validation").(bool) {
+		return nil
 	}
+
+	if v := d.Get(names.AttrSchema).(string); v != "" {
+		if err := validateGraphQLSchema(v); err != nil {
+			return fmt.Errorf("invalid GraphQL schema (set skip_schema_validation to skip this check):\n%w", err)
+		}
+	}
+
+	return nil
 }
 
 func resourceGraphQLAPICreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -135,6 +135,35 @@ func testAccGraphQLAPI_schema(t *testing.T) {
 	})
 }
 
+func testAccGraphQLAPI_schemaInvalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.AppSyncEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppSyncServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGraphQLAPIDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccGraphQLAPIConfig_schemaInvalid(rName),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`line 8, column 2: field "Query.singlePost" is defined more than once`),
+			},
+			{
+				Config:      testAccGraphQLAPIConfig_schemaUndefinedType(rName),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`line 2, column 23: field "Query.singlePost" references undefined type "Pots"`),
+			},
+			{
+				Config:             testAccGraphQLAPIConfig_schemaSkipValidation(rName),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
 func testAccGraphQLAPI_authenticationType(t *testing.T) {
 	ctx := acctest.Context(t)
 	var api1, api2 awstypes.GraphqlApi
@@ -1727,6 +1756,37 @@ resource "aws_appsync_graphql_api" "test" {
 `, rName)
 }
 
+func testAccGraphQLAPIConfig_schemaInvalid(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_appsync_graphql_api" "test" {
+  authentication_type = "API_KEY"
+  name                = %[1]q
+  schema              = "type Post {\n\tid: ID!\n\ttitle: String!\n}\n\ntype Query {\n\tsinglePost(id: ID!): Post\n\tsinglePost: Post\n}\n"
+}
+`, rName)
+}
+
+func testAccGraphQLAPIConfig_schemaUndefinedType(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_appsync_graphql_api" "test" {
+  authentication_type = "API_KEY"
+  name                = %[1]q
+  schema              = "type Query {\n\tsinglePost(id: ID!): Pots\n}\n"
+}
+`, rName)
+}
+
+func testAccGraphQLAPIConfig_schemaSkipValidation(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_appsync_graphql_api" "test" {
+  authentication_type    = "API_KEY"
+  name                   = %[1]q
+  schema                 = "type Post {\n\tid: ID!\n\ttitle: String!\n}\n\ntype Query {\n\tsinglePost(id: ID!): Post\n\tsinglePost: Post\n}\n"
+  skip_schema_validation = true
+}
+`, rName)
+}
+
 func testAccGraphQLAPIConfig_schemaUpdate(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_appsync_graphql_api" "test" {
@@ -0,0 +1,1152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appsync
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// A minimal GraphQL schema definition language (SDL) parser.
+// It understands the type system subset of the GraphQL specification that AppSync accepts
+// and is used to surface schema errors at plan time rather than during StartSchemaCreation.
+
+type graphQLSchemaError struct {
+	Line    int
+	Column  int
+	Message string
+}
+
+func (e *graphQLSchemaError) Error() string {
+	return fmt.Sprintf("line %d, column %d: %s", e.Line, e.Column, e.Message)
+}
+
+type graphQLTokenKind int
+
+const (
+	graphQLTokenEOF graphQLTokenKind = iota
+	graphQLTokenPunctuator
+	graphQLTokenName
+	graphQLTokenInt
+	graphQLTokenFloat
+	graphQLTokenString
+	graphQLTokenBlockString
+)
+
+type graphQLToken struct {
+	kind   graphQLTokenKind
+	value  string
+	line   int
+	column int
+}
+
+func (t graphQLToken) String() string {
+	switch t.kind {
+	case graphQLTokenEOF:
+		return "end of schema"
+	case graphQLTokenString, graphQLTokenBlockString:
+		return "string"
+	default:
+		return fmt.Sprintf("%q", t.value)
+	}
+}
+
+type graphQLLexer struct {
+	source []rune
+	pos    int
+	line   int
+	column int
+}
+
+func newGraphQLLexer(source string) *graphQLLexer {
+	return &graphQLLexer{
+		source: []rune(source),
+		line:   1,
+		column: 1,
+	}
+}
+
+func (l *graphQLLexer) peekRune(offset int) rune {
+	if i := l.pos + offset; i < len(l.source) {
+		return l.source[i]
+	}
+
+	return 0
+}
+
+func (l *graphQLLexer) advance() rune {
+	r := l.source[l.pos]
+	l.pos++
+
+	switch {
+	case r == '\n':
+		l.line++
+		l.column = 1
+	case r == '\r':
+		if l.peekRune(0) != '\n' {
+			l.line++
+			l.column = 1
+		}
+	default:
+		l.column++
+	}
+
+	return r
+}
+
+func (l *graphQLLexer) errorf(line, column int, format string, a ...any) error {
+	return &graphQLSchemaError{Line: line, Column: column, Message: fmt.Sprintf(format, a...)}
+}
+
+func (l *graphQLLexer) skipIgnored() {
+	for l.pos < len(l.source) {
+		switch r := l.peekRune(0); r {
+		case '\ufeff', ' ', '\t', ',', '\n', '\r':
+			l.advance()
+		case '#':
+			for l.pos < len(l.source) && l.peekRune(0) != '\n' && l.peekRune(0) != '\r' {
+				l.advance()
+			}
+		default:
+			return
+		}
+	}
+}
+
+func (l *graphQLLexer) next() (graphQLToken, error) {
+	l.skipIgnored()
+
+	line, column := l.line, l.column
+
+	if l.pos >= len(l.source) {
+		return graphQLToken{kind: graphQLTokenEOF, line: line, column: column}, nil
+	}
+
+	switch r := l.peekRune(0); {
+	case strings.ContainsRune("!$&():=@[]{}|", r):
+		l.advance()
+		return graphQLToken{kind: graphQLTokenPunctuator, value: string(r), line: line, column: column}, nil
+	case r == '.':
+		if l.peekRune(1) == '.' && l.peekRune(2) == '.' {
+			l.advance()
+			l.advance()
+			l.advance()
+			return graphQLToken{kind: graphQLTokenPunctuator, value: "...", line: line, column: column}, nil
+		}
+		return graphQLToken{}, l.errorf(line, column, `unexpected character "."`)
+	case isGraphQLNameStart(r):
+		start := l.pos
+		for l.pos < len(l.source) && isGraphQLNameContinue(l.peekRune(0)) {
+			l.advance()
+		}
+		return graphQLToken{kind: graphQLTokenName, value: string(l.source[start:l.pos]), line: line, column: column}, nil
+	case r == '-' || isGraphQLDigit(r):
+		return l.readNumber(line, column)
+	case r == '"':
+		if l.peekRune(1) == '"' && l.peekRune(2) == '"' {
+			return l.readBlockString(line, column)
+		}
+		return l.readString(line, column)
+	default:
+		return graphQLToken{}, l.errorf(line, column, "unexpected character %q", r)
+	}
+}
+
+func (l *graphQLLexer) readNumber(line, column int) (graphQLToken, error) {
+	start := l.pos
+	kind := graphQLTokenInt
+
+	if l.peekRune(0) == '-' {
+		l.advance()
+	}
+
+	if !isGraphQLDigit(l.peekRune(0)) {
+		return graphQLToken{}, l.errorf(l.line, l.column, "invalid number, expected digit")
+	}
+	for isGraphQLDigit(l.peekRune(0)) {
+		l.advance()
+	}
+
+	if l.peekRune(0) == '.' {
+		kind = graphQLTokenFloat
+		l.advance()
+		if !isGraphQLDigit(l.peekRune(0)) {
+			return graphQLToken{}, l.errorf(l.line, l.column, "invalid number, expected digit after \".\"")
+		}
+		for isGraphQLDigit(l.peekRune(0)) {
+			l.advance()
+		}
+	}
+
+	if r := l.peekRune(0); r == 'e' || r == 'E' {
+		kind = graphQLTokenFloat
+		l.advance()
+		if r := l.peekRune(0); r == '+' || r == '-' {
+			l.advance()
+		}
+		if !isGraphQLDigit(l.peekRune(0)) {
+			return graphQLToken{}, l.errorf(l.line, l.column, "invalid number, expected digit in exponent")
+		}
+		for isGraphQLDigit(l.peekRune(0)) {
+			l.advance()
+		}
+	}
+
+	if r := l.peekRune(0); r == '.' || isGraphQLNameStart(r) {
+		return graphQLToken{}, l.errorf(l.line, l.column, "invalid number, unexpected character %q", r)
+	}
+
+	return graphQLToken{kind: kind, value: string(l.source[start:l.pos]), line: line, column: column}, nil
+}
+
+func (l *graphQLLexer) readString(line, column int) (graphQLToken, error) {
+	var sb strings.Builder
+
+	l.advance() // Opening quote.
+
+	for {
+		if l.pos >= len(l.source) {
+			return graphQLToken{}, l.errorf(line, column, "unterminated string")
+		}
+
+		switch r := l.peekRune(0); r {
+		case '"':
+			l.advance()
+			return graphQLToken{kind: graphQLTokenString, value: sb.String(), line: line, column: column}, nil
+		case '\n', '\r':
+			return graphQLToken{}, l.errorf(line, column, "unterminated string")
+		case '\\':
+			escLine, escColumn := l.line, l.column
+			l.advance()
+			if l.pos >= len(l.source) {
+				return graphQLToken{}, l.errorf(line, column, "unterminated string")
+			}
+			switch e := l.advance(); e {
+			case '"', '\\', '/':
+				sb.WriteRune(e)
+			case 'b':
+				sb.WriteRune('\b')
+			case 'f':
+				sb.WriteRune('\f')
+			case 'n':
+				sb.WriteRune('\n')
+			case 'r':
+				sb.WriteRune('\r')
+			case 't':
+				sb.WriteRune('\t')
+			case 'u':
+				for range 4 {
+					if !isGraphQLHexDigit(l.peekRune(0)) {
+						return graphQLToken{}, l.errorf(escLine, escColumn, "invalid unicode escape sequence")
+					}
+					sb.WriteRune(l.advance())
+				}
+			default:
+				return graphQLToken{}, l.errorf(escLine, escColumn, "invalid escape sequence \"\\%c\"", e)
+			}
+		default:
+			sb.WriteRune(l.advance())
+		}
+	}
+}
+
+func (l *graphQLLexer) readBlockString(line, column int) (graphQLToken, error) {
+	var sb strings.Builder
+
+	l.advance()
+	l.advance()
+	l.advance()
+
+	for {
+		if l.pos >= len(l.source) {
+			return graphQLToken{}, l.errorf(line, column, "unterminated block string")
+		}
+
+		if l.peekRune(0) == '"' && l.peekRune(1) == '"' && l.peekRune(2) == '"' {
+			l.advance()
+			l.advance()
+			l.advance()
+			return graphQLToken{kind: graphQLTokenBlockString, value: sb.String(), line: line, column: column}, nil
+		}
+
+		if l.peekRune(0) == '\\' && l.peekRune(1) == '"' && l.peekRune(2) == '"' && l.peekRune(3) == '"' {
+			l.advance()
+			l.advance()
+			l.advance()
+			l.advance()
+			sb.WriteString(`"""`)
+			continue
+		}
+
+		sb.WriteRune(l.advance())
+	}
+}
+
+func isGraphQLNameStart(r rune) bool {
+	return r == '_' || (r >= 'A' && r <= 'Z') || (r >= 'a' && r <= 'z')
+}
+
+func isGraphQLNameContinue(r rune) bool {
+	return isGraphQLNameStart(r) || isGraphQLDigit(r)
+}
+
+func isGraphQLDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
+func isGraphQLHexDigit(r rune) bool {
+	return isGraphQLDigit(r) || (r >= 'A' && r <= 'F') || (r >= 'a' && r <= 'f')
+}
+
+type graphQLTypeKind string
+
+const (
+	graphQLTypeKindEnum        graphQLTypeKind = "enum"
+	graphQLTypeKindInput       graphQLTypeKind = "input"
+	graphQLTypeKindInterface   graphQLTypeKind = "interface"
+	graphQLTypeKindObject      graphQLTypeKind = "type"
+	graphQLTypeKindScalar      graphQLTypeKind = "scalar"
+	graphQLTypeKindUnion       graphQLTypeKind = "union"
+	graphQLTypeKindUnsupported graphQLTypeKind = ""
+)
+
+// graphQLTypeRef is a reference to a named type, e.g. the "Post" in "[Post!]!".
+type graphQLTypeRef struct {
+	name   string
+	line   int
+	column int
+}
+
+type graphQLFieldDefinition struct {
+	name      string
+	typ       graphQLTypeRef
+	arguments []*graphQLFieldDefinition
+	line      int
+	column    int
+}
+
+type graphQLTypeDefinition struct {
+	kind       graphQLTypeKind
+	name       string
+	extension  bool
+	fields     []*graphQLFieldDefinition
+	interfaces []graphQLTypeRef
+	members    []graphQLTypeRef
+	values     []string
+	line       int
+	column     int
+}
+
+type graphQLSchemaDocument struct {
+	definitions    []*graphQLTypeDefinition
+	directives     map[string]struct{}
+	operationTypes map[string]graphQLTypeRef
+}
+
+// typeDefinition returns the named type with the fields of all extensions merged in.
+func (doc *graphQLSchemaDocument) typeDefinition(name string) *graphQLTypeDefinition {
+	var merged *graphQLTypeDefinition
+
+	for _, def := range doc.definitions {
+		if def.name != name {
+			continue
+		}
+
+		if merged == nil {
+			merged = &graphQLTypeDefinition{
+				kind:   def.kind,
+				name:   def.name,
+				line:   def.line,
+				column: def.column,
+			}
+		}
+
+		if !def.extension {
+			merged.kind = def.kind
+		}
+		merged.fields = append(merged.fields, def.fields...)
+		merged.interfaces = append(merged.interfaces, def.interfaces...)
+		merged.members = append(merged.members, def.members...)
+		merged.values = append(merged.values, def.values...)
+	}
+
+	return merged
+}
+
+func (def *graphQLTypeDefinition) field(name string) *graphQLFieldDefinition {
+	for _, field := range def.fields {
+		if field.name == name {
+			return field
+		}
+	}
+
+	return nil
+}
+
+type graphQLParser struct {
+	lexer *graphQLLexer
+	token graphQLToken
+}
+
+// parseGraphQLSchema parses a GraphQL SDL document.
+// Only the first syntax error is returned as the parser does not attempt to recover.
+func parseGraphQLSchema(source string) (*graphQLSchemaDocument, error) {
+	p := &graphQLParser{
+		lexer: newGraphQLLexer(source),
+	}
+
+	if err := p.nextToken(); err != nil {
+		return nil, err
+	}
+
+	doc := &graphQLSchemaDocument{
+		directives:     make(map[string]struct{}),
+		operationTypes: make(map[string]graphQLTypeRef),
+	}
+
+	if p.token.kind == graphQLTokenEOF {
+		return nil, &graphQLSchemaError{Line: p.token.line, Column: p.token.column, Message: "schema contains no definitions"}
+	}
+
+	for p.token.kind != graphQLTokenEOF {
+		if err := p.parseDefinition(doc); err != nil {
+			return nil, err
+		}
+	}
+
+	return doc, nil
+}
+
+func (p *graphQLParser) nextToken() error {
+	token, err := p.lexer.next()
+
+	if err != nil {
+		return err
+	}
+
+	p.token = token
+
+	return nil
+}
+
+func (p *graphQLParser) unexpected() error {
+	return &graphQLSchemaError{Line: p.token.line, Column: p.token.column, Message: fmt.Sprintf("unexpected %s", p.token)}
+}
+
+func (p *graphQLParser) expected(what string) error {
+	return &graphQLSchemaError{Line: p.token.line, Column: p.token.column, Message: fmt.Sprintf("expected %s, found %s", what, p.token)}
+}
+
+func (p *graphQLParser) peekPunctuator(value string) bool {
+	return p.token.kind == graphQLTokenPunctuator && p.token.value == value
+}
+
+func (p *graphQLParser) peekKeyword(value string) bool {
+	return p.token.kind == graphQLTokenName && p.token.value == value
+}
+
+func (p *graphQLParser) skipPunctuator(value string) (bool, error) {
+	if !p.peekPunctuator(value) {
+		return false, nil
+	}
+
+	return true, p.nextToken()
+}
+
+func (p *graphQLParser) expectPunctuator(value string) error {
+	if !p.peekPunctuator(value) {
+		return p.expected(fmt.Sprintf("%q", value))
+	}
+
+	return p.nextToken()
+}
+
+func (p *graphQLParser) expectKeyword(value string) error {
+	if !p.peekKeyword(value) {
+		return p.expected(fmt.Sprintf("%q", value))
+	}
+
+	return p.nextToken()
+}
+
+func (p *graphQLParser) expectName() (graphQLToken, error) {
+	token := p.token
+
+	if token.kind != graphQLTokenName {
+		return token, p.expected("name")
+	}
+
+	return token, p.nextToken()
+}
+
+func (p *graphQLParser) skipDescription() error {
+	if p.token.kind == graphQLTokenString || p.token.kind == graphQLTokenBlockString {
+		return p.nextToken()
+	}
+
+	return nil
+}
+
+func (p *graphQLParser) parseDefinition(doc *graphQLSchemaDocument) error {
+	if err := p.skipDescription(); err != nil {
+		return err
+	}
+
+	extension := false
+	if p.peekKeyword("extend") {
+		extension = true
+		if err := p.nextToken(); err != nil {
+			return err
+		}
+	}
+
+	if p.token.kind != graphQLTokenName {
+		if p.peekPunctuator("{") {
+			return &graphQLSchemaError{Line: p.token.line, Column: p.token.column, Message: "executable definitions (operations) are not allowed in a schema"}
+		}
+		return p.unexpected()
+	}
+
+	switch keyword := p.token.value; keyword {
+	case "schema":
+		return p.parseSchemaDefinition(doc, extension)
+	case "directive":
+		if extension {
+			return p.unexpected()
+		}
+		return p.parseDirectiveDefinition(doc)
+	case "scalar", "type", "interface", "union", "enum", "input":
+		def, err := p.parseTypeDefinition(graphQLTypeKind(keyword))
+		if err != nil {
+			return err
+		}
+		def.extension = extension
+		doc.definitions = append(doc.definitions, def)
+		return nil
+	case "query", "mutation", "subscription", "fragment":
+		return &graphQLSchemaError{Line: p.token.line, Column: p.token.column, Message: "executable definitions (operations) are not allowed in a schema"}
+	default:
+		return p.unexpected()
+	}
+}
+
+func (p *graphQLParser) parseSchemaDefinition(doc *graphQLSchemaDocument, extension bool) error {
+	if err := p.expectKeyword("schema"); err != nil {
+		return err
+	}
+
+	if err := p.parseDirectives(); err != nil {
+		return err
+	}
+
+	if extension && !p.peekPunctuator("{") {
+		return nil
+	}
+
+	if err := p.expectPunctuator("{"); err != nil {
+		return err
+	}
+
+	for !p.peekPunctuator("}") {
+		operation, err := p.expectName()
+		if err != nil {
+			return err
+		}
+
+		switch operation.value {
+		case "query", "mutation", "subscription":
+		default:
+			return &graphQLSchemaError{Line: operation.line, Column: operation.column, Message: fmt.Sprintf("unknown operation type %q", operation.value)}
+		}
+
+		if _, ok := doc.operationTypes[operation.value]; ok {
+			return &graphQLSchemaError{Line: operation.line, Column: operation.column, Message: fmt.Sprintf("operation type %q is defined more than once", operation.value)}
+		}
+
+		if err := p.expectPunctuator(":"); err != nil {
+			return err
+		}
+
+		name, err := p.expectName()
+		if err != nil {
+			return err
+		}
+
+		doc.operationTypes[operation.value] = graphQLTypeRef{name: name.value, line: name.line, column: name.column}
+	}
+
+	return p.expectPunctuator("}")
+}
+
+func (p *graphQLParser) parseDirectiveDefinition(doc *graphQLSchemaDocument) error {
+	if err := p.expectKeyword("directive"); err != nil {
+		return err
+	}
+
+	if err := p.expectPunctuator("@"); err != nil {
+		return err
+	}
+
+	name, err := p.expectName()
+	if err != nil {
+		return err
+	}
+
+	if p.peekPunctuator("(") {
+		if _, err := p.parseArgumentsDefinition(); err != nil {
+			return err
+		}
+	}
+
+	if p.peekKeyword("repeatable") {
+		if err := p.nextToken(); err != nil {
+			return err
+		}
+	}
+
+	if err := p.expectKeyword("on"); err != nil {
+		return err
+	}
+
+	if _, err := p.skipPunctuator("|"); err != nil {
+		return err
+	}
+
+	for {
+		if _, err := p.expectName(); err != nil {
+			return err
+		}
+
+		if ok, err := p.skipPunctuator("|"); err != nil {
+			return err
+		} else if !ok {
+			break
+		}
+	}
+
+	doc.directives[name.value] = struct{}{}
+
+	return nil
+}
+
+func (p *graphQLParser) parseTypeDefinition(kind graphQLTypeKind) (*graphQLTypeDefinition, error) {
+	keyword := p.token
+
+	if err := p.nextToken(); err != nil {
+		return nil, err
+	}
+
+	name, err := p.expectName()
+	if err != nil {
+		return nil, err
+	}
+
+	def := &graphQLTypeDefinition{
+		kind:   kind,
+		name:   name.value,
+		line:   keyword.line,
+		column: keyword.column,
+	}
+
+	if (kind == graphQLTypeKindObject || kind == graphQLTypeKindInterface) && p.peekKeyword("implements") {
+		if err := p.nextToken(); err != nil {
+			return nil, err
+		}
+
+		if _, err := p.skipPunctuator("&"); err != nil {
+			return nil, err
+		}
+
+		for {
+			iface, err := p.expectName()
+			if err != nil {
+				return nil, err
+			}
+
+			def.interfaces = append(def.interfaces, graphQLTypeRef{name: iface.value, line: iface.line, column: iface.column})
+
+			if ok, err := p.skipPunctuator("&"); err != nil {
+				return nil, err
+			} else if !ok {
+				break
+			}
+		}
+	}
+
+	if err := p.parseDirectives(); err != nil {
+		return nil, err
+	}
+
+	switch kind {
+	case graphQLTypeKindObject, graphQLTypeKindInterface:
+		if p.peekPunctuator("{") {
+			if def.fields, err = p.parseFieldsDefinition(true); err != nil {
+				return nil, err
+			}
+		}
+	case graphQLTypeKindInput:
+		if p.peekPunctuator("{") {
+			if def.fields, err = p.parseFieldsDefinition(false); err != nil {
+				return nil, err
+			}
+		}
+	case graphQLTypeKindUnion:
+		if ok, err := p.skipPunctuator("="); err != nil {
+			return nil, err
+		} else if ok {
+			if _, err := p.skipPunctuator("|"); err != nil {
+				return nil, err
+			}
+
+			for {
+				member, err := p.expectName()
+				if err != nil {
+					return nil, err
+				}
+
+				def.members = append(def.members, graphQLTypeRef{name: member.value, line: member.line, column: member.column})
+
+				if ok, err := p.skipPunctuator("|"); err != nil {
+					return nil, err
+				} else if !ok {
+					break
+				}
+			}
+		}
+	case graphQLTypeKindEnum:
+		if p.peekPunctuator("{") {
+			if def.values, err = p.parseEnumValuesDefinition(); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return def, nil
+}
+
+func (p *graphQLParser) parseFieldsDefinition(withArguments bool) ([]*graphQLFieldDefinition, error) {
+	var fields []*graphQLFieldDefinition
+
+	if err := p.expectPunctuator("{"); err != nil {
+		return nil, err
+	}
+
+	if p.peekPunctuator("}") {
+		return nil, p.expected("field definition")
+	}
+
+	for !p.peekPunctuator("}") {
+		field, err := p.parseInputValueOrFieldDefinition(withArguments)
+		if err != nil {
+			return nil, err
+		}
+
+		fields = append(fields, field)
+	}
+
+	return fields, p.expectPunctuator("}")
+}
+
+func (p *graphQLParser) parseArgumentsDefinition() ([]*graphQLFieldDefinition, error) {
+	var arguments []*graphQLFieldDefinition
+
+	if err := p.expectPunctuator("("); err != nil {
+		return nil, err
+	}
+
+	if p.peekPunctuator(")") {
+		return nil, p.expected("argument definition")
+	}
+
+	for !p.peekPunctuator(")") {
+		argument, err := p.parseInputValueOrFieldDefinition(false)
+		if err != nil {
+			return nil, err
+		}
+
+		arguments = append(arguments, argument)
+	}
+
+	return arguments, p.expectPunctuator(")")
+}
+
+// parseInputValueOrFieldDefinition parses a field definition (withArguments) or an input value definition.
+func (p *graphQLParser) parseInputValueOrFieldDefinition(withArguments bool) (*graphQLFieldDefinition, error) {
+	if err := p.skipDescription(); err != nil {
+		return nil, err
+	}
+
+	name, err := p.expectName()
+	if err != nil {
+		return nil, err
+	}
+
+	field := &graphQLFieldDefinition{
+		name:   name.value,
+		line:   name.line,
+		column: name.column,
+	}
+
+	if withArguments && p.peekPunctuator("(") {
+		if field.arguments, err = p.parseArgumentsDefinition(); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.expectPunctuator(":"); err != nil {
+		return nil, err
+	}
+
+	if field.typ, err = p.parseType(); err != nil {
+		return nil, err
+	}
+
+	if !withArguments {
+		if ok, err := p.skipPunctuator("="); err != nil {
+			return nil, err
+		} else if ok {
+			if err := p.parseValue(true); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := p.parseDirectives(); err != nil {
+		return nil, err
+	}
+
+	return field, nil
+}
+
+func (p *graphQLParser) parseEnumValuesDefinition() ([]string, error) {
+	var values []string
+
+	if err := p.expectPunctuator("{"); err != nil {
+		return nil, err
+	}
+
+	if p.peekPunctuator("}") {
+		return nil, p.expected("enum value")
+	}
+
+	for !p.peekPunctuator("}") {
+		if err := p.skipDescription(); err != nil {
+			return nil, err
+		}
+
+		value, err := p.expectName()
+		if err != nil {
+			return nil, err
+		}
+
+		switch value.value {
+		case "true", "false", "null":
+			return nil, &graphQLSchemaError{Line: value.line, Column: value.column, Message: fmt.Sprintf("%q is not a valid enum value", value.value)}
+		}
+
+		values = append(values, value.value)
+
+		if err := p.parseDirectives(); err != nil {
+			return nil, err
+		}
+	}
+
+	return values, p.expectPunctuator("}")
+}
+
+func (p *graphQLParser) parseType() (graphQLTypeRef, error) {
+	var typ graphQLTypeRef
+
+	if ok, err := p.skipPunctuator("["); err != nil {
+		return typ, err
+	} else if ok {
+		if typ, err = p.parseType(); err != nil {
+			return typ, err
+		}
+
+		if err := p.expectPunctuator("]"); err != nil {
+			return typ, err
+		}
+	} else {
+		name, err := p.expectName()
+		if err != nil {
+			return typ, err
+		}
+
+		typ = graphQLTypeRef{name: name.value, line: name.line, column: name.column}
+	}
+
+	if _, err := p.skipPunctuator("!"); err != nil {
+		return typ, err
+	}
+
+	if p.peekPunctuator("!") {
+		return typ, p.unexpected()
+	}
+
+	return typ, nil
+}
+
+func (p *graphQLParser) parseDirectives() error {
+	for p.peekPunctuator("@") {
+		if err := p.nextToken(); err != nil {
+			return err
+		}
+
+		if _, err := p.expectName(); err != nil {
+			return err
+		}
+
+		if ok, err := p.skipPunctuator("("); err != nil {
+			return err
+		} else if ok {
+			if p.peekPunctuator(")") {
+				return p.expected("argument")
+			}
+
+			for !p.peekPunctuator(")") {
+				if _, err := p.expectName(); err != nil {
+					return err
+				}
+
+				if err := p.expectPunctuator(":"); err != nil {
+					return err
+				}
+
+				if err := p.parseValue(true); err != nil {
+					return err
+				}
+			}
+
+			if err := p.expectPunctuator(")"); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (p *graphQLParser) parseValue(constant bool) error {
+	switch token := p.token; token.kind {
+	case graphQLTokenInt, graphQLTokenFloat, graphQLTokenString, graphQLTokenBlockString, graphQLTokenName:
+		return p.nextToken()
+	case graphQLTokenPunctuator:
+		switch token.value {
+		case "$":
+			if constant {
+				return &graphQLSchemaError{Line: token.line, Column: token.column, Message: "variables are not allowed in constant values"}
+			}
+			if err := p.nextToken(); err != nil {
+				return err
+			}
+			_, err := p.expectName()
+			return err
+		case "[":
+			if err := p.nextToken(); err != nil {
+				return err
+			}
+			for !p.peekPunctuator("]") {
+				if p.token.kind == graphQLTokenEOF {
+					return p.expected(`"]"`)
+				}
+				if err := p.parseValue(constant); err != nil {
+					return err
+				}
+			}
+			return p.nextToken()
+		case "{":
+			if err := p.nextToken(); err != nil {
+				return err
+			}
+			for !p.peekPunctuator("}") {
+				if _, err := p.expectName(); err != nil {
+					return err
+				}
+				if err := p.expectPunctuator(":"); err != nil {
+					return err
+				}
+				if err := p.parseValue(constant); err != nil {
+					return err
+				}
+			}
+			return p.nextToken()
+		}
+	}
+
+	return p.expected("value")
+}
+
+var (
+	// graphQLBuiltInScalars are the GraphQL specification and AppSync-provided scalar types.
+	// See https://docs.aws.amazon.com/appsync/latest/devguide/scalars.html.
+	graphQLBuiltInScalars = []string{
+		"Boolean",
+		"Float",
+		"ID",
+		"Int",
+		"String",
+		"AWSDate",
+		"AWSDateTime",
+		"AWSEmail",
+		"AWSIPAddress",
+		"AWSJSON",
+		"AWSPhone",
+		"AWSTime",
+		"AWSTimestamp",
+		"AWSURL",
+	}
+)
+
+// validateGraphQLSchema parses a GraphQL SDL document and checks it for semantic errors.
+// All semantic errors are returned, joined.
+func validateGraphQLSchema(source string) error {
+	doc, err := parseGraphQLSchema(source)
+
+	if err != nil {
+		return err
+	}
+
+	return doc.validate()
+}
+
+func (doc *graphQLSchemaDocument) validate() error {
+	var errs []error
+
+	errorf := func(line, column int, format string, a ...any) {
+		errs = append(errs, &graphQLSchemaError{Line: line, Column: column, Message: fmt.Sprintf(format, a...)})
+	}
+
+	kinds := make(map[string]graphQLTypeKind)
+	for _, name := range graphQLBuiltInScalars {
+		kinds[name] = graphQLTypeKindScalar
+	}
+
+	for _, def := range doc.definitions {
+		if def.extension {
+			continue
+		}
+
+		if kind, ok := kinds[def.name]; ok {
+			if kind == graphQLTypeKindScalar && slices.Contains(graphQLBuiltInScalars, def.name) {
+				errorf(def.line, def.column, "type %q is a built-in scalar and cannot be redefined", def.name)
+			} else {
+				errorf(def.line, def.column, "type %q is defined more than once", def.name)
+			}
+			continue
+		}
+
+		if strings.HasPrefix(def.name, "__") {
+			errorf(def.line, def.column, "type name %q must not begin with \"__\"", def.name)
+		}
+
+		kinds[def.name] = def.kind
+	}
+
+	checkTypeRef := func(ref graphQLTypeRef, context string, allowed ...graphQLTypeKind) {
+		kind, ok := kinds[ref.name]
+
+		if !ok {
+			errorf(ref.line, ref.column, "%s references undefined type %q", context, ref.name)
+			return
+		}
+
+		if slices.Contains(allowed, kind) {
+			return
+		}
+
+		errorf(ref.line, ref.column, "%s references %s %q, which is not allowed here", context, kind, ref.name)
+	}
+
+	outputKinds := []graphQLTypeKind{graphQLTypeKindEnum, graphQLTypeKindInterface, graphQLTypeKindObject, graphQLTypeKindScalar, graphQLTypeKindUnion}
+	inputKinds := []graphQLTypeKind{graphQLTypeKindEnum, graphQLTypeKindInput, graphQLTypeKindScalar}
+
+	fieldNames := make(map[string]map[string]struct{})
+
+	for _, def := range doc.definitions {
+		if def.extension {
+			kind, ok := kinds[def.name]
+
+			if !ok {
+				errorf(def.line, def.column, "cannot extend undefined type %q", def.name)
+				continue
+			}
+
+			if kind != def.kind {
+				errorf(def.line, def.column, "cannot extend %s %q as %s", kind, def.name, def.kind)
+				continue
+			}
+		}
+
+		if _, ok := fieldNames[def.name]; !ok {
+			fieldNames[def.name] = make(map[string]struct{})
+		}
+
+		for _, field := range def.fields {
+			context := fmt.Sprintf("field \"%s.%s\"", def.name, field.name)
+
+			if _, ok := fieldNames[def.name][field.name]; ok {
+				errorf(field.line, field.column, "%s is defined more than once", context)
+			}
+			fieldNames[def.name][field.name] = struct{}{}
+
+			if def.kind == graphQLTypeKindInput {
+				checkTypeRef(field.typ, context, inputKinds...)
+			} else {
+				checkTypeRef(field.typ, context, outputKinds...)
+			}
+
+			argumentNames := make(map[string]struct{})
+			for _, argument := range field.arguments {
+				context := fmt.Sprintf("argument \"%s.%s(%s:)\"", def.name, field.name, argument.name)
+
+				if _, ok := argumentNames[argument.name]; ok {
+					errorf(argument.line, argument.column, "%s is defined more than once", context)
+				}
+				argumentNames[argument.name] = struct{}{}
+
+				checkTypeRef(argument.typ, context, inputKinds...)
+			}
+		}
+
+		for _, iface := range def.interfaces {
+			checkTypeRef(iface, fmt.Sprintf("type %q", def.name), graphQLTypeKindInterface)
+		}
+
+		for _, member := range def.members {
+			checkTypeRef(member, fmt.Sprintf("union %q", def.name), graphQLTypeKindObject)
+		}
+	}
+
+	for _, operation := range []string{"query", "mutation", "subscription"} {
+		if ref, ok := doc.operationTypes[operation]; ok {
+			checkTypeRef(ref, fmt.Sprintf("schema %s", operation), graphQLTypeKindObject)
+		}
+	}
+
+	// Object types must implement all fields of their interfaces.
+	for _, def := range doc.definitions {
+		if def.extension || def.kind != graphQLTypeKindObject {
+			continue
+		}
+
+		merged := doc.typeDefinition(def.name)
+		for _, ref := range merged.interfaces {
+			if kinds[ref.name] != graphQLTypeKindInterface {
+				continue
+			}
+
+			iface := doc.typeDefinition(ref.name)
+			for _, field := range iface.fields {
+				if merged.field(field.name) == nil {
+					errorf(def.line, def.column, "type %q does not implement field %q of interface %q", def.name, field.name, ref.name)
+				}
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
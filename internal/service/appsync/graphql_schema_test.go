@@ -0,0 +1,196 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appsync
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateGraphQLSchema(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema        string
+		expectedError string
+	}{
+		"valid": {
+			schema: `
+"""
+A blog post.
+"""
+type Post implements Node @aws_cognito_user_pools {
+  id: ID!
+  "The post title."
+  title: String
+  createdAt: AWSDateTime
+  tags(first: Int = 10, filter: TagFilter): [String!]!
+  status: Status
+}
+
+interface Node {
+  id: ID!
+}
+
+input TagFilter {
+  prefix: String = "a\"b"
+  limit: Int = -5
+  values: [String] = ["x", "y"]
+}
+
+enum Status {
+  DRAFT
+  PUBLISHED
+}
+
+union SearchResult = | Post
+
+type Query {
+  getPost(id: ID!): Post
+  search(text: String): [SearchResult]
+}
+
+type Mutation {
+  addPost(title: String!, meta: AWSJSON): Post
+}
+
+type Subscription {
+  onAddPost: Post @aws_subscribe(mutations: ["addPost"])
+}
+
+extend type Query {
+  allPosts: [Post]
+}
+
+schema {
+  query: Query
+  mutation: Mutation
+  subscription: Subscription
+}
+`,
+		},
+		"empty": {
+			schema:        "  # Only a comment\n",
+			expectedError: "line 2, column 1: schema contains no definitions",
+		},
+		"missing colon": {
+			schema:        "type Query {\n  id ID\n}",
+			expectedError: `line 2, column 6: expected ":", found "ID"`,
+		},
+		"unterminated string": {
+			schema:        "type Query {\n  \"oops\n  id: ID\n}",
+			expectedError: "line 2, column 3: unterminated string",
+		},
+		"unexpected character": {
+			schema:        "type Query {\n  id: ID%\n}",
+			expectedError: `line 2, column 9: unexpected character '%'`,
+		},
+		"operation": {
+			schema:        "query {\n  getPost { id }\n}",
+			expectedError: "line 1, column 1: executable definitions (operations) are not allowed in a schema",
+		},
+		"undefined type": {
+			schema:        "type Query {\n  getPost: Post\n}",
+			expectedError: `line 2, column 12: field "Query.getPost" references undefined type "Post"`,
+		},
+		"duplicate type": {
+			schema:        "type Query {\n  id: ID\n}\n\ntype Query {\n  name: String\n}",
+			expectedError: `line 5, column 1: type "Query" is defined more than once`,
+		},
+		"duplicate field": {
+			schema:        "type Query {\n  id: ID\n  id: String\n}",
+			expectedError: `line 3, column 3: field "Query.id" is defined more than once`,
+		},
+		"input type as output": {
+			schema:        "input PostInput {\n  title: String\n}\n\ntype Query {\n  getPost: PostInput\n}",
+			expectedError: `line 6, column 12: field "Query.getPost" references input "PostInput", which is not allowed here`,
+		},
+		"object type as argument": {
+			schema:        "type Post {\n  id: ID\n}\n\ntype Query {\n  getPost(post: Post): Post\n}",
+			expectedError: `line 6, column 17: argument "Query.getPost(post:)" references type "Post", which is not allowed here`,
+		},
+		"missing interface field": {
+			schema:        "interface Node {\n  id: ID!\n}\n\ntype Query implements Node {\n  name: String\n}",
+			expectedError: `line 5, column 1: type "Query" does not implement field "id" of interface "Node"`,
+		},
+		"extend undefined type": {
+			schema:        "type Query {\n  id: ID\n}\n\nextend type Mutation {\n  id: ID\n}",
+			expectedError: `line 5, column 8: cannot extend undefined type "Mutation"`,
+		},
+		"redefined built-in scalar": {
+			schema:        "scalar AWSDateTime\n\ntype Query {\n  id: ID\n}",
+			expectedError: `line 1, column 1: type "AWSDateTime" is a built-in scalar and cannot be redefined`,
+		},
+		"multiple errors": {
+			schema:        "type Query {\n  id: ID\n  id: String\n}\n\ntype Query {\n  name: String\n}",
+			expectedError: "line 6, column 1: type \"Query\" is defined more than once\nline 3, column 3: field \"Query.id\" is defined more than once",
+		},
+		"unsupported definition": {
+			schema:        "type Query {\n  id: ID\n}\n\nfuture Thing {\n  id: ID\n}",
+			expectedError: `line 5, column 1: unexpected "future"`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateGraphQLSchema(testCase.schema)
+
+			if testCase.expectedError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error %q, got none", testCase.expectedError)
+			}
+
+			if got := err.Error(); !strings.Contains(got, testCase.expectedError) {
+				t.Errorf("expected error %q, got %q", testCase.expectedError, got)
+			}
+		})
+	}
+}
+
+func TestGraphQLSchemaDocumentTypeDefinition(t *testing.T) {
+	t.Parallel()
+
+	doc, err := parseGraphQLSchema(`
+type Query {
+  getPost: String
+}
+
+extend type Query {
+  allPosts: [String]
+}
+`)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	def := doc.typeDefinition("Query")
+
+	if def == nil {
+		t.Fatal("expected type Query")
+	}
+
+	for _, name := range []string{"getPost", "allPosts"} {
+		if def.field(name) == nil {
+			t.Errorf("expected field Query.%s", name)
+		}
+	}
+
+	if def.field("missing") != nil {
+		t.Error("unexpected field Query.missing")
+	}
+
+	if doc.typeDefinition("Mutation") != nil {
+		t.Error("unexpected type Mutation")
+	}
+}
@@ -76,6 +76,7 @@ func resourceResolver() *schema.Resource {
 				RequiredWith: []string{"runtime"},
 				ValidateFunc: validation.StringLenBetween(1, 32768),
 			},
+			"code_evaluation": codeEvaluationSchema(),
 			"data_source": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -180,6 +181,8 @@ func resourceResolver() *schema.Resource {
 				ForceNew: true,
 			},
 		},
+
+		CustomizeDiff: customizeDiffEvaluateCode,
 	}
 }
 
@@ -232,6 +235,10 @@ func resourceResolverCreate(ctx context.Context, d *schema.ResourceData, meta in
 		input.SyncConfig = expandSyncConfig(v.([]interface{}))
 	}
 
+	if err := checkResolverReferences(ctx, conn, apiID, typeName, fieldName, aws.ToString(input.DataSourceName)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating AppSync Resolver (%s): %s", id, err)
+	}
+
 	_, err := retryResolverOp(ctx, apiID, func() (interface{}, error) {
 		return conn.CreateResolver(ctx, input)
 	})
@@ -347,6 +354,12 @@ func resourceResolverUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		input.SyncConfig = expandSyncConfig(v.([]interface{}))
 	}
 
+	if d.HasChange("data_source") {
+		if err := checkResolverReferences(ctx, conn, apiID, typeName, fieldName, aws.ToString(input.DataSourceName)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating AppSync Resolver (%s): %s", d.Id(), err)
+		}
+	}
+
 	_, err = retryResolverOp(ctx, apiID, func() (interface{}, error) {
 		return conn.UpdateResolver(ctx, input)
 	})
@@ -417,6 +430,40 @@ func retryResolverOp(ctx context.Context, apiID string, f func() (interface{}, e
 	return tfresource.RetryWhenIsA[*awstypes.ConcurrentModificationException](ctx, timeout, f)
 }
 
+// checkResolverReferences verifies that the GraphQL API's current schema defines the resolver's type and field
+// and that any unit resolver data source exists, so that misconfigurations are reported with a clear error.
+// The schema check is skipped if the schema cannot be retrieved or parsed.
+func checkResolverReferences(ctx context.Context, conn *appsync.Client, apiID, typeName, fieldName, dataSourceName string) error {
+	output, err := conn.GetIntrospectionSchema(ctx, &appsync.GetIntrospectionSchemaInput{
+		ApiId:  aws.String(apiID),
+		Format: awstypes.OutputTypeSdl,
+	})
+
+	if err != nil {
+		log.Printf("[WARN] reading AppSync GraphQL API (%s) schema: %s", apiID, err)
+	} else if doc, err := parseGraphQLSchema(string(output.Schema)); err != nil {
+		log.Printf("[WARN] parsing AppSync GraphQL API (%s) schema: %s", apiID, err)
+	} else if def := doc.typeDefinition(typeName); def == nil {
+		return fmt.Errorf("GraphQL API (%s) schema does not define type %q", apiID, typeName)
+	} else if def.field(fieldName) == nil {
+		return fmt.Errorf("type %q in GraphQL API (%s) schema does not define field %q", typeName, apiID, fieldName)
+	}
+
+	if dataSourceName != "" {
+		_, err := findDataSourceByTwoPartKey(ctx, conn, apiID, dataSourceName)
+
+		if tfresource.NotFound(err) {
+			return fmt.Errorf("data source %q not found in GraphQL API (%s)", dataSourceName, apiID)
+		}
+
+		if err != nil {
+			return fmt.Errorf("reading AppSync Data Source (%s): %w", dataSourceName, err)
+		}
+	}
+
+	return nil
+}
+
 func findResolverByThreePartKey(ctx context.Context, conn *appsync.Client, apiID, typeName, fieldName string) (*awstypes.Resolver, error) {
 	input := &appsync.GetResolverInput{
 		ApiId:     aws.String(apiID),
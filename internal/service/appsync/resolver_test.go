@@ -83,6 +83,59 @@ func testAccResolver_code(t *testing.T) {
 	})
 }
 
+func testAccResolver_codeEvaluation(t *testing.T) {
+	ctx := acctest.Context(t)
+	var resolver1 awstypes.Resolver
+	rName := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
+	resourceName := "aws_appsync_resolver.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.AppSyncEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppSyncServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResolverDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResolverConfig_codeEvaluation(rName, "export function request(ctx) {\n  return {;\n}\n\nexport function response(ctx) {\n  return ctx.result;\n}\n"),
+				ExpectError: regexache.MustCompile(`code_evaluation.0: .*line 2, column`),
+			},
+			{
+				Config: testAccResolverConfig_codeEvaluation(rName, "export function request(ctx) {\n  return { payload: ctx.args };\n}\n\nexport function response(ctx) {\n  return ctx.result;\n}\n"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResolverExists(ctx, resourceName, &resolver1),
+					resource.TestCheckResourceAttr(resourceName, "code_evaluation.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "code_evaluation.0.function", "request"),
+					resource.TestCheckResourceAttr(resourceName, "code_evaluation.1.function", "response"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"code_evaluation"},
+			},
+		},
+	})
+}
+
+func testAccResolver_undefinedField(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.AppSyncEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppSyncServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResolverDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResolverConfig_undefinedField(rName),
+				ExpectError: regexache.MustCompile(`type "Query" in GraphQL API \(.+\) schema does not define field "missingPost"`),
+			},
+		},
+	})
+}
+
 func testAccResolver_syncConfig(t *testing.T) {
 	ctx := acctest.Context(t)
 	var resolver1 awstypes.Resolver
@@ -875,6 +928,47 @@ EOF
 `, rName))
 }
 
+func testAccResolverConfig_codeEvaluation(rName, code string) string {
+	return acctest.ConfigCompose(testAccResolverConfig_base(rName), fmt.Sprintf(`
+resource "aws_appsync_resolver" "test" {
+  api_id      = aws_appsync_graphql_api.test.id
+  field       = "singlePost"
+  type        = "Query"
+  data_source = aws_appsync_datasource.test.name
+  code        = %[1]q
+
+  runtime {
+    name            = "APPSYNC_JS"
+    runtime_version = "1.0.0"
+  }
+
+  code_evaluation {
+    context  = jsonencode({ arguments = { id = "1" } })
+    function = "request"
+  }
+
+  code_evaluation {
+    context  = jsonencode({ arguments = { id = "1" }, result = { id = "1", title = "Post" } })
+    function = "response"
+  }
+}
+`, code))
+}
+
+func testAccResolverConfig_undefinedField(rName string) string {
+	return acctest.ConfigCompose(testAccResolverConfig_base(rName), `
+resource "aws_appsync_resolver" "test" {
+  api_id      = aws_appsync_graphql_api.test.id
+  field       = "missingPost"
+  type        = "Query"
+  data_source = aws_appsync_datasource.test.name
+
+  request_template  = "{\"version\": \"2018-05-29\", \"method\": \"GET\", \"resourcePath\": \"/\"}"
+  response_template = "$util.toJson($ctx.result)"
+}
+`)
+}
+
 func testAccResolverConfig_code(rName, code string) string {
 	return acctest.ConfigCompose(testAccResolverConfig_base(rName), fmt.Sprintf(`
 resource "aws_appsync_function" "test" {
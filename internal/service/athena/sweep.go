@@ -16,7 +16,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_athena_data_catalog", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_athena_data_catalog", &resource.Sweeper{
 		Name: "aws_athena_data_catalog",
 		F:    sweepDataCatalogs,
 		Dependencies: []string{
@@ -24,12 +24,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_athena_database", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_athena_database", &resource.Sweeper{
 		Name: "aws_athena_database",
 		F:    sweepDatabases,
 	})
 
-	resource.AddTestSweepers("aws_athena_workgroup", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_athena_workgroup", &resource.Sweeper{
 		Name: "aws_athena_workgroup",
 		F:    sweepWorkGroups,
 		Dependencies: []string{
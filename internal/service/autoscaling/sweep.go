@@ -16,12 +16,12 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_autoscaling_group", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_autoscaling_group", &resource.Sweeper{
 		Name: "aws_autoscaling_group",
 		F:    sweepGroups,
 	})
 
-	resource.AddTestSweepers("aws_launch_configuration", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_launch_configuration", &resource.Sweeper{
 		Name:         "aws_launch_configuration",
 		F:            sweepLaunchConfigurations,
 		Dependencies: []string{"aws_autoscaling_group"},
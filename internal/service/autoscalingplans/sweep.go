@@ -16,7 +16,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_autoscalingplans_scaling_plan", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_autoscalingplans_scaling_plan", &resource.Sweeper{
 		Name: "aws_autoscalingplans_scaling_plan",
 		F:    sweepScalingPlans,
 	})
@@ -0,0 +1,375 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/backup"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/backup/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_backup_backup_job", name="Backup Job")
+func newBackupJobResource(_ context.Context) (resource.ResourceWithConfigure, error) { // nosemgrep:ci.backup-in-func-name
+	r := &backupJobResource{}
+
+	r.SetDefaultCreateTimeout(60 * time.Minute)
+
+	return r, nil
+}
+
+type backupJobResource struct { // nosemgrep:ci.backup-in-var-name
+	framework.ResourceWithConfigure
+	framework.WithNoUpdate
+	framework.WithNoOpDelete
+	framework.WithTimeouts
+	framework.WithImportByID
+}
+
+func (*backupJobResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_backup_backup_job"
+}
+
+func (r *backupJobResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"backup_options": schema.MapAttribute{
+				CustomType: fwtypes.MapOfStringType,
+				Optional:   true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"backup_size_in_bytes": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"backup_vault_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"complete_window_minutes": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"completion_date": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrCreationDate: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrIAMRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"recovery_point_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"recovery_point_tags": schema.MapAttribute{
+				CustomType: fwtypes.MapOfStringType,
+				Optional:   true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrResourceARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrResourceType: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"start_window_minutes": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrState: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.BackupJobState](),
+				Computed:   true,
+			},
+			names.AttrStatusMessage: schema.StringAttribute{
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"recovery_point_lifecycle": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[backupJobLifecycleModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"delete_after_days": schema.Int64Attribute{
+							Optional: true,
+							PlanModifiers: []planmodifier.Int64{
+								int64planmodifier.RequiresReplace(),
+							},
+						},
+						"move_to_cold_storage_after_days": schema.Int64Attribute{
+							Optional: true,
+							PlanModifiers: []planmodifier.Int64{
+								int64planmodifier.RequiresReplace(),
+							},
+						},
+						"opt_in_to_archive_for_supported_resources": schema.BoolAttribute{
+							Optional: true,
+							PlanModifiers: []planmodifier.Bool{
+								boolplanmodifier.RequiresReplace(),
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *backupJobResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data backupJobResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BackupClient(ctx)
+
+	resourceARN := data.ResourceARN.ValueString()
+	input := &backup.StartBackupJobInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.IdempotencyToken = aws.String(sdkid.UniqueId())
+
+	lifecycle, diags := data.RecoveryPointLifecycle.ToPtr(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if lifecycle != nil {
+		input.Lifecycle = &awstypes.Lifecycle{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, lifecycle, input.Lifecycle)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	output, err := conn.StartBackupJob(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("starting Backup Job (%s)", resourceARN), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.BackupJobID = fwflex.StringToFramework(ctx, output.BackupJobId)
+
+	job, err := waitBackupJobCompleted(ctx, conn, data.BackupJobID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.BackupJobID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Backup Job (%s) complete", data.BackupJobID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, job, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *backupJobResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data backupJobResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BackupClient(ctx)
+
+	output, err := findBackupJobByID(ctx, conn, data.BackupJobID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Backup Job (%s)", data.BackupJobID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type backupJobResourceModel struct { // nosemgrep:ci.backup-in-var-name
+	BackupJobID            types.String                                             `tfsdk:"id"`
+	BackupOptions          fwtypes.MapOfString                                      `tfsdk:"backup_options"`
+	BackupSizeInBytes      types.Int64                                              `tfsdk:"backup_size_in_bytes"`
+	BackupVaultName        types.String                                             `tfsdk:"backup_vault_name"`
+	CompleteWindowMinutes  types.Int64                                              `tfsdk:"complete_window_minutes"`
+	CompletionDate         timetypes.RFC3339                                        `tfsdk:"completion_date"`
+	CreationDate           timetypes.RFC3339                                        `tfsdk:"creation_date"`
+	IAMRoleARN             fwtypes.ARN                                              `tfsdk:"iam_role_arn"`
+	RecoveryPointARN       types.String                                             `tfsdk:"recovery_point_arn"`
+	RecoveryPointLifecycle fwtypes.ListNestedObjectValueOf[backupJobLifecycleModel] `tfsdk:"recovery_point_lifecycle"`
+	RecoveryPointTags      fwtypes.MapOfString                                      `tfsdk:"recovery_point_tags"`
+	ResourceARN            fwtypes.ARN                                              `tfsdk:"resource_arn"`
+	ResourceType           types.String                                             `tfsdk:"resource_type"`
+	StartWindowMinutes     types.Int64                                              `tfsdk:"start_window_minutes"`
+	State                  fwtypes.StringEnum[awstypes.BackupJobState]              `tfsdk:"state"`
+	StatusMessage          types.String                                             `tfsdk:"status_message"`
+	Timeouts               timeouts.Value                                           `tfsdk:"timeouts"`
+}
+
+type backupJobLifecycleModel struct { // nosemgrep:ci.backup-in-var-name
+	DeleteAfterDays                     types.Int64 `tfsdk:"delete_after_days"`
+	MoveToColdStorageAfterDays          types.Int64 `tfsdk:"move_to_cold_storage_after_days"`
+	OptInToArchiveForSupportedResources types.Bool  `tfsdk:"opt_in_to_archive_for_supported_resources"`
+}
+
+func findBackupJobByID(ctx context.Context, conn *backup.Client, id string) (*backup.DescribeBackupJobOutput, error) { // nosemgrep:ci.backup-in-func-name
+	input := &backup.DescribeBackupJobInput{
+		BackupJobId: aws.String(id),
+	}
+
+	output, err := conn.DescribeBackupJob(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusBackupJob(ctx context.Context, conn *backup.Client, id string) retry.StateRefreshFunc { // nosemgrep:ci.backup-in-func-name
+	return func() (interface{}, string, error) {
+		output, err := findBackupJobByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.State), nil
+	}
+}
+
+func waitBackupJobCompleted(ctx context.Context, conn *backup.Client, id string, timeout time.Duration) (*backup.DescribeBackupJobOutput, error) { // nosemgrep:ci.backup-in-func-name
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.BackupJobStateCreated, awstypes.BackupJobStatePending, awstypes.BackupJobStateRunning, awstypes.BackupJobStateAborting),
+		Target:  enum.Slice(awstypes.BackupJobStateCompleted),
+		Refresh: statusBackupJob(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*backup.DescribeBackupJobOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
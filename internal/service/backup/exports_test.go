@@ -5,12 +5,14 @@ package backup
 
 // Exports for use in tests only.
 var (
+	ResourceBackupJob               = newBackupJobResource // nosemgrep:ci.backup-in-var-name
 	ResourceFramework               = resourceFramework
 	ResourceGlobalSettings          = resourceGlobalSettings
 	ResourceLogicallyAirGappedVault = newLogicallyAirGappedVaultResource
 	ResourcePlan                    = resourcePlan
 	ResourceRegionSettings          = resourceRegionSettings
 	ResourceReportPlan              = resourceReportPlan
+	ResourceRestoreJob              = newRestoreJobResource
 	ResourceRestoreTestingPlan      = newRestoreTestingPlanResource
 	ResourceRestoreTestingSelection = newRestoreTestingSelectionResource
 	ResourceSelection               = resourceSelection
@@ -19,6 +21,7 @@ var (
 	ResourceVaultNotifications      = resourceVaultNotifications
 	ResourceVaultPolicy             = resourceVaultPolicy
 
+	FindBackupJobByID                       = findBackupJobByID     // nosemgrep:ci.backup-in-var-name
 	FindBackupVaultByName                   = findBackupVaultByName // nosemgrep:ci.backup-in-var-name
 	FindFrameworkByName                     = findFrameworkByName
 	FindGlobalSettings                      = findGlobalSettings
@@ -26,9 +29,12 @@ var (
 	FindPlanByID                            = findPlanByID
 	FindRegionSettings                      = findRegionSettings
 	FindReportPlanByName                    = findReportPlanByName
+	FindRestoreJobByID                      = findRestoreJobByID
 	FindRestoreTestingPlanByName            = findRestoreTestingPlanByName
 	FindRestoreTestingSelectionByTwoPartKey = findRestoreTestingSelectionByTwoPartKey
 	FindSelectionByTwoPartKey               = findSelectionByTwoPartKey
 	FindVaultAccessPolicyByName             = findVaultAccessPolicyByName
 	FindVaultNotificationsByName            = findVaultNotificationsByName
+
+	WaitBackupJobCompleted = waitBackupJobCompleted // nosemgrep:ci.backup-in-var-name
 )
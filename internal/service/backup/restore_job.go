@@ -0,0 +1,764 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/backup"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/backup/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	restoreJobResourceTypeDynamoDB = "DynamoDB"
+	restoreJobResourceTypeEBS      = "EBS"
+	restoreJobResourceTypeEFS      = "EFS"
+	restoreJobResourceTypeRDS      = "RDS"
+	restoreJobResourceTypeS3       = "S3"
+)
+
+// @FrameworkResource("aws_backup_restore_job", name="Restore Job")
+func newRestoreJobResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &restoreJobResource{}
+
+	r.SetDefaultCreateTimeout(120 * time.Minute)
+
+	return r, nil
+}
+
+type restoreJobResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoUpdate
+	framework.WithNoOpDelete
+	framework.WithTimeouts
+	framework.WithImportByID
+}
+
+func (*restoreJobResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_backup_restore_job"
+}
+
+func (r *restoreJobResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	requiresReplaceString := []planmodifier.String{
+		stringplanmodifier.RequiresReplace(),
+	}
+	requiresReplaceBool := []planmodifier.Bool{
+		boolplanmodifier.RequiresReplace(),
+	}
+	requiresReplaceInt64 := []planmodifier.Int64{
+		int64planmodifier.RequiresReplace(),
+	}
+	requiresReplaceSet := []planmodifier.Set{
+		setplanmodifier.RequiresReplace(),
+	}
+	metadataBlock := func(attributes map[string]schema.Attribute) schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.RequiresReplace(),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: attributes,
+			},
+		}
+	}
+
+	dynamoDBBlock := metadataBlock(map[string]schema.Attribute{
+		"encryption_type": schema.StringAttribute{
+			Optional: true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("Default", "KMS"),
+			},
+			PlanModifiers: requiresReplaceString,
+		},
+		"kms_master_key_arn": schema.StringAttribute{
+			CustomType:    fwtypes.ARNType,
+			Optional:      true,
+			PlanModifiers: requiresReplaceString,
+		},
+		"target_table_name": schema.StringAttribute{
+			Required:      true,
+			PlanModifiers: requiresReplaceString,
+		},
+	})
+	dynamoDBBlock.CustomType = fwtypes.NewListNestedObjectTypeOf[restoreJobDynamoDBMetadataModel](ctx)
+
+	ebsBlock := metadataBlock(map[string]schema.Attribute{
+		names.AttrAvailabilityZone: schema.StringAttribute{
+			Required:      true,
+			PlanModifiers: requiresReplaceString,
+		},
+		names.AttrEncrypted: schema.BoolAttribute{
+			Optional:      true,
+			PlanModifiers: requiresReplaceBool,
+		},
+		names.AttrIOPS: schema.Int64Attribute{
+			Optional:      true,
+			PlanModifiers: requiresReplaceInt64,
+		},
+		names.AttrKMSKeyID: schema.StringAttribute{
+			Optional:      true,
+			PlanModifiers: requiresReplaceString,
+		},
+		names.AttrThroughput: schema.Int64Attribute{
+			Optional:      true,
+			PlanModifiers: requiresReplaceInt64,
+		},
+		names.AttrVolumeSize: schema.Int64Attribute{
+			Optional:      true,
+			PlanModifiers: requiresReplaceInt64,
+		},
+		names.AttrVolumeType: schema.StringAttribute{
+			Optional:      true,
+			PlanModifiers: requiresReplaceString,
+		},
+	})
+	ebsBlock.CustomType = fwtypes.NewListNestedObjectTypeOf[restoreJobEBSMetadataModel](ctx)
+
+	efsBlock := metadataBlock(map[string]schema.Attribute{
+		"creation_token": schema.StringAttribute{
+			Optional:      true,
+			PlanModifiers: requiresReplaceString,
+		},
+		names.AttrEncrypted: schema.BoolAttribute{
+			Optional:      true,
+			PlanModifiers: requiresReplaceBool,
+		},
+		names.AttrFileSystemID: schema.StringAttribute{
+			Optional:      true,
+			PlanModifiers: requiresReplaceString,
+		},
+		"items_to_restore": schema.SetAttribute{
+			CustomType:    fwtypes.SetOfStringType,
+			ElementType:   types.StringType,
+			Optional:      true,
+			PlanModifiers: requiresReplaceSet,
+		},
+		names.AttrKMSKeyID: schema.StringAttribute{
+			Optional:      true,
+			PlanModifiers: requiresReplaceString,
+		},
+		"new_file_system": schema.BoolAttribute{
+			Required:      true,
+			PlanModifiers: requiresReplaceBool,
+		},
+		"performance_mode": schema.StringAttribute{
+			Optional: true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("generalPurpose", "maxIO"),
+			},
+			PlanModifiers: requiresReplaceString,
+		},
+	})
+	efsBlock.CustomType = fwtypes.NewListNestedObjectTypeOf[restoreJobEFSMetadataModel](ctx)
+
+	rdsBlock := metadataBlock(map[string]schema.Attribute{
+		names.AttrAvailabilityZone: schema.StringAttribute{
+			Optional:      true,
+			PlanModifiers: requiresReplaceString,
+		},
+		"db_instance_class": schema.StringAttribute{
+			Optional:      true,
+			PlanModifiers: requiresReplaceString,
+		},
+		"db_instance_identifier": schema.StringAttribute{
+			Required:      true,
+			PlanModifiers: requiresReplaceString,
+		},
+		"db_parameter_group_name": schema.StringAttribute{
+			Optional:      true,
+			PlanModifiers: requiresReplaceString,
+		},
+		"db_subnet_group_name": schema.StringAttribute{
+			Optional:      true,
+			PlanModifiers: requiresReplaceString,
+		},
+		names.AttrDeletionProtection: schema.BoolAttribute{
+			Optional:      true,
+			PlanModifiers: requiresReplaceBool,
+		},
+		"multi_az": schema.BoolAttribute{
+			Optional:      true,
+			PlanModifiers: requiresReplaceBool,
+		},
+		"option_group_name": schema.StringAttribute{
+			Optional:      true,
+			PlanModifiers: requiresReplaceString,
+		},
+		names.AttrPort: schema.Int64Attribute{
+			Optional:      true,
+			PlanModifiers: requiresReplaceInt64,
+		},
+		names.AttrPubliclyAccessible: schema.BoolAttribute{
+			Optional:      true,
+			PlanModifiers: requiresReplaceBool,
+		},
+		names.AttrStorageType: schema.StringAttribute{
+			Optional:      true,
+			PlanModifiers: requiresReplaceString,
+		},
+		names.AttrVPCSecurityGroupIDs: schema.SetAttribute{
+			CustomType:    fwtypes.SetOfStringType,
+			ElementType:   types.StringType,
+			Optional:      true,
+			PlanModifiers: requiresReplaceSet,
+		},
+	})
+	rdsBlock.CustomType = fwtypes.NewListNestedObjectTypeOf[restoreJobRDSMetadataModel](ctx)
+
+	s3Block := metadataBlock(map[string]schema.Attribute{
+		"destination_bucket_name": schema.StringAttribute{
+			Required:      true,
+			PlanModifiers: requiresReplaceString,
+		},
+		names.AttrEncrypted: schema.BoolAttribute{
+			Optional:      true,
+			PlanModifiers: requiresReplaceBool,
+		},
+		"encryption_type": schema.StringAttribute{
+			Optional: true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("SSE-S3", "SSE-KMS"),
+			},
+			PlanModifiers: requiresReplaceString,
+		},
+		"items_to_restore": schema.SetAttribute{
+			CustomType:    fwtypes.SetOfStringType,
+			ElementType:   types.StringType,
+			Optional:      true,
+			PlanModifiers: requiresReplaceSet,
+		},
+		names.AttrKMSKey: schema.StringAttribute{
+			Optional:      true,
+			PlanModifiers: requiresReplaceString,
+		},
+		"new_bucket": schema.BoolAttribute{
+			Optional:      true,
+			PlanModifiers: requiresReplaceBool,
+		},
+	})
+	s3Block.CustomType = fwtypes.NewListNestedObjectTypeOf[restoreJobS3MetadataModel](ctx)
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"backup_size_in_bytes": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"completion_date": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"copy_source_tags_to_restored_resource": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"created_resource_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrCreationDate: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrIAMRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"metadata": schema.MapAttribute{
+				CustomType: fwtypes.MapOfStringType,
+				Optional:   true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"recovery_point_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrResourceType: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.RestoreJobStatus](),
+				Computed:   true,
+			},
+			names.AttrStatusMessage: schema.StringAttribute{
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"dynamodb": dynamoDBBlock,
+			"ebs":      ebsBlock,
+			"efs":      efsBlock,
+			"rds":      rdsBlock,
+			"s3":       s3Block,
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *restoreJobResource) ConfigValidators(context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.Conflicting(
+			path.MatchRoot("dynamodb"),
+			path.MatchRoot("ebs"),
+			path.MatchRoot("efs"),
+			path.MatchRoot("rds"),
+			path.MatchRoot("s3"),
+		),
+	}
+}
+
+func (r *restoreJobResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data restoreJobResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BackupClient(ctx)
+
+	recoveryPointARN := data.RecoveryPointARN.ValueString()
+	input := &backup.StartRestoreJobInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.IdempotencyToken = aws.String(sdkid.UniqueId())
+
+	resourceType, metadata, diags := data.expandRestoreMetadata(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input.Metadata = metadata
+	if input.ResourceType == nil && resourceType != "" {
+		input.ResourceType = aws.String(resourceType)
+	}
+
+	output, err := conn.StartRestoreJob(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("starting Backup Restore Job (%s)", recoveryPointARN), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.RestoreJobID = fwflex.StringToFramework(ctx, output.RestoreJobId)
+
+	job, err := waitRestoreJobCompleted(ctx, conn, data.RestoreJobID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.RestoreJobID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Backup Restore Job (%s) complete", data.RestoreJobID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, job, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *restoreJobResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data restoreJobResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BackupClient(ctx)
+
+	output, err := findRestoreJobByID(ctx, conn, data.RestoreJobID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Backup Restore Job (%s)", data.RestoreJobID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type restoreJobResourceModel struct {
+	BackupSizeInBytes                types.Int64                                                      `tfsdk:"backup_size_in_bytes"`
+	CompletionDate                   timetypes.RFC3339                                                `tfsdk:"completion_date"`
+	CopySourceTagsToRestoredResource types.Bool                                                       `tfsdk:"copy_source_tags_to_restored_resource"`
+	CreatedResourceARN               types.String                                                     `tfsdk:"created_resource_arn"`
+	CreationDate                     timetypes.RFC3339                                                `tfsdk:"creation_date"`
+	DynamoDB                         fwtypes.ListNestedObjectValueOf[restoreJobDynamoDBMetadataModel] `tfsdk:"dynamodb"`
+	EBS                              fwtypes.ListNestedObjectValueOf[restoreJobEBSMetadataModel]      `tfsdk:"ebs"`
+	EFS                              fwtypes.ListNestedObjectValueOf[restoreJobEFSMetadataModel]      `tfsdk:"efs"`
+	IAMRoleARN                       fwtypes.ARN                                                      `tfsdk:"iam_role_arn"`
+	Metadata                         fwtypes.MapOfString                                              `tfsdk:"metadata"`
+	RDS                              fwtypes.ListNestedObjectValueOf[restoreJobRDSMetadataModel]      `tfsdk:"rds"`
+	RecoveryPointARN                 fwtypes.ARN                                                      `tfsdk:"recovery_point_arn"`
+	ResourceType                     types.String                                                     `tfsdk:"resource_type"`
+	RestoreJobID                     types.String                                                     `tfsdk:"id"`
+	S3                               fwtypes.ListNestedObjectValueOf[restoreJobS3MetadataModel]       `tfsdk:"s3"`
+	Status                           fwtypes.StringEnum[awstypes.RestoreJobStatus]                    `tfsdk:"status"`
+	StatusMessage                    types.String                                                     `tfsdk:"status_message"`
+	Timeouts                         timeouts.Value                                                   `tfsdk:"timeouts"`
+}
+
+// expandRestoreMetadata returns the restore metadata built from the typed per-resource-type block, if any,
+// overlaid with the raw `metadata` map, along with the resource type implied by the typed block.
+func (m restoreJobResourceModel) expandRestoreMetadata(ctx context.Context) (string, map[string]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var resourceType string
+	metadata := restoreMetadata{}
+
+	dynamoDB, d := m.DynamoDB.ToPtr(ctx)
+	diags.Append(d...)
+	ebs, d := m.EBS.ToPtr(ctx)
+	diags.Append(d...)
+	efs, d := m.EFS.ToPtr(ctx)
+	diags.Append(d...)
+	rds, d := m.RDS.ToPtr(ctx)
+	diags.Append(d...)
+	s3, d := m.S3.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return "", nil, diags
+	}
+
+	var err error
+	switch {
+	case dynamoDB != nil:
+		resourceType = restoreJobResourceTypeDynamoDB
+		dynamoDB.restoreMetadata(metadata)
+	case ebs != nil:
+		resourceType = restoreJobResourceTypeEBS
+		ebs.restoreMetadata(metadata)
+	case efs != nil:
+		resourceType = restoreJobResourceTypeEFS
+		err = efs.restoreMetadata(ctx, metadata)
+	case rds != nil:
+		resourceType = restoreJobResourceTypeRDS
+		err = rds.restoreMetadata(ctx, metadata)
+	case s3 != nil:
+		resourceType = restoreJobResourceTypeS3
+		err = s3.restoreMetadata(ctx, metadata)
+	}
+
+	if err != nil {
+		diags.AddError("building Backup Restore Job metadata", err.Error())
+
+		return "", nil, diags
+	}
+
+	maps.Copy(metadata, fwflex.ExpandFrameworkStringValueMap(ctx, m.Metadata))
+
+	if len(metadata) == 0 {
+		return resourceType, nil, diags
+	}
+
+	return resourceType, metadata, diags
+}
+
+type restoreJobDynamoDBMetadataModel struct {
+	EncryptionType  types.String `tfsdk:"encryption_type"`
+	KMSMasterKeyARN fwtypes.ARN  `tfsdk:"kms_master_key_arn"`
+	TargetTableName types.String `tfsdk:"target_table_name"`
+}
+
+func (m *restoreJobDynamoDBMetadataModel) restoreMetadata(metadata restoreMetadata) {
+	metadata.setString("encryptionType", m.EncryptionType)
+	metadata.setString("kmsMasterKeyArn", m.KMSMasterKeyARN)
+	metadata.setString("targetTableName", m.TargetTableName)
+}
+
+type restoreJobEBSMetadataModel struct {
+	AvailabilityZone types.String `tfsdk:"availability_zone"`
+	Encrypted        types.Bool   `tfsdk:"encrypted"`
+	IOPS             types.Int64  `tfsdk:"iops"`
+	KMSKeyID         types.String `tfsdk:"kms_key_id"`
+	Throughput       types.Int64  `tfsdk:"throughput"`
+	VolumeSize       types.Int64  `tfsdk:"volume_size"`
+	VolumeType       types.String `tfsdk:"volume_type"`
+}
+
+func (m *restoreJobEBSMetadataModel) restoreMetadata(metadata restoreMetadata) {
+	metadata.setString("availabilityZone", m.AvailabilityZone)
+	metadata.setBool("encrypted", m.Encrypted)
+	metadata.setInt64("iops", m.IOPS)
+	metadata.setString("kmsKeyId", m.KMSKeyID)
+	metadata.setInt64("throughput", m.Throughput)
+	metadata.setInt64("volumeSize", m.VolumeSize)
+	metadata.setString("volumeType", m.VolumeType)
+}
+
+type restoreJobEFSMetadataModel struct {
+	CreationToken   types.String        `tfsdk:"creation_token"`
+	Encrypted       types.Bool          `tfsdk:"encrypted"`
+	FileSystemID    types.String        `tfsdk:"file_system_id"`
+	ItemsToRestore  fwtypes.SetOfString `tfsdk:"items_to_restore"`
+	KMSKeyID        types.String        `tfsdk:"kms_key_id"`
+	NewFileSystem   types.Bool          `tfsdk:"new_file_system"`
+	PerformanceMode types.String        `tfsdk:"performance_mode"`
+}
+
+func (m *restoreJobEFSMetadataModel) restoreMetadata(ctx context.Context, metadata restoreMetadata) error {
+	metadata.setString("CreationToken", m.CreationToken)
+	metadata.setBool("Encrypted", m.Encrypted)
+	metadata.setString("file-system-id", m.FileSystemID)
+	metadata.setString("KmsKeyId", m.KMSKeyID)
+	metadata.setBool("newFileSystem", m.NewFileSystem)
+	metadata.setString("PerformanceMode", m.PerformanceMode)
+
+	return metadata.setStringSet(ctx, "ItemsToRestore", m.ItemsToRestore)
+}
+
+type restoreJobRDSMetadataModel struct {
+	AvailabilityZone     types.String        `tfsdk:"availability_zone"`
+	DBInstanceClass      types.String        `tfsdk:"db_instance_class"`
+	DBInstanceIdentifier types.String        `tfsdk:"db_instance_identifier"`
+	DBParameterGroupName types.String        `tfsdk:"db_parameter_group_name"`
+	DBSubnetGroupName    types.String        `tfsdk:"db_subnet_group_name"`
+	DeletionProtection   types.Bool          `tfsdk:"deletion_protection"`
+	MultiAZ              types.Bool          `tfsdk:"multi_az"`
+	OptionGroupName      types.String        `tfsdk:"option_group_name"`
+	Port                 types.Int64         `tfsdk:"port"`
+	PubliclyAccessible   types.Bool          `tfsdk:"publicly_accessible"`
+	StorageType          types.String        `tfsdk:"storage_type"`
+	VPCSecurityGroupIDs  fwtypes.SetOfString `tfsdk:"vpc_security_group_ids"`
+}
+
+func (m *restoreJobRDSMetadataModel) restoreMetadata(ctx context.Context, metadata restoreMetadata) error {
+	metadata.setString("AvailabilityZone", m.AvailabilityZone)
+	metadata.setString("DBInstanceClass", m.DBInstanceClass)
+	metadata.setString("DBInstanceIdentifier", m.DBInstanceIdentifier)
+	metadata.setString("DBParameterGroupName", m.DBParameterGroupName)
+	metadata.setString("DBSubnetGroupName", m.DBSubnetGroupName)
+	metadata.setBool("DeletionProtection", m.DeletionProtection)
+	metadata.setBool("MultiAZ", m.MultiAZ)
+	metadata.setString("OptionGroupName", m.OptionGroupName)
+	metadata.setInt64("Port", m.Port)
+	metadata.setBool("PubliclyAccessible", m.PubliclyAccessible)
+	metadata.setString("StorageType", m.StorageType)
+
+	return metadata.setStringSet(ctx, "VpcSecurityGroupIds", m.VPCSecurityGroupIDs)
+}
+
+type restoreJobS3MetadataModel struct {
+	DestinationBucketName types.String        `tfsdk:"destination_bucket_name"`
+	Encrypted             types.Bool          `tfsdk:"encrypted"`
+	EncryptionType        types.String        `tfsdk:"encryption_type"`
+	ItemsToRestore        fwtypes.SetOfString `tfsdk:"items_to_restore"`
+	KMSKey                types.String        `tfsdk:"kms_key"`
+	NewBucket             types.Bool          `tfsdk:"new_bucket"`
+}
+
+func (m *restoreJobS3MetadataModel) restoreMetadata(ctx context.Context, metadata restoreMetadata) error {
+	metadata.setString("DestinationBucketName", m.DestinationBucketName)
+	metadata.setBool("Encrypted", m.Encrypted)
+	metadata.setString("EncryptionType", m.EncryptionType)
+	metadata.setString("KMSKey", m.KMSKey)
+	metadata.setBool("NewBucket", m.NewBucket)
+
+	return metadata.setStringSet(ctx, "ItemsToRestore", m.ItemsToRestore)
+}
+
+// restoreMetadata is the string-valued metadata passed to StartRestoreJob.
+// Unset (null or unknown) values are omitted.
+type restoreMetadata map[string]string
+
+func (m restoreMetadata) setString(key string, v interface {
+	IsNull() bool
+	IsUnknown() bool
+	ValueString() string
+}) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	m[key] = v.ValueString()
+}
+
+func (m restoreMetadata) setBool(key string, v types.Bool) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	m[key] = strconv.FormatBool(v.ValueBool())
+}
+
+func (m restoreMetadata) setInt64(key string, v types.Int64) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	m[key] = strconv.FormatInt(v.ValueInt64(), 10)
+}
+
+// setStringSet stores the set's elements as a JSON array.
+func (m restoreMetadata) setStringSet(ctx context.Context, key string, v fwtypes.SetOfString) error {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+
+	elements := fwflex.ExpandFrameworkStringValueSet(ctx, v)
+	if len(elements) == 0 {
+		return nil
+	}
+
+	b, err := json.Marshal(slices.Sorted(slices.Values(elements)))
+
+	if err != nil {
+		return fmt.Errorf("encoding %s: %w", key, err)
+	}
+
+	m[key] = string(b)
+
+	return nil
+}
+
+func findRestoreJobByID(ctx context.Context, conn *backup.Client, id string) (*backup.DescribeRestoreJobOutput, error) {
+	input := &backup.DescribeRestoreJobInput{
+		RestoreJobId: aws.String(id),
+	}
+
+	output, err := conn.DescribeRestoreJob(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusRestoreJob(ctx context.Context, conn *backup.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findRestoreJobByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitRestoreJobCompleted(ctx context.Context, conn *backup.Client, id string, timeout time.Duration) (*backup.DescribeRestoreJobOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.RestoreJobStatusPending, awstypes.RestoreJobStatusRunning),
+		Target:  enum.Slice(awstypes.RestoreJobStatusCompleted),
+		Refresh: statusRestoreJob(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*backup.DescribeRestoreJobOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package backup_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/backup"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/backup/types"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	dynamodbtypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tfbackup "github.com/hashicorp/terraform-provider-aws/internal/service/backup"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccBackupRestoreJob_dynamoDB(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v backup.DescribeRestoreJobOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_backup_restore_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BackupServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRestoreJobDeleteCreatedDynamoDBTable(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRestoreJobConfig_dynamoDB(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRestoreJobExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "copy_source_tags_to_restored_resource", acctest.CtFalse),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "created_resource_arn", "dynamodb", fmt.Sprintf("table/%s-restored", rName)),
+					resource.TestCheckResourceAttr(resourceName, "dynamodb.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "dynamodb.0.target_table_name", rName+"-restored"),
+					resource.TestCheckResourceAttrPair(resourceName, "recovery_point_arn", "aws_backup_backup_job.test", "recovery_point_arn"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrResourceType, "DynamoDB"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.RestoreJobStatusCompleted)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"copy_source_tags_to_restored_resource", "dynamodb", names.AttrTimeouts},
+			},
+		},
+	})
+}
+
+func testAccCheckRestoreJobExists(ctx context.Context, n string, v *backup.DescribeRestoreJobOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BackupClient(ctx)
+
+		output, err := tfbackup.FindRestoreJobByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+// testAccCheckRestoreJobDeleteCreatedDynamoDBTable deletes the tables created by restore jobs.
+// Restored resources are not managed by the restore job resource and so are not deleted on destroy.
+func testAccCheckRestoreJobDeleteCreatedDynamoDBTable(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_backup_restore_job" {
+				continue
+			}
+
+			v, err := arn.Parse(rs.Primary.Attributes["created_resource_arn"])
+
+			if err != nil {
+				continue
+			}
+
+			_, err = conn.DeleteTable(ctx, &dynamodb.DeleteTableInput{
+				TableName: aws.String(strings.TrimPrefix(v.Resource, "table/")),
+			})
+
+			if errs.IsA[*dynamodbtypes.ResourceNotFoundException](err) {
+				continue
+			}
+
+			if err != nil {
+				return fmt.Errorf("deleting DynamoDB Table restored by Backup Restore Job (%s): %w", rs.Primary.ID, err)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccRestoreJobConfig_dynamoDB(rName string) string {
+	return acctest.ConfigCompose(testAccBackupJobConfig_basic(rName), fmt.Sprintf(`
+resource "aws_backup_restore_job" "test" {
+  recovery_point_arn = aws_backup_backup_job.test.recovery_point_arn
+  iam_role_arn       = aws_iam_role.test.arn
+
+  dynamodb {
+    target_table_name = "%[1]s-restored"
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.restore]
+}
+`, rName))
+}
@@ -20,6 +20,10 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
 	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory: newBackupJobResource,
+			Name:    "Backup Job",
+		},
 		{
 			Factory: newLogicallyAirGappedVaultResource,
 			Name:    "Logically Air Gapped Vault",
@@ -27,6 +31,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory: newRestoreJobResource,
+			Name:    "Restore Job",
+		},
 		{
 			Factory: newRestoreTestingPlanResource,
 			Name:    "Restore Testing Plan",
@@ -131,6 +139,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Stateful: true,
 		},
 		{
 			Factory:  resourceVaultLockConfiguration,
@@ -0,0 +1,1516 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrockagent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagent"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagent/document"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrockagent/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Flow")
+// @Tags(identifierAttribute="arn")
+func newFlowResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &flowResource{}
+
+	r.SetDefaultCreateTimeout(5 * time.Minute)
+	r.SetDefaultUpdateTimeout(5 * time.Minute)
+
+	return r, nil
+}
+
+type flowResource struct {
+	framework.ResourceWithConfigure
+	framework.WithTimeouts
+}
+
+func (*flowResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_bedrockagent_flow"
+}
+
+func (r *flowResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	nameValidator := stringvalidator.RegexMatches(regexache.MustCompile(`^[a-zA-Z]([_]?[0-9a-zA-Z]){1,50}$`), "must start with a letter and contain only letters, digits and single underscores. The name can have up to 51 characters")
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"customer_encryption_key_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 200),
+				},
+			},
+			names.AttrExecutionRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`^([0-9a-zA-Z][_-]?){1,100}$`), "valid characters are a-z, A-Z, 0-9, _ (underscore) and - (hyphen). The name can have up to 100 characters"),
+				},
+			},
+			"prepare_flow": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"skip_resource_in_use_check": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.FlowStatus](),
+				Computed:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"updated_at": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrVersion: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"definition": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[flowDefinitionModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"connection": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[flowConnectionModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrName: schema.StringAttribute{
+										Required: true,
+										Validators: []validator.String{
+											stringvalidator.RegexMatches(regexache.MustCompile(`^[a-zA-Z]([_]?[0-9a-zA-Z]){1,100}$`), "must start with a letter and contain only letters, digits and single underscores. The name can have up to 101 characters"),
+										},
+									},
+									names.AttrSource: schema.StringAttribute{
+										Required:   true,
+										Validators: []validator.String{nameValidator},
+									},
+									names.AttrTarget: schema.StringAttribute{
+										Required:   true,
+										Validators: []validator.String{nameValidator},
+									},
+									names.AttrType: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.FlowConnectionType](),
+										Required:   true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									names.AttrConfiguration: schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[flowConnectionConfigurationModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Blocks: map[string]schema.Block{
+												"conditional": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[flowConditionalConnectionConfigurationModel](ctx),
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(1),
+														listvalidator.ExactlyOneOf(
+															path.MatchRelative().AtParent().AtName("conditional"),
+															path.MatchRelative().AtParent().AtName("data"),
+														),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															names.AttrCondition: schema.StringAttribute{
+																Required:   true,
+																Validators: []validator.String{nameValidator},
+															},
+														},
+													},
+												},
+												"data": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[flowDataConnectionConfigurationModel](ctx),
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															"source_output": schema.StringAttribute{
+																Required:   true,
+																Validators: []validator.String{nameValidator},
+															},
+															"target_input": schema.StringAttribute{
+																Required:   true,
+																Validators: []validator.String{nameValidator},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"node": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[flowNodeModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrName: schema.StringAttribute{
+										Required:   true,
+										Validators: []validator.String{nameValidator},
+									},
+									names.AttrType: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.FlowNodeType](),
+										Required:   true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									names.AttrConfiguration: flowNodeConfigurationBlock(ctx),
+									"input": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[flowNodeInputModel](ctx),
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												names.AttrExpression: schema.StringAttribute{
+													Required: true,
+													Validators: []validator.String{
+														stringvalidator.LengthBetween(1, 64),
+													},
+												},
+												names.AttrName: schema.StringAttribute{
+													Required:   true,
+													Validators: []validator.String{nameValidator},
+												},
+												names.AttrType: schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.FlowNodeIODataType](),
+													Required:   true,
+												},
+											},
+										},
+									},
+									"output": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[flowNodeOutputModel](ctx),
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												names.AttrName: schema.StringAttribute{
+													Required:   true,
+													Validators: []validator.String{nameValidator},
+												},
+												names.AttrType: schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.FlowNodeIODataType](),
+													Required:   true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+		},
+	}
+}
+
+func flowNodeConfigurationBlock(ctx context.Context) schema.ListNestedBlock {
+	// Exactly one configuration block, matching the node's type, must be specified.
+	configurationPaths := []path.Expression{
+		path.MatchRelative().AtParent().AtName("agent"),
+		path.MatchRelative().AtParent().AtName("collector"),
+		path.MatchRelative().AtParent().AtName(names.AttrCondition),
+		path.MatchRelative().AtParent().AtName("input"),
+		path.MatchRelative().AtParent().AtName("iterator"),
+		path.MatchRelative().AtParent().AtName("knowledge_base"),
+		path.MatchRelative().AtParent().AtName("lambda_function"),
+		path.MatchRelative().AtParent().AtName("lex"),
+		path.MatchRelative().AtParent().AtName("output"),
+		path.MatchRelative().AtParent().AtName("prompt"),
+		path.MatchRelative().AtParent().AtName("retrieval"),
+		path.MatchRelative().AtParent().AtName("storage"),
+	}
+	emptyBlock := func() schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[flowNodeEmptyConfigurationModel](ctx),
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{},
+		}
+	}
+	s3Block := func() schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[flowNodeS3ConfigurationModel](ctx),
+			Validators: []validator.List{
+				listvalidator.IsRequired(),
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					names.AttrBucketName: schema.StringAttribute{
+						Required: true,
+					},
+				},
+			},
+		}
+	}
+
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[flowNodeConfigurationModel](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"agent": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[agentFlowNodeConfigurationModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+						listvalidator.ExactlyOneOf(configurationPaths...),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"agent_alias_arn": schema.StringAttribute{
+								CustomType: fwtypes.ARNType,
+								Required:   true,
+							},
+						},
+					},
+				},
+				"collector": emptyBlock(),
+				names.AttrCondition: schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[conditionFlowNodeConfigurationModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Blocks: map[string]schema.Block{
+							names.AttrCondition: schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[flowConditionModel](ctx),
+								Validators: []validator.List{
+									listvalidator.IsRequired(),
+									listvalidator.SizeBetween(1, 5),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										names.AttrExpression: schema.StringAttribute{
+											Optional: true,
+											Validators: []validator.String{
+												stringvalidator.LengthBetween(1, 64),
+											},
+										},
+										names.AttrName: schema.StringAttribute{
+											Required: true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				"input":    emptyBlock(),
+				"iterator": emptyBlock(),
+				"knowledge_base": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[knowledgeBaseFlowNodeConfigurationModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"knowledge_base_id": schema.StringAttribute{
+								Required: true,
+							},
+							"model_id": schema.StringAttribute{
+								Optional: true,
+							},
+						},
+					},
+				},
+				"lambda_function": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[lambdaFunctionFlowNodeConfigurationModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"lambda_arn": schema.StringAttribute{
+								CustomType: fwtypes.ARNType,
+								Required:   true,
+							},
+						},
+					},
+				},
+				"lex": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[lexFlowNodeConfigurationModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"bot_alias_arn": schema.StringAttribute{
+								CustomType: fwtypes.ARNType,
+								Required:   true,
+							},
+							"locale_id": schema.StringAttribute{
+								Required: true,
+							},
+						},
+					},
+				},
+				"output": emptyBlock(),
+				"prompt": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[promptFlowNodeConfigurationModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Blocks: map[string]schema.Block{
+							"source_configuration": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[promptFlowNodeSourceConfigurationModel](ctx),
+								Validators: []validator.List{
+									listvalidator.IsRequired(),
+									listvalidator.SizeAtMost(1),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Blocks: map[string]schema.Block{
+										"inline": schema.ListNestedBlock{
+											CustomType: fwtypes.NewListNestedObjectTypeOf[promptFlowNodeInlineConfigurationModel](ctx),
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(1),
+												listvalidator.ExactlyOneOf(
+													path.MatchRelative().AtParent().AtName("inline"),
+													path.MatchRelative().AtParent().AtName("resource"),
+												),
+											},
+											NestedObject: schema.NestedBlockObject{
+												Attributes: map[string]schema.Attribute{
+													"additional_model_request_fields": schema.StringAttribute{
+														CustomType: fwtypes.NewSmithyJSONType(ctx, document.NewLazyDocument),
+														Optional:   true,
+													},
+													"model_id": schema.StringAttribute{
+														Required: true,
+													},
+													"template_type": schema.StringAttribute{
+														CustomType: fwtypes.StringEnumType[awstypes.PromptTemplateType](),
+														Required:   true,
+													},
+												},
+												Blocks: map[string]schema.Block{
+													"inference_configuration": promptInferenceConfigurationBlock(ctx),
+													"template_configuration":  promptTemplateConfigurationBlock(ctx),
+												},
+											},
+										},
+										"resource": schema.ListNestedBlock{
+											CustomType: fwtypes.NewListNestedObjectTypeOf[promptFlowNodeResourceConfigurationModel](ctx),
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(1),
+											},
+											NestedObject: schema.NestedBlockObject{
+												Attributes: map[string]schema.Attribute{
+													"prompt_arn": schema.StringAttribute{
+														CustomType: fwtypes.ARNType,
+														Required:   true,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				"retrieval": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[retrievalFlowNodeConfigurationModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Blocks: map[string]schema.Block{
+							"service_configuration": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[retrievalFlowNodeServiceConfigurationModel](ctx),
+								Validators: []validator.List{
+									listvalidator.IsRequired(),
+									listvalidator.SizeAtMost(1),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Blocks: map[string]schema.Block{
+										"s3": s3Block(),
+									},
+								},
+							},
+						},
+					},
+				},
+				"storage": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[storageFlowNodeConfigurationModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Blocks: map[string]schema.Block{
+							"service_configuration": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[storageFlowNodeServiceConfigurationModel](ctx),
+								Validators: []validator.List{
+									listvalidator.IsRequired(),
+									listvalidator.SizeAtMost(1),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Blocks: map[string]schema.Block{
+										"s3": s3Block(),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *flowResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var data flowResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Definition.IsNull() || data.Definition.IsUnknown() {
+		return
+	}
+
+	definition, diags := data.Definition.ToPtr(ctx)
+	if diags.HasError() || definition == nil {
+		return
+	}
+
+	response.Diagnostics.Append(validateFlowDefinition(ctx, path.Root("definition").AtListIndex(0), definition)...)
+}
+
+func (r *flowResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data flowResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	input := &bedrockagent.CreateFlowInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input.ClientToken = aws.String(id.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateFlow(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating Bedrock Agent Flow", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = fwflex.StringToFramework(ctx, output.Id)
+
+	if data.PrepareFlow.ValueBool() {
+		if _, err := prepareFlow(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts)); err != nil {
+			response.Diagnostics.AddError("creating Bedrock Agent Flow", err.Error())
+
+			return
+		}
+	}
+
+	flow, err := findFlowByID(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Agent Flow (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.setComputedValues(ctx, flow)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *flowResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data flowResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	flowID := data.ID.ValueString()
+	output, err := findFlowByID(ctx, conn, flowID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Agent Flow (%s)", flowID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *flowResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new flowResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	flowID := new.ID.ValueString()
+	updated := false
+
+	if !new.CustomerEncryptionKeyARN.Equal(old.CustomerEncryptionKeyARN) ||
+		!new.Definition.Equal(old.Definition) ||
+		!new.Description.Equal(old.Description) ||
+		!new.ExecutionRoleARN.Equal(old.ExecutionRoleARN) ||
+		!new.Name.Equal(old.Name) {
+		input := &bedrockagent.UpdateFlowInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input.FlowIdentifier = aws.String(flowID)
+
+		_, err := conn.UpdateFlow(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Bedrock Agent Flow (%s)", flowID), err.Error())
+
+			return
+		}
+
+		updated = true
+	}
+
+	// Prepare the flow if it has changed, or if it was previously left unprepared.
+	if new.PrepareFlow.ValueBool() && (updated || !old.PrepareFlow.ValueBool() || old.Status.ValueEnum() != awstypes.FlowStatusPrepared) {
+		if _, err := prepareFlow(ctx, conn, flowID, r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Bedrock Agent Flow (%s)", flowID), err.Error())
+
+			return
+		}
+	}
+
+	flow, err := findFlowByID(ctx, conn, flowID)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Agent Flow (%s)", flowID), err.Error())
+
+		return
+	}
+
+	new.setComputedValues(ctx, flow)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *flowResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data flowResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	flowID := data.ID.ValueString()
+	_, err := conn.DeleteFlow(ctx, &bedrockagent.DeleteFlowInput{
+		FlowIdentifier:         aws.String(flowID),
+		SkipResourceInUseCheck: fwflex.BoolValueFromFramework(ctx, data.SkipResourceInUseCheck),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Bedrock Agent Flow (%s)", flowID), err.Error())
+
+		return
+	}
+}
+
+func (r *flowResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrID), request.ID)...)
+	// Set prepare_flow to default value on import
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("prepare_flow"), true)...)
+}
+
+func (r *flowResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func prepareFlow(ctx context.Context, conn *bedrockagent.Client, id string, timeout time.Duration) (*bedrockagent.GetFlowOutput, error) {
+	input := &bedrockagent.PrepareFlowInput{
+		FlowIdentifier: aws.String(id),
+	}
+
+	_, err := conn.PrepareFlow(ctx, input)
+
+	if err != nil {
+		return nil, fmt.Errorf("preparing Bedrock Agent Flow (%s): %w", id, err)
+	}
+
+	flow, err := waitFlowPrepared(ctx, conn, id, timeout)
+
+	if err != nil {
+		return nil, fmt.Errorf("waiting for Bedrock Agent Flow (%s) prepare: %w", id, err)
+	}
+
+	return flow, nil
+}
+
+func findFlowByID(ctx context.Context, conn *bedrockagent.Client, id string) (*bedrockagent.GetFlowOutput, error) {
+	input := &bedrockagent.GetFlowInput{
+		FlowIdentifier: aws.String(id),
+	}
+
+	output, err := conn.GetFlow(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusFlow(ctx context.Context, conn *bedrockagent.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findFlowByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitFlowPrepared(ctx context.Context, conn *bedrockagent.Client, id string, timeout time.Duration) (*bedrockagent.GetFlowOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.FlowStatusNotPrepared, awstypes.FlowStatusPreparing),
+		Target:  enum.Slice(awstypes.FlowStatusPrepared),
+		Refresh: statusFlow(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*bedrockagent.GetFlowOutput); ok {
+		validations := tfslices.Filter(output.Validations, func(v awstypes.FlowValidation) bool {
+			return v.Severity == awstypes.FlowValidationSeverityError
+		})
+		tfresource.SetLastError(err, errors.Join(tfslices.ApplyToAll(validations, func(v awstypes.FlowValidation) error {
+			return errors.New(aws.ToString(v.Message))
+		})...))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type flowResourceModel struct {
+	ARN                      types.String                                         `tfsdk:"arn"`
+	CreatedAt                timetypes.RFC3339                                    `tfsdk:"created_at"`
+	CustomerEncryptionKeyARN fwtypes.ARN                                          `tfsdk:"customer_encryption_key_arn"`
+	Definition               fwtypes.ListNestedObjectValueOf[flowDefinitionModel] `tfsdk:"definition"`
+	Description              types.String                                         `tfsdk:"description"`
+	ExecutionRoleARN         fwtypes.ARN                                          `tfsdk:"execution_role_arn"`
+	ID                       types.String                                         `tfsdk:"id"`
+	Name                     types.String                                         `tfsdk:"name"`
+	PrepareFlow              types.Bool                                           `tfsdk:"prepare_flow"`
+	SkipResourceInUseCheck   types.Bool                                           `tfsdk:"skip_resource_in_use_check"`
+	Status                   fwtypes.StringEnum[awstypes.FlowStatus]              `tfsdk:"status"`
+	Tags                     tftags.Map                                           `tfsdk:"tags"`
+	TagsAll                  tftags.Map                                           `tfsdk:"tags_all"`
+	Timeouts                 timeouts.Value                                       `tfsdk:"timeouts"`
+	UpdatedAt                timetypes.RFC3339                                    `tfsdk:"updated_at"`
+	Version                  types.String                                         `tfsdk:"version"`
+}
+
+func (m *flowResourceModel) setComputedValues(ctx context.Context, flow *bedrockagent.GetFlowOutput) {
+	m.ARN = fwflex.StringToFramework(ctx, flow.Arn)
+	m.CreatedAt = fwflex.TimeToFramework(ctx, flow.CreatedAt)
+	m.Status = fwtypes.StringEnumValue(flow.Status)
+	m.UpdatedAt = fwflex.TimeToFramework(ctx, flow.UpdatedAt)
+	m.Version = fwflex.StringToFramework(ctx, flow.Version)
+}
+
+type flowDefinitionModel struct {
+	Connection fwtypes.ListNestedObjectValueOf[flowConnectionModel] `tfsdk:"connection"`
+	Node       fwtypes.ListNestedObjectValueOf[flowNodeModel]       `tfsdk:"node"`
+}
+
+type flowConnectionModel struct {
+	Configuration fwtypes.ListNestedObjectValueOf[flowConnectionConfigurationModel] `tfsdk:"configuration"`
+	Name          types.String                                                      `tfsdk:"name"`
+	Source        types.String                                                      `tfsdk:"source"`
+	Target        types.String                                                      `tfsdk:"target"`
+	Type          fwtypes.StringEnum[awstypes.FlowConnectionType]                   `tfsdk:"type"`
+}
+
+type flowConnectionConfigurationModel struct {
+	Conditional fwtypes.ListNestedObjectValueOf[flowConditionalConnectionConfigurationModel] `tfsdk:"conditional"`
+	Data        fwtypes.ListNestedObjectValueOf[flowDataConnectionConfigurationModel]        `tfsdk:"data"`
+}
+
+var (
+	_ fwflex.Expander  = flowConnectionConfigurationModel{}
+	_ fwflex.Flattener = &flowConnectionConfigurationModel{}
+)
+
+func (m flowConnectionConfigurationModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.Conditional.IsNull():
+		flowConditionalConnectionConfigurationData, d := m.Conditional.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.FlowConnectionConfigurationMemberConditional
+		diags.Append(fwflex.Expand(ctx, flowConditionalConnectionConfigurationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.Data.IsNull():
+		flowDataConnectionConfigurationData, d := m.Data.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.FlowConnectionConfigurationMemberData
+		diags.Append(fwflex.Expand(ctx, flowDataConnectionConfigurationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *flowConnectionConfigurationModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.FlowConnectionConfigurationMemberConditional:
+		var model flowConditionalConnectionConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Conditional = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+
+	case awstypes.FlowConnectionConfigurationMemberData:
+		var model flowDataConnectionConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Data = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+	}
+
+	return diags
+}
+
+type flowConditionalConnectionConfigurationModel struct {
+	Condition types.String `tfsdk:"condition"`
+}
+
+type flowDataConnectionConfigurationModel struct {
+	SourceOutput types.String `tfsdk:"source_output"`
+	TargetInput  types.String `tfsdk:"target_input"`
+}
+
+type flowNodeModel struct {
+	Configuration fwtypes.ListNestedObjectValueOf[flowNodeConfigurationModel] `tfsdk:"configuration"`
+	Input         fwtypes.ListNestedObjectValueOf[flowNodeInputModel]         `tfsdk:"input"`
+	Name          types.String                                                `tfsdk:"name"`
+	Output        fwtypes.ListNestedObjectValueOf[flowNodeOutputModel]        `tfsdk:"output"`
+	Type          fwtypes.StringEnum[awstypes.FlowNodeType]                   `tfsdk:"type"`
+}
+
+type flowNodeInputModel struct {
+	Expression types.String                                    `tfsdk:"expression"`
+	Name       types.String                                    `tfsdk:"name"`
+	Type       fwtypes.StringEnum[awstypes.FlowNodeIODataType] `tfsdk:"type"`
+}
+
+type flowNodeOutputModel struct {
+	Name types.String                                    `tfsdk:"name"`
+	Type fwtypes.StringEnum[awstypes.FlowNodeIODataType] `tfsdk:"type"`
+}
+
+type flowNodeConfigurationModel struct {
+	Agent          fwtypes.ListNestedObjectValueOf[agentFlowNodeConfigurationModel]          `tfsdk:"agent"`
+	Collector      fwtypes.ListNestedObjectValueOf[flowNodeEmptyConfigurationModel]          `tfsdk:"collector"`
+	Condition      fwtypes.ListNestedObjectValueOf[conditionFlowNodeConfigurationModel]      `tfsdk:"condition"`
+	Input          fwtypes.ListNestedObjectValueOf[flowNodeEmptyConfigurationModel]          `tfsdk:"input"`
+	Iterator       fwtypes.ListNestedObjectValueOf[flowNodeEmptyConfigurationModel]          `tfsdk:"iterator"`
+	KnowledgeBase  fwtypes.ListNestedObjectValueOf[knowledgeBaseFlowNodeConfigurationModel]  `tfsdk:"knowledge_base"`
+	LambdaFunction fwtypes.ListNestedObjectValueOf[lambdaFunctionFlowNodeConfigurationModel] `tfsdk:"lambda_function"`
+	Lex            fwtypes.ListNestedObjectValueOf[lexFlowNodeConfigurationModel]            `tfsdk:"lex"`
+	Output         fwtypes.ListNestedObjectValueOf[flowNodeEmptyConfigurationModel]          `tfsdk:"output"`
+	Prompt         fwtypes.ListNestedObjectValueOf[promptFlowNodeConfigurationModel]         `tfsdk:"prompt"`
+	Retrieval      fwtypes.ListNestedObjectValueOf[retrievalFlowNodeConfigurationModel]      `tfsdk:"retrieval"`
+	Storage        fwtypes.ListNestedObjectValueOf[storageFlowNodeConfigurationModel]        `tfsdk:"storage"`
+}
+
+var (
+	_ fwflex.Expander  = flowNodeConfigurationModel{}
+	_ fwflex.Flattener = &flowNodeConfigurationModel{}
+)
+
+// nodeType returns the type of node that the configuration is for.
+func (m flowNodeConfigurationModel) nodeType() (awstypes.FlowNodeType, bool) {
+	for _, v := range []struct {
+		value    interface{ IsNull() bool }
+		nodeType awstypes.FlowNodeType
+	}{
+		{m.Agent, awstypes.FlowNodeTypeAgent},
+		{m.Collector, awstypes.FlowNodeTypeCollector},
+		{m.Condition, awstypes.FlowNodeTypeCondition},
+		{m.Input, awstypes.FlowNodeTypeInput},
+		{m.Iterator, awstypes.FlowNodeTypeIterator},
+		{m.KnowledgeBase, awstypes.FlowNodeTypeKnowledgeBase},
+		{m.LambdaFunction, awstypes.FlowNodeTypeLambdaFunction},
+		{m.Lex, awstypes.FlowNodeTypeLex},
+		{m.Output, awstypes.FlowNodeTypeOutput},
+		{m.Prompt, awstypes.FlowNodeTypePrompt},
+		{m.Retrieval, awstypes.FlowNodeTypeRetrieval},
+		{m.Storage, awstypes.FlowNodeTypeStorage},
+	} {
+		if !v.value.IsNull() {
+			return v.nodeType, true
+		}
+	}
+
+	return "", false
+}
+
+// conditionNames returns the names of a Condition node's conditions, or nil if not known.
+func (m flowNodeConfigurationModel) conditionNames(ctx context.Context) map[string]struct{} {
+	if m.Condition.IsNull() || m.Condition.IsUnknown() {
+		return nil
+	}
+
+	condition, d := m.Condition.ToPtr(ctx)
+	if d.HasError() || condition == nil || condition.Condition.IsUnknown() {
+		return nil
+	}
+
+	conditions, d := condition.Condition.ToSlice(ctx)
+	if d.HasError() {
+		return nil
+	}
+
+	result := make(map[string]struct{}, len(conditions))
+
+	for _, v := range conditions {
+		if v.Name.IsUnknown() {
+			return nil
+		}
+
+		result[v.Name.ValueString()] = struct{}{}
+	}
+
+	return result
+}
+
+func (m flowNodeConfigurationModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.Agent.IsNull():
+		agentFlowNodeConfigurationData, d := m.Agent.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.FlowNodeConfigurationMemberAgent
+		diags.Append(fwflex.Expand(ctx, agentFlowNodeConfigurationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.Collector.IsNull():
+		return &awstypes.FlowNodeConfigurationMemberCollector{}, diags
+
+	case !m.Condition.IsNull():
+		conditionFlowNodeConfigurationData, d := m.Condition.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.FlowNodeConfigurationMemberCondition
+		diags.Append(fwflex.Expand(ctx, conditionFlowNodeConfigurationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.Input.IsNull():
+		return &awstypes.FlowNodeConfigurationMemberInput{}, diags
+
+	case !m.Iterator.IsNull():
+		return &awstypes.FlowNodeConfigurationMemberIterator{}, diags
+
+	case !m.KnowledgeBase.IsNull():
+		knowledgeBaseFlowNodeConfigurationData, d := m.KnowledgeBase.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.FlowNodeConfigurationMemberKnowledgeBase
+		diags.Append(fwflex.Expand(ctx, knowledgeBaseFlowNodeConfigurationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.LambdaFunction.IsNull():
+		lambdaFunctionFlowNodeConfigurationData, d := m.LambdaFunction.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.FlowNodeConfigurationMemberLambdaFunction
+		diags.Append(fwflex.Expand(ctx, lambdaFunctionFlowNodeConfigurationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.Lex.IsNull():
+		lexFlowNodeConfigurationData, d := m.Lex.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.FlowNodeConfigurationMemberLex
+		diags.Append(fwflex.Expand(ctx, lexFlowNodeConfigurationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.Output.IsNull():
+		return &awstypes.FlowNodeConfigurationMemberOutput{}, diags
+
+	case !m.Prompt.IsNull():
+		promptFlowNodeConfigurationData, d := m.Prompt.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.FlowNodeConfigurationMemberPrompt
+		diags.Append(fwflex.Expand(ctx, promptFlowNodeConfigurationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.Retrieval.IsNull():
+		retrievalFlowNodeConfigurationData, d := m.Retrieval.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.FlowNodeConfigurationMemberRetrieval
+		diags.Append(fwflex.Expand(ctx, retrievalFlowNodeConfigurationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.Storage.IsNull():
+		storageFlowNodeConfigurationData, d := m.Storage.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.FlowNodeConfigurationMemberStorage
+		diags.Append(fwflex.Expand(ctx, storageFlowNodeConfigurationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *flowNodeConfigurationModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.FlowNodeConfigurationMemberAgent:
+		var model agentFlowNodeConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Agent = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+
+	case awstypes.FlowNodeConfigurationMemberCollector:
+		m.Collector = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &flowNodeEmptyConfigurationModel{})
+
+		return diags
+
+	case awstypes.FlowNodeConfigurationMemberCondition:
+		var model conditionFlowNodeConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Condition = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+
+	case awstypes.FlowNodeConfigurationMemberInput:
+		m.Input = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &flowNodeEmptyConfigurationModel{})
+
+		return diags
+
+	case awstypes.FlowNodeConfigurationMemberIterator:
+		m.Iterator = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &flowNodeEmptyConfigurationModel{})
+
+		return diags
+
+	case awstypes.FlowNodeConfigurationMemberKnowledgeBase:
+		var model knowledgeBaseFlowNodeConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.KnowledgeBase = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+
+	case awstypes.FlowNodeConfigurationMemberLambdaFunction:
+		var model lambdaFunctionFlowNodeConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.LambdaFunction = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+
+	case awstypes.FlowNodeConfigurationMemberLex:
+		var model lexFlowNodeConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Lex = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+
+	case awstypes.FlowNodeConfigurationMemberOutput:
+		m.Output = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &flowNodeEmptyConfigurationModel{})
+
+		return diags
+
+	case awstypes.FlowNodeConfigurationMemberPrompt:
+		var model promptFlowNodeConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Prompt = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+
+	case awstypes.FlowNodeConfigurationMemberRetrieval:
+		var model retrievalFlowNodeConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Retrieval = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+
+	case awstypes.FlowNodeConfigurationMemberStorage:
+		var model storageFlowNodeConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Storage = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+	}
+
+	return diags
+}
+
+// flowNodeEmptyConfigurationModel is the configuration of node types with no configurable settings.
+type flowNodeEmptyConfigurationModel struct{}
+
+type agentFlowNodeConfigurationModel struct {
+	AgentAliasARN fwtypes.ARN `tfsdk:"agent_alias_arn"`
+}
+
+type conditionFlowNodeConfigurationModel struct {
+	Condition fwtypes.ListNestedObjectValueOf[flowConditionModel] `tfsdk:"condition"`
+}
+
+type flowConditionModel struct {
+	Expression types.String `tfsdk:"expression"`
+	Name       types.String `tfsdk:"name"`
+}
+
+type knowledgeBaseFlowNodeConfigurationModel struct {
+	KnowledgeBaseID types.String `tfsdk:"knowledge_base_id"`
+	ModelID         types.String `tfsdk:"model_id"`
+}
+
+type lambdaFunctionFlowNodeConfigurationModel struct {
+	LambdaARN fwtypes.ARN `tfsdk:"lambda_arn"`
+}
+
+type lexFlowNodeConfigurationModel struct {
+	BotAliasARN fwtypes.ARN  `tfsdk:"bot_alias_arn"`
+	LocaleID    types.String `tfsdk:"locale_id"`
+}
+
+type promptFlowNodeConfigurationModel struct {
+	SourceConfiguration fwtypes.ListNestedObjectValueOf[promptFlowNodeSourceConfigurationModel] `tfsdk:"source_configuration"`
+}
+
+type promptFlowNodeSourceConfigurationModel struct {
+	Inline   fwtypes.ListNestedObjectValueOf[promptFlowNodeInlineConfigurationModel]   `tfsdk:"inline"`
+	Resource fwtypes.ListNestedObjectValueOf[promptFlowNodeResourceConfigurationModel] `tfsdk:"resource"`
+}
+
+var (
+	_ fwflex.Expander  = promptFlowNodeSourceConfigurationModel{}
+	_ fwflex.Flattener = &promptFlowNodeSourceConfigurationModel{}
+)
+
+func (m promptFlowNodeSourceConfigurationModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.Inline.IsNull():
+		promptFlowNodeInlineConfigurationData, d := m.Inline.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.PromptFlowNodeSourceConfigurationMemberInline
+		diags.Append(fwflex.Expand(ctx, promptFlowNodeInlineConfigurationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.Resource.IsNull():
+		promptFlowNodeResourceConfigurationData, d := m.Resource.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.PromptFlowNodeSourceConfigurationMemberResource
+		diags.Append(fwflex.Expand(ctx, promptFlowNodeResourceConfigurationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *promptFlowNodeSourceConfigurationModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.PromptFlowNodeSourceConfigurationMemberInline:
+		var model promptFlowNodeInlineConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Inline = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+
+	case awstypes.PromptFlowNodeSourceConfigurationMemberResource:
+		var model promptFlowNodeResourceConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Resource = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+	}
+
+	return diags
+}
+
+type promptFlowNodeInlineConfigurationModel struct {
+	AdditionalModelRequestFields fwtypes.SmithyJSON[document.Interface]                             `tfsdk:"additional_model_request_fields"`
+	InferenceConfiguration       fwtypes.ListNestedObjectValueOf[promptInferenceConfigurationModel] `tfsdk:"inference_configuration"`
+	ModelID                      types.String                                                       `tfsdk:"model_id"`
+	TemplateConfiguration        fwtypes.ListNestedObjectValueOf[promptTemplateConfigurationModel]  `tfsdk:"template_configuration"`
+	TemplateType                 fwtypes.StringEnum[awstypes.PromptTemplateType]                    `tfsdk:"template_type"`
+}
+
+type promptFlowNodeResourceConfigurationModel struct {
+	PromptARN fwtypes.ARN `tfsdk:"prompt_arn"`
+}
+
+type flowNodeS3ConfigurationModel struct {
+	BucketName types.String `tfsdk:"bucket_name"`
+}
+
+type retrievalFlowNodeConfigurationModel struct {
+	ServiceConfiguration fwtypes.ListNestedObjectValueOf[retrievalFlowNodeServiceConfigurationModel] `tfsdk:"service_configuration"`
+}
+
+type retrievalFlowNodeServiceConfigurationModel struct {
+	S3 fwtypes.ListNestedObjectValueOf[flowNodeS3ConfigurationModel] `tfsdk:"s3"`
+}
+
+var (
+	_ fwflex.Expander  = retrievalFlowNodeServiceConfigurationModel{}
+	_ fwflex.Flattener = &retrievalFlowNodeServiceConfigurationModel{}
+)
+
+func (m retrievalFlowNodeServiceConfigurationModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.S3.IsNull():
+		flowNodeS3ConfigurationData, d := m.S3.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.RetrievalFlowNodeServiceConfigurationMemberS3
+		diags.Append(fwflex.Expand(ctx, flowNodeS3ConfigurationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *retrievalFlowNodeServiceConfigurationModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.RetrievalFlowNodeServiceConfigurationMemberS3:
+		var model flowNodeS3ConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.S3 = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+	}
+
+	return diags
+}
+
+type storageFlowNodeConfigurationModel struct {
+	ServiceConfiguration fwtypes.ListNestedObjectValueOf[storageFlowNodeServiceConfigurationModel] `tfsdk:"service_configuration"`
+}
+
+type storageFlowNodeServiceConfigurationModel struct {
+	S3 fwtypes.ListNestedObjectValueOf[flowNodeS3ConfigurationModel] `tfsdk:"s3"`
+}
+
+var (
+	_ fwflex.Expander  = storageFlowNodeServiceConfigurationModel{}
+	_ fwflex.Flattener = &storageFlowNodeServiceConfigurationModel{}
+)
+
+func (m storageFlowNodeServiceConfigurationModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.S3.IsNull():
+		flowNodeS3ConfigurationData, d := m.S3.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.StorageFlowNodeServiceConfigurationMemberS3
+		diags.Append(fwflex.Expand(ctx, flowNodeS3ConfigurationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *storageFlowNodeServiceConfigurationModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.StorageFlowNodeServiceConfigurationMemberS3:
+		var model flowNodeS3ConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.S3 = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+	}
+
+	return diags
+}
@@ -0,0 +1,232 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrockagent
+
+import (
+	"context"
+	"fmt"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrockagent/types"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// validateFlowDefinition checks a flow's node and connection graph before it is sent to AWS.
+// Connections must refer to existing nodes, node inputs and outputs and condition names,
+// data connections must join an output and an input of the same data type,
+// and each node's configuration block must match its type.
+// Values that are not yet known are not validated.
+func validateFlowDefinition(ctx context.Context, definitionPath path.Path, definition *flowDefinitionModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	type flowNodePorts struct {
+		nodeType awstypes.FlowNodeType
+		// nil if not known.
+		inputs  map[string]awstypes.FlowNodeIODataType
+		outputs map[string]awstypes.FlowNodeIODataType
+		// nil if not known, or not a Condition node.
+		conditions map[string]struct{}
+	}
+
+	nodes := make(map[string]*flowNodePorts)
+	allNodesKnown := !definition.Node.IsUnknown()
+
+	nodeModels, d := definition.Node.ToSlice(ctx)
+	if d.HasError() {
+		return diags
+	}
+
+	for i, node := range nodeModels {
+		nodePath := definitionPath.AtName("node").AtListIndex(i)
+
+		if node.Name.IsUnknown() {
+			allNodesKnown = false
+			continue
+		}
+
+		name := node.Name.ValueString()
+
+		if _, ok := nodes[name]; ok {
+			diags.AddAttributeError(nodePath.AtName(names.AttrName), "Duplicate flow node name", fmt.Sprintf("Flow node name %q is not unique.", name))
+			continue
+		}
+
+		ports := &flowNodePorts{
+			nodeType: node.Type.ValueEnum(),
+		}
+		nodes[name] = ports
+
+		if !node.Configuration.IsUnknown() {
+			if configuration, d := node.Configuration.ToPtr(ctx); !d.HasError() && configuration != nil {
+				if configuredType, ok := configuration.nodeType(); ok && ports.nodeType != "" && configuredType != ports.nodeType {
+					diags.AddAttributeError(nodePath.AtName(names.AttrConfiguration), "Invalid flow node configuration", fmt.Sprintf("Flow node %q of type %s has a %s configuration.", name, ports.nodeType, configuredType))
+				}
+
+				ports.conditions = configuration.conditionNames(ctx)
+			}
+		}
+
+		ports.inputs = flowNodeIODataTypes(ctx, node.Input, func(v *flowNodeInputModel) (types.String, fwtypes.StringEnum[awstypes.FlowNodeIODataType]) {
+			return v.Name, v.Type
+		})
+		ports.outputs = flowNodeIODataTypes(ctx, node.Output, func(v *flowNodeOutputModel) (types.String, fwtypes.StringEnum[awstypes.FlowNodeIODataType]) {
+			return v.Name, v.Type
+		})
+	}
+
+	connectionModels, d := definition.Connection.ToSlice(ctx)
+	if d.HasError() {
+		return diags
+	}
+
+	connectionNames := make(map[string]struct{})
+
+	for i, connection := range connectionModels {
+		connectionPath := definitionPath.AtName("connection").AtListIndex(i)
+
+		if !connection.Name.IsUnknown() {
+			name := connection.Name.ValueString()
+
+			if _, ok := connectionNames[name]; ok {
+				diags.AddAttributeError(connectionPath.AtName(names.AttrName), "Duplicate flow connection name", fmt.Sprintf("Flow connection name %q is not unique.", name))
+			}
+
+			connectionNames[name] = struct{}{}
+		}
+
+		var source, target *flowNodePorts
+
+		for _, v := range []struct {
+			attribute string
+			value     types.String
+			ports     **flowNodePorts
+		}{
+			{names.AttrSource, connection.Source, &source},
+			{names.AttrTarget, connection.Target, &target},
+		} {
+			if v.value.IsUnknown() {
+				continue
+			}
+
+			name := v.value.ValueString()
+
+			if ports, ok := nodes[name]; ok {
+				*v.ports = ports
+			} else if allNodesKnown {
+				diags.AddAttributeError(connectionPath.AtName(v.attribute), "Dangling flow connection", fmt.Sprintf("Flow connection %s node %q is not defined.", v.attribute, name))
+			}
+		}
+
+		if connection.Configuration.IsUnknown() {
+			continue
+		}
+
+		configuration, d := connection.Configuration.ToPtr(ctx)
+		if d.HasError() || configuration == nil {
+			continue
+		}
+
+		connectionType := connection.Type.ValueEnum()
+
+		switch {
+		case !configuration.Data.IsNull() && !configuration.Data.IsUnknown():
+			if connectionType != "" && connectionType != awstypes.FlowConnectionTypeData {
+				diags.AddAttributeError(connectionPath.AtName(names.AttrConfiguration), "Invalid flow connection configuration", fmt.Sprintf("Flow connection of type %s has a data configuration.", connectionType))
+			}
+
+			data, d := configuration.Data.ToPtr(ctx)
+			if d.HasError() || data == nil {
+				continue
+			}
+
+			dataPath := connectionPath.AtName(names.AttrConfiguration).AtListIndex(0).AtName("data").AtListIndex(0)
+			var outputType, inputType awstypes.FlowNodeIODataType
+
+			if source != nil && source.outputs != nil && !data.SourceOutput.IsUnknown() {
+				name := data.SourceOutput.ValueString()
+
+				if v, ok := source.outputs[name]; ok {
+					outputType = v
+				} else {
+					diags.AddAttributeError(dataPath.AtName("source_output"), "Dangling flow connection", fmt.Sprintf("Flow connection source node %q has no output named %q.", connection.Source.ValueString(), name))
+				}
+			}
+
+			if target != nil && target.inputs != nil && !data.TargetInput.IsUnknown() {
+				name := data.TargetInput.ValueString()
+
+				if v, ok := target.inputs[name]; ok {
+					inputType = v
+				} else {
+					diags.AddAttributeError(dataPath.AtName("target_input"), "Dangling flow connection", fmt.Sprintf("Flow connection target node %q has no input named %q.", connection.Target.ValueString(), name))
+				}
+			}
+
+			if outputType != "" && inputType != "" && outputType != inputType {
+				diags.AddAttributeError(dataPath, "Mismatched flow connection data types", fmt.Sprintf("Flow connection joins output %q (%s) of node %q to input %q (%s) of node %q.",
+					data.SourceOutput.ValueString(), outputType, connection.Source.ValueString(),
+					data.TargetInput.ValueString(), inputType, connection.Target.ValueString()))
+			}
+
+		case !configuration.Conditional.IsNull() && !configuration.Conditional.IsUnknown():
+			if connectionType != "" && connectionType != awstypes.FlowConnectionTypeConditional {
+				diags.AddAttributeError(connectionPath.AtName(names.AttrConfiguration), "Invalid flow connection configuration", fmt.Sprintf("Flow connection of type %s has a conditional configuration.", connectionType))
+			}
+
+			if source == nil {
+				continue
+			}
+
+			if source.nodeType != "" && source.nodeType != awstypes.FlowNodeTypeCondition {
+				diags.AddAttributeError(connectionPath.AtName(names.AttrSource), "Invalid flow connection", fmt.Sprintf("Conditional flow connection source node %q is of type %s, not %s.", connection.Source.ValueString(), source.nodeType, awstypes.FlowNodeTypeCondition))
+				continue
+			}
+
+			conditional, d := configuration.Conditional.ToPtr(ctx)
+			if d.HasError() || conditional == nil || conditional.Condition.IsUnknown() || source.conditions == nil {
+				continue
+			}
+
+			if name := conditional.Condition.ValueString(); !hasKey(source.conditions, name) {
+				diags.AddAttributeError(connectionPath.AtName(names.AttrConfiguration).AtListIndex(0).AtName("conditional").AtListIndex(0).AtName(names.AttrCondition), "Dangling flow connection", fmt.Sprintf("Flow connection source node %q has no condition named %q.", connection.Source.ValueString(), name))
+			}
+		}
+	}
+
+	return diags
+}
+
+// flowNodeIODataTypes returns a map of node input or output name to data type, or nil if any name is unknown.
+func flowNodeIODataTypes[T any](ctx context.Context, v fwtypes.ListNestedObjectValueOf[T], f func(*T) (types.String, fwtypes.StringEnum[awstypes.FlowNodeIODataType])) map[string]awstypes.FlowNodeIODataType {
+	if v.IsUnknown() {
+		return nil
+	}
+
+	models, d := v.ToSlice(ctx)
+	if d.HasError() {
+		return nil
+	}
+
+	m := make(map[string]awstypes.FlowNodeIODataType, len(models))
+
+	for _, model := range models {
+		name, dataType := f(model)
+
+		if name.IsUnknown() {
+			return nil
+		}
+
+		m[name.ValueString()] = dataType.ValueEnum()
+	}
+
+	return m
+}
+
+func hasKey[K comparable, V any](m map[K]V, k K) bool {
+	_, ok := m[k]
+	return ok
+}
@@ -0,0 +1,238 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrockagent
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrockagent/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+func TestValidateFlowDefinition(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	inputNode := testFlowNode(ctx, "FlowInputNode", awstypes.FlowNodeTypeInput, nil, map[string]awstypes.FlowNodeIODataType{"document": awstypes.FlowNodeIODataTypeString})
+	promptNode := testFlowNode(ctx, "Prompt", awstypes.FlowNodeTypePrompt, map[string]awstypes.FlowNodeIODataType{"topic": awstypes.FlowNodeIODataTypeString}, map[string]awstypes.FlowNodeIODataType{"modelCompletion": awstypes.FlowNodeIODataTypeString})
+	outputNode := testFlowNode(ctx, "FlowOutputNode", awstypes.FlowNodeTypeOutput, map[string]awstypes.FlowNodeIODataType{"document": awstypes.FlowNodeIODataTypeString}, nil)
+	numberOutputNode := testFlowNode(ctx, "NumberOutputNode", awstypes.FlowNodeTypeOutput, map[string]awstypes.FlowNodeIODataType{"document": awstypes.FlowNodeIODataTypeNumber}, nil)
+	conditionNode := testFlowNode(ctx, "Condition", awstypes.FlowNodeTypeCondition, map[string]awstypes.FlowNodeIODataType{"value": awstypes.FlowNodeIODataTypeNumber}, nil)
+	mismatchedNode := testFlowNode(ctx, "Mismatched", awstypes.FlowNodeTypeOutput, map[string]awstypes.FlowNodeIODataType{"document": awstypes.FlowNodeIODataTypeString}, nil)
+	mismatchedNode.Type = fwtypes.StringEnumValue(awstypes.FlowNodeTypeLambdaFunction)
+	unknownNode := testFlowNode(ctx, "", awstypes.FlowNodeTypeOutput, nil, nil)
+	unknownNode.Name = types.StringUnknown()
+
+	testCases := map[string]struct {
+		nodes             []*flowNodeModel
+		connections       []*flowConnectionModel
+		expectedSummaries []string
+	}{
+		"valid": {
+			nodes: []*flowNodeModel{inputNode, promptNode, outputNode},
+			connections: []*flowConnectionModel{
+				testFlowDataConnection(ctx, "FlowInputNodeToPrompt", "FlowInputNode", "document", "Prompt", "topic"),
+				testFlowDataConnection(ctx, "PromptToFlowOutputNode", "Prompt", "modelCompletion", "FlowOutputNode", "document"),
+			},
+		},
+		"duplicate node name": {
+			nodes:             []*flowNodeModel{inputNode, inputNode},
+			expectedSummaries: []string{"Duplicate flow node name"},
+		},
+		"duplicate connection name": {
+			nodes: []*flowNodeModel{inputNode, promptNode, outputNode},
+			connections: []*flowConnectionModel{
+				testFlowDataConnection(ctx, "Connection", "FlowInputNode", "document", "Prompt", "topic"),
+				testFlowDataConnection(ctx, "Connection", "Prompt", "modelCompletion", "FlowOutputNode", "document"),
+			},
+			expectedSummaries: []string{"Duplicate flow connection name"},
+		},
+		"undefined source node": {
+			nodes: []*flowNodeModel{promptNode},
+			connections: []*flowConnectionModel{
+				testFlowDataConnection(ctx, "FlowInputNodeToPrompt", "FlowInputNode", "document", "Prompt", "topic"),
+			},
+			expectedSummaries: []string{"Dangling flow connection"},
+		},
+		"undefined source node with unknown node name": {
+			nodes: []*flowNodeModel{promptNode, unknownNode},
+			connections: []*flowConnectionModel{
+				testFlowDataConnection(ctx, "FlowInputNodeToPrompt", "FlowInputNode", "document", "Prompt", "topic"),
+			},
+		},
+		"undefined output and input": {
+			nodes: []*flowNodeModel{inputNode, promptNode},
+			connections: []*flowConnectionModel{
+				testFlowDataConnection(ctx, "FlowInputNodeToPrompt", "FlowInputNode", "data", "Prompt", "question"),
+			},
+			expectedSummaries: []string{"Dangling flow connection", "Dangling flow connection"},
+		},
+		"mismatched data types": {
+			nodes: []*flowNodeModel{inputNode, numberOutputNode},
+			connections: []*flowConnectionModel{
+				testFlowDataConnection(ctx, "FlowInputNodeToNumberOutputNode", "FlowInputNode", "document", "NumberOutputNode", "document"),
+			},
+			expectedSummaries: []string{"Mismatched flow connection data types"},
+		},
+		"mismatched node configuration": {
+			nodes:             []*flowNodeModel{mismatchedNode},
+			expectedSummaries: []string{"Invalid flow node configuration"},
+		},
+		"mismatched connection configuration": {
+			nodes: []*flowNodeModel{inputNode, promptNode},
+			connections: []*flowConnectionModel{
+				func() *flowConnectionModel {
+					v := testFlowDataConnection(ctx, "FlowInputNodeToPrompt", "FlowInputNode", "document", "Prompt", "topic")
+					v.Type = fwtypes.StringEnumValue(awstypes.FlowConnectionTypeConditional)
+					return v
+				}(),
+			},
+			expectedSummaries: []string{"Invalid flow connection configuration"},
+		},
+		"conditional connection from non-condition node": {
+			nodes: []*flowNodeModel{inputNode, outputNode},
+			connections: []*flowConnectionModel{
+				testFlowConditionalConnection(ctx, "FlowInputNodeToFlowOutputNode", "FlowInputNode", "default", "FlowOutputNode"),
+			},
+			expectedSummaries: []string{"Invalid flow connection"},
+		},
+		"conditional connection": {
+			nodes: []*flowNodeModel{conditionNode, outputNode},
+			connections: []*flowConnectionModel{
+				testFlowConditionalConnection(ctx, "ConditionToFlowOutputNode", "Condition", "default", "FlowOutputNode"),
+			},
+		},
+		"undefined condition": {
+			nodes: []*flowNodeModel{conditionNode, outputNode},
+			connections: []*flowConnectionModel{
+				testFlowConditionalConnection(ctx, "ConditionToFlowOutputNode", "Condition", "large", "FlowOutputNode"),
+			},
+			expectedSummaries: []string{"Dangling flow connection"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			definition := &flowDefinitionModel{
+				Connection: fwtypes.NewListNestedObjectValueOfSliceMust(ctx, testCase.connections),
+				Node:       fwtypes.NewListNestedObjectValueOfSliceMust(ctx, testCase.nodes),
+			}
+
+			diags := validateFlowDefinition(ctx, path.Root("definition").AtListIndex(0), definition)
+
+			var summaries []string
+			for _, d := range diags.Errors() {
+				summaries = append(summaries, d.Summary())
+			}
+
+			if got, want := summaries, testCase.expectedSummaries; !slices.Equal(got, want) {
+				t.Errorf("errors = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func testFlowNode(ctx context.Context, name string, nodeType awstypes.FlowNodeType, inputs, outputs map[string]awstypes.FlowNodeIODataType) *flowNodeModel {
+	configuration := &flowNodeConfigurationModel{
+		Agent:          fwtypes.NewListNestedObjectValueOfNull[agentFlowNodeConfigurationModel](ctx),
+		Collector:      fwtypes.NewListNestedObjectValueOfNull[flowNodeEmptyConfigurationModel](ctx),
+		Condition:      fwtypes.NewListNestedObjectValueOfNull[conditionFlowNodeConfigurationModel](ctx),
+		Input:          fwtypes.NewListNestedObjectValueOfNull[flowNodeEmptyConfigurationModel](ctx),
+		Iterator:       fwtypes.NewListNestedObjectValueOfNull[flowNodeEmptyConfigurationModel](ctx),
+		KnowledgeBase:  fwtypes.NewListNestedObjectValueOfNull[knowledgeBaseFlowNodeConfigurationModel](ctx),
+		LambdaFunction: fwtypes.NewListNestedObjectValueOfNull[lambdaFunctionFlowNodeConfigurationModel](ctx),
+		Lex:            fwtypes.NewListNestedObjectValueOfNull[lexFlowNodeConfigurationModel](ctx),
+		Output:         fwtypes.NewListNestedObjectValueOfNull[flowNodeEmptyConfigurationModel](ctx),
+		Prompt:         fwtypes.NewListNestedObjectValueOfNull[promptFlowNodeConfigurationModel](ctx),
+		Retrieval:      fwtypes.NewListNestedObjectValueOfNull[retrievalFlowNodeConfigurationModel](ctx),
+		Storage:        fwtypes.NewListNestedObjectValueOfNull[storageFlowNodeConfigurationModel](ctx),
+	}
+
+	switch nodeType {
+	case awstypes.FlowNodeTypeCondition:
+		configuration.Condition = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &conditionFlowNodeConfigurationModel{
+			Condition: fwtypes.NewListNestedObjectValueOfSliceMust(ctx, []*flowConditionModel{
+				{Expression: types.StringValue("value < 10"), Name: types.StringValue("small")},
+				{Expression: types.StringNull(), Name: types.StringValue("default")},
+			}),
+		})
+	case awstypes.FlowNodeTypeInput:
+		configuration.Input = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &flowNodeEmptyConfigurationModel{})
+	case awstypes.FlowNodeTypeOutput:
+		configuration.Output = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &flowNodeEmptyConfigurationModel{})
+	case awstypes.FlowNodeTypePrompt:
+		configuration.Prompt = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &promptFlowNodeConfigurationModel{
+			SourceConfiguration: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &promptFlowNodeSourceConfigurationModel{
+				Inline: fwtypes.NewListNestedObjectValueOfNull[promptFlowNodeInlineConfigurationModel](ctx),
+				Resource: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &promptFlowNodeResourceConfigurationModel{
+					PromptARN: fwtypes.ARNValue("arn:aws:bedrock:us-west-2:123456789012:prompt/PROMPT12345"), //lintignore:AWSAT003,AWSAT005
+				}),
+			}),
+		})
+	}
+
+	var inputModels []*flowNodeInputModel
+	for _, k := range slices.Sorted(maps.Keys(inputs)) {
+		inputModels = append(inputModels, &flowNodeInputModel{
+			Expression: types.StringValue("$.data"),
+			Name:       types.StringValue(k),
+			Type:       fwtypes.StringEnumValue(inputs[k]),
+		})
+	}
+
+	var outputModels []*flowNodeOutputModel
+	for _, k := range slices.Sorted(maps.Keys(outputs)) {
+		outputModels = append(outputModels, &flowNodeOutputModel{
+			Name: types.StringValue(k),
+			Type: fwtypes.StringEnumValue(outputs[k]),
+		})
+	}
+
+	return &flowNodeModel{
+		Configuration: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, configuration),
+		Input:         fwtypes.NewListNestedObjectValueOfSliceMust(ctx, inputModels),
+		Name:          types.StringValue(name),
+		Output:        fwtypes.NewListNestedObjectValueOfSliceMust(ctx, outputModels),
+		Type:          fwtypes.StringEnumValue(nodeType),
+	}
+}
+
+func testFlowDataConnection(ctx context.Context, name, source, sourceOutput, target, targetInput string) *flowConnectionModel {
+	return &flowConnectionModel{
+		Configuration: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &flowConnectionConfigurationModel{
+			Conditional: fwtypes.NewListNestedObjectValueOfNull[flowConditionalConnectionConfigurationModel](ctx),
+			Data: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &flowDataConnectionConfigurationModel{
+				SourceOutput: types.StringValue(sourceOutput),
+				TargetInput:  types.StringValue(targetInput),
+			}),
+		}),
+		Name:   types.StringValue(name),
+		Source: types.StringValue(source),
+		Target: types.StringValue(target),
+		Type:   fwtypes.StringEnumValue(awstypes.FlowConnectionTypeData),
+	}
+}
+
+func testFlowConditionalConnection(ctx context.Context, name, source, condition, target string) *flowConnectionModel {
+	return &flowConnectionModel{
+		Configuration: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &flowConnectionConfigurationModel{
+			Conditional: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &flowConditionalConnectionConfigurationModel{
+				Condition: types.StringValue(condition),
+			}),
+			Data: fwtypes.NewListNestedObjectValueOfNull[flowDataConnectionConfigurationModel](ctx),
+		}),
+		Name:   types.StringValue(name),
+		Source: types.StringValue(source),
+		Target: types.StringValue(target),
+		Type:   fwtypes.StringEnumValue(awstypes.FlowConnectionTypeConditional),
+	}
+}
@@ -0,0 +1,573 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrockagent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagent"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagent/document"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrockagent/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Prompt")
+// @Tags(identifierAttribute="arn")
+func newPromptResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &promptResource{}
+
+	return r, nil
+}
+
+type promptResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*promptResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_bedrockagent_prompt"
+}
+
+func (r *promptResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"customer_encryption_key_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			"default_variant": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`^([0-9a-zA-Z][_-]?){1,100}$`), "valid characters are a-z, A-Z, 0-9, _ (underscore) and - (hyphen). The name can have up to 100 characters"),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 200),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`^([0-9a-zA-Z][_-]?){1,100}$`), "valid characters are a-z, A-Z, 0-9, _ (underscore) and - (hyphen). The name can have up to 100 characters"),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"updated_at": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrVersion: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"variant": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[promptVariantModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(3),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"additional_model_request_fields": schema.StringAttribute{
+							CustomType: fwtypes.NewSmithyJSONType(ctx, document.NewLazyDocument),
+							Optional:   true,
+						},
+						"model_id": schema.StringAttribute{
+							Optional: true,
+						},
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(regexache.MustCompile(`^([0-9a-zA-Z][_-]?){1,100}$`), "valid characters are a-z, A-Z, 0-9, _ (underscore) and - (hyphen). The name can have up to 100 characters"),
+							},
+						},
+						"template_type": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.PromptTemplateType](),
+							Required:   true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"inference_configuration": promptInferenceConfigurationBlock(ctx),
+						"metadata": schema.SetNestedBlock{
+							CustomType: fwtypes.NewSetNestedObjectTypeOf[promptMetadataEntryModel](ctx),
+							Validators: []validator.Set{
+								setvalidator.SizeAtMost(50),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrKey: schema.StringAttribute{
+										Required: true,
+										Validators: []validator.String{
+											stringvalidator.LengthBetween(1, 128),
+										},
+									},
+									names.AttrValue: schema.StringAttribute{
+										Required: true,
+										Validators: []validator.String{
+											stringvalidator.LengthBetween(0, 1024),
+										},
+									},
+								},
+							},
+						},
+						"template_configuration": promptTemplateConfigurationBlock(ctx),
+					},
+				},
+			},
+		},
+	}
+}
+
+// promptInferenceConfigurationBlock returns the schema of a prompt's inference configuration.
+// It's shared by prompt variants and inline prompt flow nodes.
+func promptInferenceConfigurationBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[promptInferenceConfigurationModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"text": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[promptModelInferenceConfigurationModel](ctx),
+					Validators: []validator.List{
+						listvalidator.IsRequired(),
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"max_tokens": schema.Int64Attribute{
+								Optional: true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(0),
+								},
+							},
+							"stop_sequences": schema.ListAttribute{
+								CustomType:  fwtypes.ListOfStringType,
+								ElementType: types.StringType,
+								Optional:    true,
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(4),
+								},
+							},
+							"temperature": schema.Float64Attribute{
+								Optional: true,
+								Validators: []validator.Float64{
+									float64validator.Between(0, 1),
+								},
+							},
+							"top_p": schema.Float64Attribute{
+								Optional: true,
+								Validators: []validator.Float64{
+									float64validator.Between(0, 1),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// promptTemplateConfigurationBlock returns the schema of a prompt's template configuration.
+// It's shared by prompt variants and inline prompt flow nodes.
+func promptTemplateConfigurationBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[promptTemplateConfigurationModel](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"text": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[textPromptTemplateConfigurationModel](ctx),
+					Validators: []validator.List{
+						listvalidator.IsRequired(),
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"text": schema.StringAttribute{
+								Required: true,
+								Validators: []validator.String{
+									stringvalidator.LengthBetween(1, 200000),
+								},
+							},
+						},
+						Blocks: map[string]schema.Block{
+							"input_variable": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[promptInputVariableModel](ctx),
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(20),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										names.AttrName: schema.StringAttribute{
+											Required: true,
+											Validators: []validator.String{
+												stringvalidator.RegexMatches(regexache.MustCompile(`^([0-9a-zA-Z][_-]?){1,100}$`), "valid characters are a-z, A-Z, 0-9, _ (underscore) and - (hyphen). The name can have up to 100 characters"),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *promptResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data promptResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	input := &bedrockagent.CreatePromptInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input.ClientToken = aws.String(id.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreatePrompt(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating Bedrock Agent Prompt", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringToFramework(ctx, output.Arn)
+	data.CreatedAt = fwflex.TimeToFramework(ctx, output.CreatedAt)
+	data.ID = fwflex.StringToFramework(ctx, output.Id)
+	data.UpdatedAt = fwflex.TimeToFramework(ctx, output.UpdatedAt)
+	data.Version = fwflex.StringToFramework(ctx, output.Version)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *promptResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data promptResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	promptID := data.ID.ValueString()
+	output, err := findPromptByTwoPartKey(ctx, conn, promptID, "")
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Agent Prompt (%s)", promptID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *promptResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new promptResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	if !new.CustomerEncryptionKeyARN.Equal(old.CustomerEncryptionKeyARN) ||
+		!new.DefaultVariant.Equal(old.DefaultVariant) ||
+		!new.Description.Equal(old.Description) ||
+		!new.Name.Equal(old.Name) ||
+		!new.Variant.Equal(old.Variant) {
+		input := &bedrockagent.UpdatePromptInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input.PromptIdentifier = fwflex.StringFromFramework(ctx, new.ID)
+
+		output, err := conn.UpdatePrompt(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Bedrock Agent Prompt (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		new.UpdatedAt = fwflex.TimeToFramework(ctx, output.UpdatedAt)
+	} else {
+		new.UpdatedAt = old.UpdatedAt
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *promptResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data promptResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	promptID := data.ID.ValueString()
+	_, err := conn.DeletePrompt(ctx, &bedrockagent.DeletePromptInput{
+		PromptIdentifier: aws.String(promptID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Bedrock Agent Prompt (%s)", promptID), err.Error())
+
+		return
+	}
+}
+
+func (r *promptResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+// findPromptByTwoPartKey returns the specified version of a prompt.
+// The working draft is returned if version is empty.
+func findPromptByTwoPartKey(ctx context.Context, conn *bedrockagent.Client, promptID, version string) (*bedrockagent.GetPromptOutput, error) {
+	input := &bedrockagent.GetPromptInput{
+		PromptIdentifier: aws.String(promptID),
+	}
+	if version != "" {
+		input.PromptVersion = aws.String(version)
+	}
+
+	output, err := conn.GetPrompt(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type promptResourceModel struct {
+	ARN                      types.String                                        `tfsdk:"arn"`
+	CreatedAt                timetypes.RFC3339                                   `tfsdk:"created_at"`
+	CustomerEncryptionKeyARN fwtypes.ARN                                         `tfsdk:"customer_encryption_key_arn"`
+	DefaultVariant           types.String                                        `tfsdk:"default_variant"`
+	Description              types.String                                        `tfsdk:"description"`
+	ID                       types.String                                        `tfsdk:"id"`
+	Name                     types.String                                        `tfsdk:"name"`
+	Tags                     tftags.Map                                          `tfsdk:"tags"`
+	TagsAll                  tftags.Map                                          `tfsdk:"tags_all"`
+	UpdatedAt                timetypes.RFC3339                                   `tfsdk:"updated_at"`
+	Variant                  fwtypes.ListNestedObjectValueOf[promptVariantModel] `tfsdk:"variant"`
+	Version                  types.String                                        `tfsdk:"version"`
+}
+
+type promptVariantModel struct {
+	AdditionalModelRequestFields fwtypes.SmithyJSON[document.Interface]                             `tfsdk:"additional_model_request_fields"`
+	InferenceConfiguration       fwtypes.ListNestedObjectValueOf[promptInferenceConfigurationModel] `tfsdk:"inference_configuration"`
+	Metadata                     fwtypes.SetNestedObjectValueOf[promptMetadataEntryModel]           `tfsdk:"metadata"`
+	ModelID                      types.String                                                       `tfsdk:"model_id"`
+	Name                         types.String                                                       `tfsdk:"name"`
+	TemplateConfiguration        fwtypes.ListNestedObjectValueOf[promptTemplateConfigurationModel]  `tfsdk:"template_configuration"`
+	TemplateType                 fwtypes.StringEnum[awstypes.PromptTemplateType]                    `tfsdk:"template_type"`
+}
+
+type promptMetadataEntryModel struct {
+	Key   types.String `tfsdk:"key"`
+	Value types.String `tfsdk:"value"`
+}
+
+type promptInferenceConfigurationModel struct {
+	Text fwtypes.ListNestedObjectValueOf[promptModelInferenceConfigurationModel] `tfsdk:"text"`
+}
+
+var (
+	_ fwflex.Expander  = promptInferenceConfigurationModel{}
+	_ fwflex.Flattener = &promptInferenceConfigurationModel{}
+)
+
+func (m promptInferenceConfigurationModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.Text.IsNull():
+		promptModelInferenceConfigurationData, d := m.Text.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.PromptInferenceConfigurationMemberText
+		diags.Append(fwflex.Expand(ctx, promptModelInferenceConfigurationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *promptInferenceConfigurationModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.PromptInferenceConfigurationMemberText:
+		var model promptModelInferenceConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Text = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+	}
+
+	return diags
+}
+
+type promptModelInferenceConfigurationModel struct {
+	MaxTokens     types.Int64                       `tfsdk:"max_tokens"`
+	StopSequences fwtypes.ListValueOf[types.String] `tfsdk:"stop_sequences"`
+	Temperature   types.Float64                     `tfsdk:"temperature"`
+	TopP          types.Float64                     `tfsdk:"top_p"`
+}
+
+type promptTemplateConfigurationModel struct {
+	Text fwtypes.ListNestedObjectValueOf[textPromptTemplateConfigurationModel] `tfsdk:"text"`
+}
+
+var (
+	_ fwflex.Expander  = promptTemplateConfigurationModel{}
+	_ fwflex.Flattener = &promptTemplateConfigurationModel{}
+)
+
+func (m promptTemplateConfigurationModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.Text.IsNull():
+		textPromptTemplateConfigurationData, d := m.Text.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.PromptTemplateConfigurationMemberText
+		diags.Append(fwflex.Expand(ctx, textPromptTemplateConfigurationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *promptTemplateConfigurationModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.PromptTemplateConfigurationMemberText:
+		var model textPromptTemplateConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Text = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+	}
+
+	return diags
+}
+
+type textPromptTemplateConfigurationModel struct {
+	InputVariable fwtypes.ListNestedObjectValueOf[promptInputVariableModel] `tfsdk:"input_variable"`
+	Text          types.String                                              `tfsdk:"text"`
+}
+
+type promptInputVariableModel struct {
+	Name types.String `tfsdk:"name"`
+}
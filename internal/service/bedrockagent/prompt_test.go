@@ -0,0 +1,337 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrockagent_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagent"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfbedrockagent "github.com/hashicorp/terraform-provider-aws/internal/service/bedrockagent"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccBedrockAgentPrompt_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrockagent_prompt.test"
+	var v bedrockagent.GetPromptOutput
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockAgentServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPromptDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPromptConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPromptExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreatedAt),
+					resource.TestCheckResourceAttr(resourceName, "default_variant", "variantOne"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+					resource.TestCheckResourceAttr(resourceName, "variant.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "variant.0.name", "variantOne"),
+					resource.TestCheckResourceAttr(resourceName, "variant.0.template_type", "TEXT"),
+					resource.TestCheckResourceAttr(resourceName, "variant.0.inference_configuration.0.text.0.max_tokens", "2048"),
+					resource.TestCheckResourceAttr(resourceName, "variant.0.template_configuration.0.text.0.input_variable.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "variant.0.template_configuration.0.text.0.input_variable.0.name", "topic"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, "DRAFT"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccBedrockAgentPrompt_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrockagent_prompt.test"
+	var v bedrockagent.GetPromptOutput
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockAgentServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPromptDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPromptConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPromptExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfbedrockagent.ResourcePrompt, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccBedrockAgentPrompt_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrockagent_prompt.test"
+	var v bedrockagent.GetPromptOutput
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockAgentServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPromptDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPromptConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPromptExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPromptConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPromptExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "2"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccPromptConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPromptExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBedrockAgentPrompt_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrockagent_prompt.test"
+	var v bedrockagent.GetPromptOutput
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockAgentServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPromptDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPromptConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPromptExists(ctx, resourceName, &v),
+					resource.TestCheckNoResourceAttr(resourceName, names.AttrDescription),
+					resource.TestCheckResourceAttr(resourceName, "variant.#", "1"),
+				),
+			},
+			{
+				Config: testAccPromptConfig_updated(rName, "updated description"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPromptExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "default_variant", "variantTwo"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "updated description"),
+					resource.TestCheckResourceAttr(resourceName, "variant.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "variant.1.name", "variantTwo"),
+					resource.TestCheckResourceAttr(resourceName, "variant.1.inference_configuration.0.text.0.stop_sequences.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPromptDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BedrockAgentClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_bedrockagent_prompt" {
+				continue
+			}
+
+			_, err := tfbedrockagent.FindPromptByTwoPartKey(ctx, conn, rs.Primary.ID, "")
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Bedrock Agent Prompt %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPromptExists(ctx context.Context, n string, v *bedrockagent.GetPromptOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BedrockAgentClient(ctx)
+
+		output, err := tfbedrockagent.FindPromptByTwoPartKey(ctx, conn, rs.Primary.ID, "")
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPromptConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_bedrockagent_prompt" "test" {
+  name            = %[1]q
+  default_variant = "variantOne"
+
+  variant {
+    name          = "variantOne"
+    model_id      = "anthropic.claude-3-haiku-20240307-v1:0"
+    template_type = "TEXT"
+
+    inference_configuration {
+      text {
+        max_tokens  = 2048
+        temperature = 0.5
+        top_p       = 0.9
+      }
+    }
+
+    template_configuration {
+      text {
+        text = "Write a short poem about {{topic}}."
+
+        input_variable {
+          name = "topic"
+        }
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccPromptConfig_updated(rName, description string) string {
+	return fmt.Sprintf(`
+resource "aws_bedrockagent_prompt" "test" {
+  name            = %[1]q
+  description     = %[2]q
+  default_variant = "variantTwo"
+
+  variant {
+    name          = "variantOne"
+    model_id      = "anthropic.claude-3-haiku-20240307-v1:0"
+    template_type = "TEXT"
+
+    inference_configuration {
+      text {
+        max_tokens  = 2048
+        temperature = 0.5
+        top_p       = 0.9
+      }
+    }
+
+    template_configuration {
+      text {
+        text = "Write a short poem about {{topic}}."
+
+        input_variable {
+          name = "topic"
+        }
+      }
+    }
+  }
+
+  variant {
+    name          = "variantTwo"
+    model_id      = "anthropic.claude-3-haiku-20240307-v1:0"
+    template_type = "TEXT"
+
+    inference_configuration {
+      text {
+        max_tokens     = 1024
+        stop_sequences = ["Human:"]
+        temperature    = 0.2
+      }
+    }
+
+    metadata {
+      key   = "author"
+      value = "terraform"
+    }
+
+    template_configuration {
+      text {
+        text = "Write a haiku about {{topic}}."
+
+        input_variable {
+          name = "topic"
+        }
+      }
+    }
+  }
+}
+`, rName, description)
+}
+
+func testAccPromptConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_bedrockagent_prompt" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccPromptConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_bedrockagent_prompt" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -51,6 +51,24 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory: newDataSourceResource,
 			Name:    "Data Source",
 		},
+		{
+			Factory: newFlowAliasResource,
+			Name:    "Flow Alias",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory: newFlowResource,
+			Name:    "Flow",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory: newFlowVersionResource,
+			Name:    "Flow Version",
+		},
 		{
 			Factory: newKnowledgeBaseResource,
 			Name:    "Knowledge Base",
@@ -58,6 +76,20 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory: newPromptResource,
+			Name:    "Prompt",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory: newPromptVersionResource,
+			Name:    "Prompt Version",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 	}
 }
 
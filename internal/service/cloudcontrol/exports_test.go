@@ -3,9 +3,27 @@
 
 package cloudcontrol
 
+import (
+	"io/fs"
+	"testing"
+)
+
 // Exports for use in tests only.
 var (
 	ResourceResource = resourceResource
 
-	FindResource = findResource
+	FindResourceSchemaByTypeName = findResourceSchemaByTypeName
+	FindSchemaSnapshotByTypeName = findSchemaSnapshotByTypeName
+	SchemaSnapshotFileName       = schemaSnapshotFileName
 )
+
+// SetSchemaSnapshotFS replaces the bundled resource schema snapshot for the duration of the test.
+func SetSchemaSnapshotFS(t *testing.T, fsys fs.FS) {
+	t.Helper()
+
+	v := schemaSnapshotFS
+	schemaSnapshotFS = fsys
+	t.Cleanup(func() {
+		schemaSnapshotFS = v
+	})
+}
@@ -25,7 +25,6 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
-	tfcloudformation "github.com/hashicorp/terraform-provider-aws/internal/service/cloudformation"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 	"github.com/mattbaird/jsonpatch"
@@ -242,8 +241,6 @@ func resourceResourceDelete(ctx context.Context, d *schema.ResourceData, meta in
 }
 
 func resourceResourceCustomizeDiffGetSchema(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudFormationClient(ctx)
-
 	resourceSchema := diff.Get(names.AttrSchema).(string)
 
 	if resourceSchema != "" {
@@ -252,13 +249,13 @@ func resourceResourceCustomizeDiffGetSchema(ctx context.Context, diff *schema.Re
 
 	typeName := diff.Get("type_name").(string)
 
-	output, err := tfcloudformation.FindTypeByName(ctx, conn, typeName)
+	resourceSchema, err := findResourceSchemaByTypeName(ctx, meta.(*conns.AWSClient), typeName)
 
 	if err != nil {
 		return fmt.Errorf("reading CloudFormation Type (%s): %w", typeName, err)
 	}
 
-	if err := diff.SetNew(names.AttrSchema, output.Schema); err != nil {
+	if err := diff.SetNew(names.AttrSchema, resourceSchema); err != nil {
 		return fmt.Errorf("setting schema New: %w", err)
 	}
 
@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudcontrol
+
+import (
+	"context"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcloudformation "github.com/hashicorp/terraform-provider-aws/internal/service/cloudformation"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// The bundled snapshot of CloudFormation resource schemas.
+// The snapshot is updated by running `make cloudcontrol-schemas`, which requires AWS credentials.
+//
+//go:embed schemas
+var schemaSnapshot embed.FS
+
+// schemaSnapshotFS is the file system from which the bundled snapshot is read. It's replaced in tests.
+var schemaSnapshotFS fs.FS = schemaSnapshot
+
+const (
+	schemaSnapshotDir         = "schemas"
+	schemaSnapshotVersionFile = "VERSION"
+)
+
+// resourceSchemas caches CloudFormation resource schemas so that DescribeType is called once per
+// account, Region and resource type, rather than once per resource instance.
+var resourceSchemas sync.Map
+
+// findResourceSchemaByTypeName returns the CloudFormation resource schema for the specified resource type.
+// The schema is read using DescribeType. If DescribeType is unreachable or throttled, the bundled snapshot is used.
+func findResourceSchemaByTypeName(ctx context.Context, meta *conns.AWSClient, typeName string) (string, error) {
+	key := strings.Join([]string{meta.AccountID, meta.Region, typeName}, "/")
+
+	if v, ok := resourceSchemas.Load(key); ok {
+		return v.(string), nil
+	}
+
+	output, err := tfcloudformation.FindTypeByName(ctx, meta.CloudFormationClient(ctx), typeName)
+
+	if tfresource.NotFound(err) {
+		return "", err
+	}
+
+	if err != nil {
+		resourceSchema, snapshotErr := findSchemaSnapshotByTypeName(typeName)
+
+		if snapshotErr != nil {
+			return "", err
+		}
+
+		tflog.Warn(ctx, "reading CloudFormation Type failed, using bundled resource schema snapshot", map[string]any{
+			"error":            err.Error(),
+			"snapshot_version": schemaSnapshotVersion(),
+			"type_name":        typeName,
+		})
+
+		return resourceSchema, nil
+	}
+
+	resourceSchema := aws.ToString(output.Schema)
+	resourceSchemas.Store(key, resourceSchema)
+
+	return resourceSchema, nil
+}
+
+// findSchemaSnapshotByTypeName returns the bundled snapshot of the CloudFormation resource schema for the specified resource type.
+func findSchemaSnapshotByTypeName(typeName string) (string, error) {
+	b, err := fs.ReadFile(schemaSnapshotFS, path.Join(schemaSnapshotDir, schemaSnapshotFileName(typeName)))
+
+	if errors.Is(err, fs.ErrNotExist) {
+		return "", &retry.NotFoundError{
+			LastError: err,
+			Message:   fmt.Sprintf("no bundled resource schema for %s", typeName),
+		}
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// schemaSnapshotVersion returns the version of the bundled resource schema snapshot.
+// The empty string is returned if the snapshot has not been generated.
+func schemaSnapshotVersion() string {
+	b, err := fs.ReadFile(schemaSnapshotFS, path.Join(schemaSnapshotDir, schemaSnapshotVersionFile))
+
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimSpace(string(b))
+}
+
+// schemaSnapshotFileName returns the name of the file containing a resource type's bundled schema,
+// e.g. "AWS_Logs_LogGroup.json" for "AWS::Logs::LogGroup".
+func schemaSnapshotFileName(typeName string) string {
+	return strings.ReplaceAll(typeName, "::", "_") + ".json"
+}
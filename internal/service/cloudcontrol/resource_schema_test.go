@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudcontrol_test
+
+import (
+	"context"
+	"testing"
+	"testing/fstest"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns/connstest"
+	tfcloudcontrol "github.com/hashicorp/terraform-provider-aws/internal/service/cloudcontrol"
+	tfcloudformation "github.com/hashicorp/terraform-provider-aws/internal/service/cloudformation"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestSchemaSnapshotFileName(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		"AWS::Logs::LogGroup":         "AWS_Logs_LogGroup.json",
+		"AWS::ApiGateway::ApiKey":     "AWS_ApiGateway_ApiKey.json",
+		"Example::Private::Extension": "Example_Private_Extension.json",
+	}
+
+	for typeName, want := range testCases {
+		t.Run(typeName, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tfcloudcontrol.SchemaSnapshotFileName(typeName); got != want {
+				t.Errorf("SchemaSnapshotFileName(%q) = %q, want %q", typeName, got, want)
+			}
+		})
+	}
+}
+
+func TestFindSchemaSnapshotByTypeName_notFound(t *testing.T) {
+	t.Parallel()
+
+	_, err := tfcloudcontrol.FindSchemaSnapshotByTypeName("Example::Private::Extension")
+
+	if !tfresource.NotFound(err) {
+		t.Errorf("FindSchemaSnapshotByTypeName: got error %v, want NotFound", err)
+	}
+}
+
+const testResourceSchema = `{"typeName":"AWS::Logs::LogGroup","properties":{"LogGroupName":{"type":"string"}}}`
+
+func testSchemaSnapshotFS() fstest.MapFS {
+	return fstest.MapFS{
+		"schemas/AWS_Logs_LogGroup.json": &fstest.MapFile{Data: []byte(testResourceSchema)},
+		"schemas/VERSION":                &fstest.MapFile{Data: []byte("2026-10-01\n")},
+	}
+}
+
+func TestFindSchemaSnapshotByTypeName(t *testing.T) {
+	tfcloudcontrol.SetSchemaSnapshotFS(t, testSchemaSnapshotFS())
+
+	got, err := tfcloudcontrol.FindSchemaSnapshotByTypeName("AWS::Logs::LogGroup")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if want := testResourceSchema; got != want {
+		t.Errorf("FindSchemaSnapshotByTypeName = %q, want %q", got, want)
+	}
+}
+
+func TestFindResourceSchemaByTypeName_snapshotFallback(t *testing.T) {
+	tfcloudcontrol.SetSchemaSnapshotFS(t, testSchemaSnapshotFS())
+
+	ctx := context.Background()
+	fake := connstest.NewFake()
+	connstest.Handle(fake, cloudformation.ServiceID, "DescribeType", func(context.Context, *cloudformation.DescribeTypeInput) (*cloudformation.DescribeTypeOutput, error) {
+		return nil, connstest.APIError("ThrottlingException", "Rate exceeded")
+	})
+	meta := fake.AWSClient(ctx, tfcloudformation.ServicePackage(ctx))
+
+	got, err := tfcloudcontrol.FindResourceSchemaByTypeName(ctx, meta, "AWS::Logs::LogGroup")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if want := testResourceSchema; got != want {
+		t.Errorf("FindResourceSchemaByTypeName = %q, want %q", got, want)
+	}
+
+	if got, want := fake.CallCount(cloudformation.ServiceID, "DescribeType"), 1; got < want {
+		t.Errorf("DescribeType calls = %d, want at least %d", got, want)
+	}
+
+	// A resource type that isn't bundled returns the DescribeType error.
+	if _, err := tfcloudcontrol.FindResourceSchemaByTypeName(ctx, meta, "AWS::ECS::Cluster"); err == nil {
+		t.Error("FindResourceSchemaByTypeName(AWS::ECS::Cluster): expected error")
+	}
+}
+
+func TestFindResourceSchemaByTypeName_notFound(t *testing.T) {
+	tfcloudcontrol.SetSchemaSnapshotFS(t, testSchemaSnapshotFS())
+
+	ctx := context.Background()
+	fake := connstest.NewFake()
+	connstest.Handle(fake, cloudformation.ServiceID, "DescribeType", func(_ context.Context, input *cloudformation.DescribeTypeInput) (*cloudformation.DescribeTypeOutput, error) {
+		return nil, &awstypes.TypeNotFoundException{Message: aws.String("Type not found: " + aws.ToString(input.TypeName))}
+	})
+	meta := fake.AWSClient(ctx, tfcloudformation.ServicePackage(ctx))
+
+	// A resource type that CloudFormation reports as not found isn't read from the snapshot.
+	_, err := tfcloudcontrol.FindResourceSchemaByTypeName(ctx, meta, "AWS::Logs::LogGroup")
+
+	if !tfresource.NotFound(err) {
+		t.Errorf("FindResourceSchemaByTypeName: got error %v, want NotFound", err)
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudcontrol
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_cloudcontrolapi_resources", name="Resources")
+func newResourcesDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	d := &resourcesDataSource{}
+
+	return d, nil
+}
+
+type resourcesDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*resourcesDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_cloudcontrolapi_resources"
+}
+
+func (d *resourcesDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"identifiers": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"resource_model": schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrResources: schema.DynamicAttribute{
+				Computed: true,
+			},
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			"type_name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`[0-9A-Za-z]{2,64}::[0-9A-Za-z]{2,64}::[0-9A-Za-z]{2,64}`), "must be three alphanumeric sections separated by double colons (::)"),
+				},
+			},
+			"type_version_id": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (d *resourcesDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data resourcesDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().CloudControlClient(ctx)
+
+	typeName := data.TypeName.ValueString()
+	input := &cloudcontrol.ListResourcesInput{
+		ResourceModel: fwflex.StringFromFramework(ctx, data.ResourceModel),
+		RoleArn:       fwflex.StringFromFramework(ctx, data.RoleARN),
+		TypeName:      aws.String(typeName),
+		TypeVersionId: fwflex.StringFromFramework(ctx, data.TypeVersionID),
+	}
+
+	var identifiers []string
+	resources := make([]resourcesDataSourceResource, 0)
+	pages := cloudcontrol.NewListResourcesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("listing Cloud Control API (%s) Resources", typeName), err.Error())
+
+			return
+		}
+
+		for _, v := range page.ResourceDescriptions {
+			identifier := aws.ToString(v.Identifier)
+			properties := json.RawMessage("null")
+			if v := aws.ToString(v.Properties); v != "" {
+				properties = json.RawMessage(v)
+			}
+
+			identifiers = append(identifiers, identifier)
+			resources = append(resources, resourcesDataSourceResource{
+				Identifier: identifier,
+				Properties: properties,
+			})
+		}
+	}
+
+	value, err := resourcesValue(ctx, resources)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("decoding Cloud Control API (%s) Resource properties", typeName), err.Error())
+
+		return
+	}
+
+	data.ID = types.StringValue(typeName)
+	data.Identifiers = fwflex.FlattenFrameworkStringValueListOfString(ctx, identifiers)
+	data.Resources = types.DynamicValue(value)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// resourcesValue returns the framework value of the specified resources, with each resource's properties decoded.
+func resourcesValue(ctx context.Context, resources []resourcesDataSourceResource) (attr.Value, error) {
+	b, err := json.Marshal(resources)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return fwflex.ValueFromJSON(ctx, string(b))
+}
+
+type resourcesDataSourceModel struct {
+	ID            types.String                      `tfsdk:"id"`
+	Identifiers   fwtypes.ListValueOf[types.String] `tfsdk:"identifiers"`
+	ResourceModel types.String                      `tfsdk:"resource_model"`
+	Resources     types.Dynamic                     `tfsdk:"resources"`
+	RoleARN       fwtypes.ARN                       `tfsdk:"role_arn"`
+	TypeName      types.String                      `tfsdk:"type_name"`
+	TypeVersionID types.String                      `tfsdk:"type_version_id"`
+}
+
+type resourcesDataSourceResource struct {
+	Identifier string          `json:"identifier"`
+	Properties json.RawMessage `json:"properties"`
+}
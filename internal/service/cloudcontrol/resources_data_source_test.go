@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudcontrol_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudControlResourcesDataSource_resourceModel(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudcontrolapi_resources.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcesDataSourceConfig_resourceModel(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrID, "AWS::Logs::LogStream"),
+					resource.TestCheckResourceAttr(dataSourceName, "identifiers.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "identifiers.0", rName+"|"+rName),
+					resource.TestCheckOutput("log_stream_name", rName),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourcesDataSourceConfig_resourceModel(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_cloudwatch_log_stream" "test" {
+  name           = %[1]q
+  log_group_name = aws_cloudwatch_log_group.test.name
+}
+
+data "aws_cloudcontrolapi_resources" "test" {
+  type_name = "AWS::Logs::LogStream"
+
+  resource_model = jsonencode({
+    LogGroupName = aws_cloudwatch_log_stream.test.log_group_name
+  })
+}
+
+output "log_stream_name" {
+  value = one([for r in data.aws_cloudcontrolapi_resources.test.resources : r.properties.LogStreamName])
+}
+`, rName)
+}
@@ -21,12 +21,12 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_cloudformation_stack_set_instance", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_cloudformation_stack_set_instance", &resource.Sweeper{
 		Name: "aws_cloudformation_stack_set_instance",
 		F:    sweepStackSetInstances,
 	})
 
-	resource.AddTestSweepers("aws_cloudformation_stack_set", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_cloudformation_stack_set", &resource.Sweeper{
 		Name: "aws_cloudformation_stack_set",
 		Dependencies: []string{
 			"aws_cloudformation_stack_set_instance",
@@ -34,7 +34,7 @@ func RegisterSweepers() {
 		F: sweepStackSets,
 	})
 
-	resource.AddTestSweepers("aws_cloudformation_stack", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_cloudformation_stack", &resource.Sweeper{
 		Name: "aws_cloudformation_stack",
 		Dependencies: []string{
 			"aws_cloudformation_stack_set_instance",
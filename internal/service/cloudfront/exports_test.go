@@ -37,4 +37,7 @@ var (
 	FindRealtimeLogConfigByARN                 = findRealtimeLogConfigByARN
 	FindResponseHeadersPolicyByID              = findResponseHeadersPolicyByID
 	WaitDistributionDeployed                   = waitDistributionDeployed
+
+	BuildFunctionTestEventObject = buildFunctionTestEventObject
+	FunctionOutputMismatches     = functionOutputMismatches
 )
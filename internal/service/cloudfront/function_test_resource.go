@@ -0,0 +1,552 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	functionEventTypeViewerRequest  = "viewer-request"
+	functionEventTypeViewerResponse = "viewer-response"
+
+	// Documentation address used as the viewer IP when a fixture doesn't specify one.
+	defaultFunctionTestViewerIP = "198.51.100.1"
+)
+
+// @FrameworkResource("aws_cloudfront_function_test", name="Function Test")
+func newFunctionTestResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &functionTestResource{}
+
+	r.SetDefaultCreateTimeout(5 * time.Minute)
+
+	return r, nil
+}
+
+type functionTestResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpRead
+	framework.WithNoUpdate
+	framework.WithNoOpDelete
+	framework.WithTimeouts
+}
+
+func (*functionTestResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_cloudfront_function_test"
+}
+
+func (r *functionTestResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"etag": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"live_stage_etag": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"publish": schema.BoolAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"event": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[functionTestEventModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"compute_utilization": schema.Int64Attribute{
+							Computed: true,
+						},
+						"event_type": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(functionEventTypeViewerRequest, functionEventTypeViewerResponse),
+							},
+						},
+						"execution_logs": schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"expected_output": schema.StringAttribute{
+							CustomType: jsontypes.NormalizedType{},
+							Optional:   true,
+						},
+						"function_output": schema.StringAttribute{
+							Computed: true,
+						},
+						"max_compute_utilization": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 100),
+							},
+						},
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+						},
+						"request": schema.StringAttribute{
+							CustomType: jsontypes.NormalizedType{},
+							Optional:   true,
+						},
+						"response": schema.StringAttribute{
+							CustomType: jsontypes.NormalizedType{},
+							Optional:   true,
+						},
+						"viewer_ip": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *functionTestResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var data functionTestResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	events, diags := data.Events.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	for i, event := range events {
+		if event.EventType.ValueString() == functionEventTypeViewerRequest && !event.Response.IsNull() {
+			response.Diagnostics.AddAttributeError(
+				path.Root("event").AtListIndex(i).AtName("response"),
+				"Invalid Attribute Combination",
+				fmt.Sprintf("response can only be set for %s events", functionEventTypeViewerResponse),
+			)
+		}
+	}
+}
+
+// ModifyPlan runs the tests while planning when the function's DEVELOPMENT stage ETag is already known,
+// so that failures are reported before anything is applied.
+func (r *functionTestResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan functionTestResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.ETag.IsUnknown() || plan.Name.IsUnknown() || plan.Events.IsUnknown() {
+		return
+	}
+
+	if !request.State.Raw.IsNull() {
+		var state functionTestResourceModel
+		response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Nothing to re-test.
+		if plan.ETag.Equal(state.ETag) && plan.Name.Equal(state.Name) && plan.Events.Equal(state.Events) {
+			return
+		}
+	}
+
+	events, diags := plan.Events.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	for _, event := range events {
+		if event.EventType.IsUnknown() || event.Request.IsUnknown() || event.Response.IsUnknown() || event.ViewerIP.IsUnknown() || event.ExpectedOutput.IsUnknown() || event.MaxComputeUtilization.IsUnknown() {
+			return
+		}
+	}
+
+	conn := r.Meta().CloudFrontClient(ctx)
+
+	response.Diagnostics.Append(runFunctionTests(ctx, conn, plan.Name.ValueString(), plan.ETag.ValueString(), events)...)
+}
+
+func (r *functionTestResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data functionTestResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CloudFrontClient(ctx)
+
+	name, etag := data.Name.ValueString(), data.ETag.ValueString()
+	events, diags := data.Events.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, r.CreateTimeout(ctx, data.Timeouts))
+	defer cancel()
+
+	response.Diagnostics.Append(runFunctionTests(ctx, conn, name, etag, events)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.Events, diags = fwtypes.NewListNestedObjectValueOfSlice(ctx, events)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the tested code is published: the ETag pins the DEVELOPMENT stage version.
+	if data.Publish.ValueBool() {
+		input := &cloudfront.PublishFunctionInput{
+			IfMatch: aws.String(etag),
+			Name:    aws.String(name),
+		}
+
+		_, err := conn.PublishFunction(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("publishing CloudFront Function (%s)", name), err.Error())
+
+			return
+		}
+	}
+
+	output, err := findFunctionByTwoPartKey(ctx, conn, name, awstypes.FunctionStageLive)
+
+	switch {
+	case tfresource.NotFound(err):
+		data.LiveStageETag = types.StringValue("")
+	case err != nil:
+		response.Diagnostics.AddError(fmt.Sprintf("reading CloudFront Function (%s) LIVE stage", name), err.Error())
+
+		return
+	default:
+		data.LiveStageETag = fwflex.StringToFramework(ctx, output.ETag)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+// runFunctionTests runs each event fixture against the function's DEVELOPMENT stage, records the results
+// in the event models and returns an error for each event whose result doesn't satisfy its assertions.
+func runFunctionTests(ctx context.Context, conn *cloudfront.Client, name, etag string, events []*functionTestEventModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for i, event := range events {
+		eventName := event.Name.ValueString()
+		attrPath := path.Root("event").AtListIndex(i)
+
+		eventObject, err := buildFunctionTestEventObject(event.EventType.ValueString(), event.ViewerIP.ValueString(), event.Request.ValueString(), event.Response.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(attrPath, fmt.Sprintf("building CloudFront Function (%s) test event (%s)", name, eventName), err.Error())
+
+			continue
+		}
+
+		result, err := testFunction(ctx, conn, name, etag, eventObject)
+
+		if err != nil {
+			diags.AddError(fmt.Sprintf("testing CloudFront Function (%s) with event (%s)", name, eventName), err.Error())
+
+			return diags
+		}
+
+		utilization, err := strconv.ParseInt(aws.ToString(result.ComputeUtilization), 10, 64)
+
+		if err != nil {
+			diags.AddError(fmt.Sprintf("testing CloudFront Function (%s) with event (%s)", name, eventName), fmt.Sprintf("parsing compute utilization: %s", err))
+
+			return diags
+		}
+
+		event.ComputeUtilization = types.Int64Value(utilization)
+		event.ExecutionLogs = fwflex.FlattenFrameworkStringValueListOfString(ctx, result.FunctionExecutionLogs)
+		event.FunctionOutput = fwflex.StringToFramework(ctx, result.FunctionOutput)
+
+		var failures []string
+
+		if v := aws.ToString(result.FunctionErrorMessage); v != "" {
+			failures = append(failures, fmt.Sprintf("function returned an error: %s", v))
+		}
+
+		if !event.MaxComputeUtilization.IsNull() {
+			if maxUtilization := event.MaxComputeUtilization.ValueInt64(); utilization > maxUtilization {
+				failures = append(failures, fmt.Sprintf("compute utilization %d exceeds the maximum of %d", utilization, maxUtilization))
+			}
+		}
+
+		if !event.ExpectedOutput.IsNull() && len(failures) == 0 {
+			mismatches, err := functionOutputMismatches(event.ExpectedOutput.ValueString(), aws.ToString(result.FunctionOutput))
+
+			if err != nil {
+				failures = append(failures, err.Error())
+			}
+
+			failures = append(failures, mismatches...)
+		}
+
+		if len(failures) > 0 {
+			detail := strings.Join(failures, "\n")
+			if logs := result.FunctionExecutionLogs; len(logs) > 0 {
+				detail += "\n\nExecution logs:\n" + strings.Join(logs, "\n")
+			}
+
+			diags.AddAttributeError(attrPath, fmt.Sprintf("CloudFront Function (%s) test event (%s) failed", name, eventName), detail)
+		}
+	}
+
+	return diags
+}
+
+func testFunction(ctx context.Context, conn *cloudfront.Client, name, etag string, eventObject []byte) (*awstypes.TestResult, error) {
+	input := &cloudfront.TestFunctionInput{
+		EventObject: eventObject,
+		IfMatch:     aws.String(etag),
+		Name:        aws.String(name),
+		Stage:       awstypes.FunctionStageDevelopment,
+	}
+
+	output, err := conn.TestFunction(ctx, input)
+
+	if errs.IsA[*awstypes.PreconditionFailed](err) {
+		return nil, fmt.Errorf("ETag %s does not match the function's DEVELOPMENT stage, the function has been modified since: %w", etag, err)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.TestResult == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.TestResult, nil
+}
+
+// buildFunctionTestEventObject returns the CloudFront Functions event structure for the given fixture.
+// See https://docs.aws.amazon.com/AmazonCloudFront/latest/DeveloperGuide/functions-event-structure.html.
+func buildFunctionTestEventObject(eventType, viewerIP, request, response string) ([]byte, error) {
+	if viewerIP == "" {
+		viewerIP = defaultFunctionTestViewerIP
+	}
+
+	event := map[string]any{
+		"version": "1.0",
+		"context": map[string]any{
+			"eventType": eventType,
+		},
+		"viewer": map[string]any{
+			"ip": viewerIP,
+		},
+	}
+
+	defaultRequest := map[string]any{
+		"method":      "GET",
+		"uri":         "/",
+		"querystring": map[string]any{},
+		"headers":     map[string]any{},
+		"cookies":     map[string]any{},
+	}
+	if err := decodeFunctionTestEventPart(request, "request", defaultRequest); err != nil {
+		return nil, err
+	}
+	event["request"] = defaultRequest
+
+	if eventType == functionEventTypeViewerResponse {
+		defaultResponse := map[string]any{
+			"statusCode":        200,
+			"statusDescription": "OK",
+			"headers":           map[string]any{},
+			"cookies":           map[string]any{},
+		}
+		if err := decodeFunctionTestEventPart(response, "response", defaultResponse); err != nil {
+			return nil, err
+		}
+		event["response"] = defaultResponse
+	}
+
+	return json.Marshal(event)
+}
+
+// decodeFunctionTestEventPart overlays the top-level fields of the JSON object in v onto part.
+func decodeFunctionTestEventPart(v, name string, part map[string]any) error {
+	if v == "" {
+		return nil
+	}
+
+	var m map[string]any
+	if err := json.Unmarshal([]byte(v), &m); err != nil {
+		return fmt.Errorf("%s must be a JSON object: %w", name, err)
+	}
+
+	for k, v := range m {
+		part[k] = v
+	}
+
+	return nil
+}
+
+// functionOutputMismatches compares a function's output against the expected output.
+// Every field present in expected must be present in actual with a matching value; fields only present in actual are ignored.
+// Lists must match element by element.
+func functionOutputMismatches(expected, actual string) ([]string, error) {
+	var e, a any
+
+	if err := json.Unmarshal([]byte(expected), &e); err != nil {
+		return nil, fmt.Errorf("parsing expected output: %w", err)
+	}
+
+	if err := json.Unmarshal([]byte(actual), &a); err != nil {
+		return nil, fmt.Errorf("parsing function output: %w", err)
+	}
+
+	var mismatches []string
+	jsonSubsetMismatches("", e, a, &mismatches)
+
+	return mismatches, nil
+}
+
+func jsonSubsetMismatches(fieldPath string, expected, actual any, mismatches *[]string) {
+	display := fieldPath
+	if display == "" {
+		display = "(root)"
+	}
+
+	switch e := expected.(type) {
+	case map[string]any:
+		a, ok := actual.(map[string]any)
+		if !ok {
+			*mismatches = append(*mismatches, fmt.Sprintf("%s: expected an object, got %s", display, jsonString(actual)))
+			return
+		}
+
+		keys := make([]string, 0, len(e))
+		for k := range e {
+			keys = append(keys, k)
+		}
+		slices.Sort(keys)
+
+		for _, k := range keys {
+			p := k
+			if fieldPath != "" {
+				p = fieldPath + "." + k
+			}
+
+			v, ok := a[k]
+			if !ok {
+				*mismatches = append(*mismatches, fmt.Sprintf("%s: expected %s, field not present", p, jsonString(e[k])))
+				continue
+			}
+
+			jsonSubsetMismatches(p, e[k], v, mismatches)
+		}
+	case []any:
+		a, ok := actual.([]any)
+		if !ok || len(a) != len(e) {
+			*mismatches = append(*mismatches, fmt.Sprintf("%s: expected %s, got %s", display, jsonString(expected), jsonString(actual)))
+			return
+		}
+
+		for i := range e {
+			jsonSubsetMismatches(fmt.Sprintf("%s[%d]", fieldPath, i), e[i], a[i], mismatches)
+		}
+	default:
+		if !reflect.DeepEqual(expected, actual) {
+			*mismatches = append(*mismatches, fmt.Sprintf("%s: expected %s, got %s", display, jsonString(expected), jsonString(actual)))
+		}
+	}
+}
+
+func jsonString(v any) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+
+	return string(b)
+}
+
+type functionTestResourceModel struct {
+	ETag          types.String                                            `tfsdk:"etag"`
+	Events        fwtypes.ListNestedObjectValueOf[functionTestEventModel] `tfsdk:"event"`
+	LiveStageETag types.String                                            `tfsdk:"live_stage_etag"`
+	Name          types.String                                            `tfsdk:"name"`
+	Publish       types.Bool                                              `tfsdk:"publish"`
+	Timeouts      timeouts.Value                                          `tfsdk:"timeouts"`
+}
+
+type functionTestEventModel struct {
+	ComputeUtilization    types.Int64                       `tfsdk:"compute_utilization"`
+	EventType             types.String                      `tfsdk:"event_type"`
+	ExecutionLogs         fwtypes.ListValueOf[types.String] `tfsdk:"execution_logs"`
+	ExpectedOutput        jsontypes.Normalized              `tfsdk:"expected_output"`
+	FunctionOutput        types.String                      `tfsdk:"function_output"`
+	MaxComputeUtilization types.Int64                       `tfsdk:"max_compute_utilization"`
+	Name                  types.String                      `tfsdk:"name"`
+	Request               jsontypes.Normalized              `tfsdk:"request"`
+	Response              jsontypes.Normalized              `tfsdk:"response"`
+	ViewerIP              types.String                      `tfsdk:"viewer_ip"`
+}
@@ -0,0 +1,226 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfcloudfront "github.com/hashicorp/terraform-provider-aws/internal/service/cloudfront"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestBuildFunctionTestEventObject(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		eventType string
+		viewerIP  string
+		request   string
+		response  string
+		expected  string
+		wantErr   bool
+	}{
+		"viewer-request defaults": {
+			eventType: "viewer-request",
+			expected:  `{"context":{"eventType":"viewer-request"},"request":{"cookies":{},"headers":{},"method":"GET","querystring":{},"uri":"/"},"version":"1.0","viewer":{"ip":"198.51.100.1"}}`,
+		},
+		"viewer-request overrides": {
+			eventType: "viewer-request",
+			viewerIP:  "192.0.2.10",
+			request:   `{"uri":"/blog/","headers":{"host":{"value":"example.com"}}}`,
+			expected:  `{"context":{"eventType":"viewer-request"},"request":{"cookies":{},"headers":{"host":{"value":"example.com"}},"method":"GET","querystring":{},"uri":"/blog/"},"version":"1.0","viewer":{"ip":"192.0.2.10"}}`,
+		},
+		"viewer-response": {
+			eventType: "viewer-response",
+			response:  `{"statusCode":404,"statusDescription":"Not Found"}`,
+			expected:  `{"context":{"eventType":"viewer-response"},"request":{"cookies":{},"headers":{},"method":"GET","querystring":{},"uri":"/"},"response":{"cookies":{},"headers":{},"statusCode":404,"statusDescription":"Not Found"},"version":"1.0","viewer":{"ip":"198.51.100.1"}}`,
+		},
+		"not an object": {
+			eventType: "viewer-request",
+			request:   `["/"]`,
+			wantErr:   true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfcloudfront.BuildFunctionTestEventObject(testCase.eventType, testCase.viewerIP, testCase.request, testCase.response)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("err = %v, want error: %t", err, want)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if got, want := string(got), testCase.expected; got != want {
+				t.Errorf("event object = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestFunctionOutputMismatches(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		expected string
+		actual   string
+		want     []string
+		wantErr  bool
+	}{
+		"subset matches": {
+			expected: `{"request":{"uri":"/index.html"}}`,
+			actual:   `{"request":{"uri":"/index.html","method":"GET","headers":{}}}`,
+		},
+		"value differs": {
+			expected: `{"response":{"statusCode":302}}`,
+			actual:   `{"response":{"statusCode":200}}`,
+			want:     []string{"response.statusCode: expected 302, got 200"},
+		},
+		"field missing": {
+			expected: `{"response":{"headers":{"location":{"value":"/"}}}}`,
+			actual:   `{"response":{"headers":{}}}`,
+			want:     []string{`response.headers.location: expected {"value":"/"}, field not present`},
+		},
+		"list element differs": {
+			expected: `{"request":{"cookies":{"id":{"multiValue":[{"value":"a"},{"value":"b"}]}}}}`,
+			actual:   `{"request":{"cookies":{"id":{"multiValue":[{"value":"a"},{"value":"c"}]}}}}`,
+			want:     []string{`request.cookies.id.multiValue[1].value: expected "b", got "c"`},
+		},
+		"list length differs": {
+			expected: `{"l":[1,2]}`,
+			actual:   `{"l":[1]}`,
+			want:     []string{"l: expected [1,2], got [1]"},
+		},
+		"type differs": {
+			expected: `{"request":{"uri":"/"}}`,
+			actual:   `{"request":"/"}`,
+			want:     []string{`request: expected an object, got "/"`},
+		},
+		"invalid output": {
+			expected: `{}`,
+			actual:   `not JSON`,
+			wantErr:  true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfcloudfront.FunctionOutputMismatches(testCase.expected, testCase.actual)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("err = %v, want error: %t", err, want)
+			}
+
+			if !reflect.DeepEqual(got, testCase.want) {
+				t.Errorf("mismatches = %q, want %q", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestAccCloudFrontFunctionTest_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudfront_function_test.test"
+	functionResourceName := "aws_cloudfront_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.CloudFrontEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionTestConfig_basic(rName, "/index.html"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrName, functionResourceName, names.AttrName),
+					resource.TestCheckResourceAttrPair(resourceName, "etag", functionResourceName, "etag"),
+					resource.TestCheckResourceAttrPair(resourceName, "live_stage_etag", functionResourceName, "etag"),
+					resource.TestCheckResourceAttr(resourceName, "event.#", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "event.0.compute_utilization"),
+					resource.TestCheckResourceAttrSet(resourceName, "event.0.function_output"),
+					resource.TestCheckResourceAttrSet(resourceName, "event.1.compute_utilization"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudFrontFunctionTest_failedAssertion(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.CloudFrontEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFunctionTestConfig_basic(rName, "/default.html"),
+				ExpectError: regexache.MustCompile(`request.uri: expected "/default.html", got "/index.html"`),
+			},
+		},
+	})
+}
+
+func testAccFunctionTestConfig_basic(rName, expectedURI string) string {
+	expectedOutput, _ := json.Marshal(map[string]any{
+		"request": map[string]any{
+			"uri": expectedURI,
+		},
+	})
+
+	return fmt.Sprintf(`
+resource "aws_cloudfront_function" "test" {
+  name    = %[1]q
+  runtime = "cloudfront-js-2.0"
+  publish = false
+  code    = <<-EOT
+function handler(event) {
+  var request = event.request;
+  if (request.uri.endsWith('/')) {
+    request.uri += 'index.html';
+  }
+  return request;
+}
+EOT
+}
+
+resource "aws_cloudfront_function_test" "test" {
+  name    = aws_cloudfront_function.test.name
+  etag    = aws_cloudfront_function.test.etag
+  publish = true
+
+  event {
+    name            = "directory index"
+    event_type      = "viewer-request"
+    request         = jsonencode({ uri = "/" })
+    expected_output = %[2]q
+
+    max_compute_utilization = 80
+  }
+
+  event {
+    name       = "passthrough"
+    event_type = "viewer-request"
+    request    = jsonencode({ uri = "/images/logo.png" })
+  }
+}
+`, rName, string(expectedOutput))
+}
@@ -29,6 +29,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory: newContinuousDeploymentPolicyResource,
 			Name:    "Continuous Deployment Policy",
 		},
+		{
+			Factory: newFunctionTestResource,
+			Name:    "Function Test",
+		},
 		{
 			Factory: newKeyValueStoreResource,
 			Name:    "Key Value Store",
@@ -15,13 +15,13 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_cloudhsm_v2_cluster", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_cloudhsm_v2_cluster", &resource.Sweeper{
 		Name:         "aws_cloudhsm_v2_cluster",
 		F:            sweepClusters,
 		Dependencies: []string{"aws_cloudhsm_v2_hsm"},
 	})
 
-	resource.AddTestSweepers("aws_cloudhsm_v2_hsm", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_cloudhsm_v2_hsm", &resource.Sweeper{
 		Name: "aws_cloudhsm_v2_hsm",
 		F:    sweepHSMs,
 	})
@@ -16,12 +16,12 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_cloudtrail", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_cloudtrail", &resource.Sweeper{
 		Name: "aws_cloudtrail",
 		F:    sweepTrails,
 	})
 
-	resource.AddTestSweepers("aws_cloudtrail_event_data_store", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_cloudtrail_event_data_store", &resource.Sweeper{
 		Name: "aws_cloudtrail_event_data_store",
 		F:    sweepEventDataStores,
 	})
@@ -15,7 +15,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_codegurureviewer", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_codegurureviewer", &resource.Sweeper{
 		Name: "aws_codegurureviewer",
 		F:    sweepAssociations,
 	})
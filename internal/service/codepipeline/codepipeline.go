@@ -214,6 +214,16 @@ func resourcePipeline() *schema.Resource {
 								},
 							},
 						},
+						"before_entry": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrCondition: stageConditionSchema(true),
+								},
+							},
+						},
 						names.AttrName: {
 							Type:     schema.TypeString,
 							Required: true,
@@ -222,6 +232,45 @@ func resourcePipeline() *schema.Resource {
 								validation.StringMatch(regexache.MustCompile(`[0-9A-Za-z_.@-]+`), ""),
 							),
 						},
+						"on_failure": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrCondition: stageConditionSchema(false),
+									"result": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ValidateDiagFunc: enum.Validate[types.Result](),
+									},
+									"retry_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"retry_mode": {
+													Type:             schema.TypeString,
+													Optional:         true,
+													ValidateDiagFunc: enum.Validate[types.StageRetryMode](),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"on_success": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrCondition: stageConditionSchema(true),
+								},
+							},
+						},
 					},
 				},
 			},
@@ -460,6 +509,105 @@ func resourcePipeline() *schema.Resource {
 	}
 }
 
+// stageConditionSchema returns the schema for the "condition" block of a stage's before_entry, on_success and on_failure blocks.
+func stageConditionSchema(required bool) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: required,
+		Optional: !required,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"result": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ValidateDiagFunc: enum.Validate[types.Result](),
+				},
+				names.AttrRule: {
+					Type:     schema.TypeList,
+					Required: true,
+					MinItems: 1,
+					MaxItems: 5,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							names.AttrConfiguration: {
+								Type:     schema.TypeMap,
+								Optional: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"input_artifacts": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							names.AttrName: {
+								Type:     schema.TypeString,
+								Required: true,
+								ValidateFunc: validation.All(
+									validation.StringLenBetween(1, 100),
+									validation.StringMatch(regexache.MustCompile(`[0-9A-Za-z_.@-]+`), ""),
+								),
+							},
+							names.AttrRegion: {
+								Type:     schema.TypeString,
+								Optional: true,
+								Computed: true,
+							},
+							names.AttrRoleARN: {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: verify.ValidARN,
+							},
+							"rule_type_id": {
+								Type:     schema.TypeList,
+								Required: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"category": {
+											Type:             schema.TypeString,
+											Required:         true,
+											ValidateDiagFunc: enum.Validate[types.RuleCategory](),
+										},
+										names.AttrOwner: {
+											Type:             schema.TypeString,
+											Optional:         true,
+											Computed:         true,
+											ValidateDiagFunc: enum.Validate[types.RuleOwner](),
+										},
+										"provider": {
+											Type:     schema.TypeString,
+											Required: true,
+											ValidateFunc: validation.All(
+												validation.StringLenBetween(1, 35),
+												validation.StringMatch(regexache.MustCompile(`[0-9A-Za-z_-]+`), ""),
+											),
+										},
+										names.AttrVersion: {
+											Type:     schema.TypeString,
+											Optional: true,
+											Computed: true,
+											ValidateFunc: validation.All(
+												validation.StringLenBetween(1, 9),
+												validation.StringMatch(regexache.MustCompile(`[0-9A-Za-z_-]+`), ""),
+											),
+										},
+									},
+								},
+							},
+							"timeout_in_minutes": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntBetween(5, 86400),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func resourcePipelineCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -796,10 +944,22 @@ func expandStageDeclaration(tfMap map[string]interface{}) *types.StageDeclaratio
 		apiObject.Actions = expandActionDeclarations(v)
 	}
 
+	if v, ok := tfMap["before_entry"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.BeforeEntry = expandBeforeEntryConditions(v[0].(map[string]interface{}))
+	}
+
 	if v, ok := tfMap[names.AttrName].(string); ok && v != "" {
 		apiObject.Name = aws.String(v)
 	}
 
+	if v, ok := tfMap["on_failure"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.OnFailure = expandFailureConditions(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["on_success"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.OnSuccess = expandSuccessConditions(v[0].(map[string]interface{}))
+	}
+
 	return apiObject
 }
 
@@ -829,6 +989,204 @@ func expandStageDeclarations(tfList []interface{}) []types.StageDeclaration {
 	return apiObjects
 }
 
+func expandBeforeEntryConditions(tfMap map[string]interface{}) *types.BeforeEntryConditions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.BeforeEntryConditions{}
+
+	if v, ok := tfMap[names.AttrCondition].([]interface{}); ok && len(v) > 0 {
+		apiObject.Conditions = expandConditions(v)
+	}
+
+	return apiObject
+}
+
+func expandFailureConditions(tfMap map[string]interface{}) *types.FailureConditions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.FailureConditions{}
+
+	if v, ok := tfMap[names.AttrCondition].([]interface{}); ok && len(v) > 0 {
+		apiObject.Conditions = expandConditions(v)
+	}
+
+	if v, ok := tfMap["result"].(string); ok && v != "" {
+		apiObject.Result = types.Result(v)
+	}
+
+	if v, ok := tfMap["retry_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.RetryConfiguration = expandRetryConfiguration(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandSuccessConditions(tfMap map[string]interface{}) *types.SuccessConditions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.SuccessConditions{}
+
+	if v, ok := tfMap[names.AttrCondition].([]interface{}); ok && len(v) > 0 {
+		apiObject.Conditions = expandConditions(v)
+	}
+
+	return apiObject
+}
+
+func expandRetryConfiguration(tfMap map[string]interface{}) *types.RetryConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.RetryConfiguration{}
+
+	if v, ok := tfMap["retry_mode"].(string); ok && v != "" {
+		apiObject.RetryMode = types.StageRetryMode(v)
+	}
+
+	return apiObject
+}
+
+func expandCondition(tfMap map[string]interface{}) *types.Condition {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.Condition{}
+
+	if v, ok := tfMap["result"].(string); ok && v != "" {
+		apiObject.Result = types.Result(v)
+	}
+
+	if v, ok := tfMap[names.AttrRule].([]interface{}); ok && len(v) > 0 {
+		apiObject.Rules = expandRuleDeclarations(v)
+	}
+
+	return apiObject
+}
+
+func expandConditions(tfList []interface{}) []types.Condition {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []types.Condition
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandCondition(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, *apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandRuleDeclaration(tfMap map[string]interface{}) *types.RuleDeclaration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.RuleDeclaration{}
+
+	if v, ok := tfMap[names.AttrConfiguration].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.Configuration = flex.ExpandStringValueMap(v)
+	}
+
+	if v, ok := tfMap["input_artifacts"].([]interface{}); ok && len(v) > 0 {
+		apiObject.InputArtifacts = expandInputArtifacts(v)
+	}
+
+	if v, ok := tfMap[names.AttrName].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrRegion].(string); ok && v != "" {
+		apiObject.Region = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrRoleARN].(string); ok && v != "" {
+		apiObject.RoleArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["rule_type_id"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.RuleTypeId = expandRuleTypeID(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["timeout_in_minutes"].(int); ok && v != 0 {
+		apiObject.TimeoutInMinutes = aws.Int32(int32(v))
+	}
+
+	return apiObject
+}
+
+func expandRuleDeclarations(tfList []interface{}) []types.RuleDeclaration {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []types.RuleDeclaration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandRuleDeclaration(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, *apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandRuleTypeID(tfMap map[string]interface{}) *types.RuleTypeId {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.RuleTypeId{}
+
+	if v, ok := tfMap["category"].(string); ok && v != "" {
+		apiObject.Category = types.RuleCategory(v)
+	}
+
+	if v, ok := tfMap[names.AttrOwner].(string); ok && v != "" {
+		apiObject.Owner = types.RuleOwner(v)
+	}
+
+	if v, ok := tfMap["provider"].(string); ok && v != "" {
+		apiObject.Provider = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrVersion].(string); ok && v != "" {
+		apiObject.Version = aws.String(v)
+	}
+
+	return apiObject
+}
+
 func expandActionDeclaration(tfMap map[string]interface{}) *types.ActionDeclaration {
 	if tfMap == nil {
 		return nil
@@ -1287,10 +1645,22 @@ func flattenStageDeclaration(d *schema.ResourceData, i int, apiObject types.Stag
 		tfMap[names.AttrAction] = flattenActionDeclarations(d, i, v)
 	}
 
+	if v := apiObject.BeforeEntry; v != nil {
+		tfMap["before_entry"] = []interface{}{flattenBeforeEntryConditions(v)}
+	}
+
 	if v := apiObject.Name; v != nil {
 		tfMap[names.AttrName] = aws.ToString(v)
 	}
 
+	if v := apiObject.OnFailure; v != nil {
+		tfMap["on_failure"] = []interface{}{flattenFailureConditions(v)}
+	}
+
+	if v := apiObject.OnSuccess; v != nil {
+		tfMap["on_success"] = []interface{}{flattenSuccessConditions(v)}
+	}
+
 	return tfMap
 }
 
@@ -1308,6 +1678,123 @@ func flattenStageDeclarations(d *schema.ResourceData, apiObjects []types.StageDe
 	return tfList
 }
 
+func flattenBeforeEntryConditions(apiObject *types.BeforeEntryConditions) map[string]interface{} {
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Conditions; len(v) > 0 {
+		tfMap[names.AttrCondition] = flattenConditions(v)
+	}
+
+	return tfMap
+}
+
+func flattenFailureConditions(apiObject *types.FailureConditions) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"result": apiObject.Result,
+	}
+
+	if v := apiObject.Conditions; len(v) > 0 {
+		tfMap[names.AttrCondition] = flattenConditions(v)
+	}
+
+	if v := apiObject.RetryConfiguration; v != nil {
+		tfMap["retry_configuration"] = []interface{}{map[string]interface{}{
+			"retry_mode": v.RetryMode,
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenSuccessConditions(apiObject *types.SuccessConditions) map[string]interface{} {
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Conditions; len(v) > 0 {
+		tfMap[names.AttrCondition] = flattenConditions(v)
+	}
+
+	return tfMap
+}
+
+func flattenCondition(apiObject types.Condition) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"result": apiObject.Result,
+	}
+
+	if v := apiObject.Rules; len(v) > 0 {
+		tfMap[names.AttrRule] = flattenRuleDeclarations(v)
+	}
+
+	return tfMap
+}
+
+func flattenConditions(apiObjects []types.Condition) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, flattenCondition(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenRuleDeclaration(apiObject types.RuleDeclaration) map[string]interface{} {
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Configuration; v != nil {
+		tfMap[names.AttrConfiguration] = v
+	}
+
+	if v := apiObject.InputArtifacts; len(v) > 0 {
+		tfMap["input_artifacts"] = flattenInputArtifacts(v)
+	}
+
+	if v := apiObject.Name; v != nil {
+		tfMap[names.AttrName] = aws.ToString(v)
+	}
+
+	if v := apiObject.Region; v != nil {
+		tfMap[names.AttrRegion] = aws.ToString(v)
+	}
+
+	if v := apiObject.RoleArn; v != nil {
+		tfMap[names.AttrRoleARN] = aws.ToString(v)
+	}
+
+	if apiObject := apiObject.RuleTypeId; apiObject != nil {
+		tfMap["rule_type_id"] = []interface{}{map[string]interface{}{
+			"category":        apiObject.Category,
+			names.AttrOwner:   apiObject.Owner,
+			"provider":        aws.ToString(apiObject.Provider),
+			names.AttrVersion: aws.ToString(apiObject.Version),
+		}}
+	}
+
+	if v := apiObject.TimeoutInMinutes; v != nil {
+		tfMap["timeout_in_minutes"] = aws.ToInt32(v)
+	}
+
+	return tfMap
+}
+
+func flattenRuleDeclarations(apiObjects []types.RuleDeclaration) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, flattenRuleDeclaration(apiObject))
+	}
+
+	return tfList
+}
+
 func flattenActionDeclaration(d *schema.ResourceData, i, j int, apiObject types.ActionDeclaration) map[string]interface{} {
 	var actionProvider string
 	tfMap := map[string]interface{}{}
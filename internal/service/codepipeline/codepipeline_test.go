@@ -1041,6 +1041,113 @@ func TestAccCodePipeline_manualApprovalTimeoutInMinutes(t *testing.T) {
 	})
 }
 
+func TestAccCodePipeline_stageConditions(t *testing.T) {
+	ctx := acctest.Context(t)
+	var p types.PipelineDeclaration
+	rName := sdkacctest.RandString(10)
+	resourceName := "aws_codepipeline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodePipelineServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPipelineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCodePipelineConfig_stageConditions(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPipelineExists(ctx, resourceName, &p),
+					resource.TestCheckResourceAttr(resourceName, "stage.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "stage.0.before_entry.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.before_entry.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.before_entry.0.condition.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.before_entry.0.condition.0.result", string(types.ResultFail)),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.before_entry.0.condition.0.rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.before_entry.0.condition.0.rule.0.name", "VariableCheck"),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.before_entry.0.condition.0.rule.0.rule_type_id.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.before_entry.0.condition.0.rule.0.rule_type_id.0.category", string(types.RuleCategoryRule)),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.before_entry.0.condition.0.rule.0.rule_type_id.0.owner", string(types.RuleOwnerAws)),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.before_entry.0.condition.0.rule.0.rule_type_id.0.provider", "VariableCheck"),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.before_entry.0.condition.0.rule.0.configuration.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.on_failure.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.on_failure.0.result", string(types.ResultRetry)),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.on_failure.0.retry_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.on_failure.0.retry_configuration.0.retry_mode", string(types.StageRetryModeFailedActions)),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.on_success.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCodePipelineConfig_stageConditionsUpdated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPipelineExists(ctx, resourceName, &p),
+					resource.TestCheckResourceAttr(resourceName, "stage.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.before_entry.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.on_failure.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.on_failure.0.result", string(types.ResultRollback)),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.on_failure.0.retry_configuration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.on_success.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.on_success.0.condition.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.on_success.0.condition.0.rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.on_success.0.condition.0.rule.0.name", "VariableCheck"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCodePipeline_stageConditionsRuleTypeIDDefaults(t *testing.T) {
+	ctx := acctest.Context(t)
+	var p types.PipelineDeclaration
+	rName := sdkacctest.RandString(10)
+	resourceName := "aws_codepipeline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodePipelineServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPipelineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCodePipelineConfig_stageConditionsRuleTypeIDDefaults(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPipelineExists(ctx, resourceName, &p),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.before_entry.0.condition.0.rule.0.rule_type_id.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.before_entry.0.condition.0.rule.0.rule_type_id.0.category", string(types.RuleCategoryRule)),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.before_entry.0.condition.0.rule.0.rule_type_id.0.owner", string(types.RuleOwnerAws)),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.before_entry.0.condition.0.rule.0.rule_type_id.0.provider", "VariableCheck"),
+					resource.TestCheckResourceAttrSet(resourceName, "stage.1.before_entry.0.condition.0.rule.0.rule_type_id.0.version"),
+				),
+			},
+			{
+				// Omitting owner and version doesn't cause a perpetual diff.
+				Config:   testAccCodePipelineConfig_stageConditionsRuleTypeIDDefaults(rName),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckPipelineExists(ctx context.Context, n string, v *types.PipelineDeclaration) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -2667,3 +2774,282 @@ resource "aws_codestarconnections_connection" "test" {
 }
 `, rName))
 }
+
+func testAccCodePipelineConfig_stageConditions(rName string) string { // nosemgrep:ci.codepipeline-in-func-name
+	return acctest.ConfigCompose(
+		testAccS3DefaultBucket(rName),
+		testAccServiceIAMRole(rName),
+		fmt.Sprintf(`
+resource "aws_codepipeline" "test" {
+  name          = "test-pipeline-%[1]s"
+  role_arn      = aws_iam_role.codepipeline_role.arn
+  pipeline_type = "V2"
+
+  artifact_store {
+    location = aws_s3_bucket.test.bucket
+    type     = "S3"
+  }
+
+  variable {
+    name          = "Environment"
+    default_value = "test"
+  }
+
+  stage {
+    name = "Source"
+
+    action {
+      name             = "Source"
+      category         = "Source"
+      owner            = "AWS"
+      provider         = "CodeStarSourceConnection"
+      version          = "1"
+      output_artifacts = ["test"]
+
+      configuration = {
+        ConnectionArn    = aws_codestarconnections_connection.test.arn
+        FullRepositoryId = "lifesum-terraform/test"
+        BranchName       = "main"
+      }
+    }
+  }
+
+  stage {
+    name = "Build"
+
+    action {
+      name            = "Build"
+      category        = "Build"
+      owner           = "AWS"
+      provider        = "CodeBuild"
+      input_artifacts = ["test"]
+      version         = "1"
+
+      configuration = {
+        ProjectName = "test"
+      }
+    }
+
+    before_entry {
+      condition {
+        result = "FAIL"
+
+        rule {
+          name = "VariableCheck"
+
+          rule_type_id {
+            category = "Rule"
+            owner    = "AWS"
+            provider = "VariableCheck"
+            version  = "1"
+          }
+
+          configuration = {
+            Variable = "#{variables.Environment}"
+            Value    = "test"
+            Operator = "EQ"
+          }
+        }
+      }
+    }
+
+    on_failure {
+      result = "RETRY"
+
+      retry_configuration {
+        retry_mode = "FAILED_ACTIONS"
+      }
+    }
+  }
+}
+
+resource "aws_codestarconnections_connection" "test" {
+  name          = %[1]q
+  provider_type = "GitHub"
+}
+`, rName))
+}
+
+func testAccCodePipelineConfig_stageConditionsRuleTypeIDDefaults(rName string) string { // nosemgrep:ci.codepipeline-in-func-name
+	return acctest.ConfigCompose(
+		testAccS3DefaultBucket(rName),
+		testAccServiceIAMRole(rName),
+		fmt.Sprintf(`
+resource "aws_codepipeline" "test" {
+  name          = "test-pipeline-%[1]s"
+  role_arn      = aws_iam_role.codepipeline_role.arn
+  pipeline_type = "V2"
+
+  artifact_store {
+    location = aws_s3_bucket.test.bucket
+    type     = "S3"
+  }
+
+  variable {
+    name          = "Environment"
+    default_value = "test"
+  }
+
+  stage {
+    name = "Source"
+
+    action {
+      name             = "Source"
+      category         = "Source"
+      owner            = "AWS"
+      provider         = "CodeStarSourceConnection"
+      version          = "1"
+      output_artifacts = ["test"]
+
+      configuration = {
+        ConnectionArn    = aws_codestarconnections_connection.test.arn
+        FullRepositoryId = "lifesum-terraform/test"
+        BranchName       = "main"
+      }
+    }
+  }
+
+  stage {
+    name = "Build"
+
+    action {
+      name            = "Build"
+      category        = "Build"
+      owner           = "AWS"
+      provider        = "CodeBuild"
+      input_artifacts = ["test"]
+      version         = "1"
+
+      configuration = {
+        ProjectName = "test"
+      }
+    }
+
+    before_entry {
+      condition {
+        result = "FAIL"
+
+        rule {
+          name = "VariableCheck"
+
+          rule_type_id {
+            category = "Rule"
+            provider = "VariableCheck"
+          }
+
+          configuration = {
+            Variable = "#{variables.Environment}"
+            Value    = "test"
+            Operator = "EQ"
+          }
+        }
+      }
+    }
+
+    on_failure {
+      result = "RETRY"
+
+      retry_configuration {
+        retry_mode = "FAILED_ACTIONS"
+      }
+    }
+  }
+}
+
+resource "aws_codestarconnections_connection" "test" {
+  name          = %[1]q
+  provider_type = "GitHub"
+}
+`, rName))
+}
+
+func testAccCodePipelineConfig_stageConditionsUpdated(rName string) string { // nosemgrep:ci.codepipeline-in-func-name
+	return acctest.ConfigCompose(
+		testAccS3DefaultBucket(rName),
+		testAccServiceIAMRole(rName),
+		fmt.Sprintf(`
+resource "aws_codepipeline" "test" {
+  name          = "test-pipeline-%[1]s"
+  role_arn      = aws_iam_role.codepipeline_role.arn
+  pipeline_type = "V2"
+
+  artifact_store {
+    location = aws_s3_bucket.test.bucket
+    type     = "S3"
+  }
+
+  variable {
+    name          = "Environment"
+    default_value = "test"
+  }
+
+  stage {
+    name = "Source"
+
+    action {
+      name             = "Source"
+      category         = "Source"
+      owner            = "AWS"
+      provider         = "CodeStarSourceConnection"
+      version          = "1"
+      output_artifacts = ["test"]
+
+      configuration = {
+        ConnectionArn    = aws_codestarconnections_connection.test.arn
+        FullRepositoryId = "lifesum-terraform/test"
+        BranchName       = "main"
+      }
+    }
+  }
+
+  stage {
+    name = "Build"
+
+    action {
+      name            = "Build"
+      category        = "Build"
+      owner           = "AWS"
+      provider        = "CodeBuild"
+      input_artifacts = ["test"]
+      version         = "1"
+
+      configuration = {
+        ProjectName = "test"
+      }
+    }
+
+    on_failure {
+      result = "ROLLBACK"
+    }
+
+    on_success {
+      condition {
+        result = "FAIL"
+
+        rule {
+          name = "VariableCheck"
+
+          rule_type_id {
+            category = "Rule"
+            owner    = "AWS"
+            provider = "VariableCheck"
+            version  = "1"
+          }
+
+          configuration = {
+            Variable = "#{variables.Environment}"
+            Value    = "test"
+            Operator = "EQ"
+          }
+        }
+      }
+    }
+  }
+}
+
+resource "aws_codestarconnections_connection" "test" {
+  name          = %[1]q
+  provider_type = "GitHub"
+}
+`, rName))
+}
@@ -0,0 +1,417 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codepipeline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codepipeline"
+	"github.com/aws/aws-sdk-go-v2/service/codepipeline/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_codepipeline_execution", name="Execution")
+func resourceExecution() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceExecutionCreate,
+		ReadWithoutTimeout:   resourceExecutionRead,
+		DeleteWithoutTimeout: resourceExecutionDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"pipeline_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"pipeline_version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"source_revision": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 50,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"revision_type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[types.SourceRevisionType](),
+						},
+						"revision_value": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"stage_result": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_summary": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"variable": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 50,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						names.AttrValue: {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceExecutionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CodePipelineClient(ctx)
+
+	pipelineName := d.Get("pipeline_name").(string)
+	input := &codepipeline.StartPipelineExecutionInput{
+		ClientRequestToken: aws.String(id.UniqueId()),
+		Name:               aws.String(pipelineName),
+	}
+
+	if v, ok := d.GetOk("source_revision"); ok && len(v.([]interface{})) > 0 {
+		input.SourceRevisions = expandSourceRevisionOverrides(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("variable"); ok && len(v.([]interface{})) > 0 {
+		input.Variables = expandPipelineVariables(v.([]interface{}))
+	}
+
+	output, err := conn.StartPipelineExecution(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "starting CodePipeline Pipeline (%s) execution: %s", pipelineName, err)
+	}
+
+	d.SetId(aws.ToString(output.PipelineExecutionId))
+
+	if _, err := waitPipelineExecutionSucceeded(ctx, conn, pipelineName, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		diags = append(diags, resourceExecutionRead(ctx, d, meta)...)
+
+		if stageErr := pipelineExecutionStageError(ctx, conn, pipelineName, d.Id()); stageErr != nil {
+			err = errors.Join(err, stageErr)
+		}
+
+		return sdkdiag.AppendErrorf(diags, "waiting for CodePipeline Pipeline (%s) execution (%s) complete: %s", pipelineName, d.Id(), err)
+	}
+
+	return append(diags, resourceExecutionRead(ctx, d, meta)...)
+}
+
+func resourceExecutionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CodePipelineClient(ctx)
+
+	pipelineName := d.Get("pipeline_name").(string)
+	execution, err := findPipelineExecutionByTwoPartKey(ctx, conn, pipelineName, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CodePipeline Pipeline (%s) execution (%s) not found, removing from state", pipelineName, d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CodePipeline Pipeline (%s) execution (%s): %s", pipelineName, d.Id(), err)
+	}
+
+	d.Set("pipeline_version", execution.PipelineVersion)
+	d.Set(names.AttrStatus, execution.Status)
+	d.Set("status_summary", execution.StatusSummary)
+
+	// The pipeline state only reports each stage's latest execution, so keep any
+	// previously recorded results once later executions have moved through a stage.
+	state, err := findPipelineStateByName(ctx, conn, pipelineName)
+
+	switch {
+	case tfresource.NotFound(err):
+	case err != nil:
+		return sdkdiag.AppendErrorf(diags, "reading CodePipeline Pipeline (%s) state: %s", pipelineName, err)
+	default:
+		if v := flattenStageResults(state.StageStates, d.Id()); len(v) > 0 {
+			if err := d.Set("stage_result", v); err != nil {
+				return sdkdiag.AppendErrorf(diags, "setting stage_result: %s", err)
+			}
+		}
+	}
+
+	return diags
+}
+
+func resourceExecutionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	log.Printf("[DEBUG] Removing CodePipeline Pipeline (%s) execution (%s) from state; the execution history is retained", d.Get("pipeline_name").(string), d.Id())
+
+	return diags
+}
+
+func findPipelineExecutionByTwoPartKey(ctx context.Context, conn *codepipeline.Client, pipelineName, executionID string) (*types.PipelineExecution, error) {
+	input := &codepipeline.GetPipelineExecutionInput{
+		PipelineExecutionId: aws.String(executionID),
+		PipelineName:        aws.String(pipelineName),
+	}
+
+	output, err := conn.GetPipelineExecution(ctx, input)
+
+	if errs.IsA[*types.PipelineNotFoundException](err) || errs.IsA[*types.PipelineExecutionNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.PipelineExecution == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.PipelineExecution, nil
+}
+
+func findPipelineStateByName(ctx context.Context, conn *codepipeline.Client, name string) (*codepipeline.GetPipelineStateOutput, error) {
+	input := &codepipeline.GetPipelineStateInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetPipelineState(ctx, input)
+
+	if errs.IsA[*types.PipelineNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusPipelineExecution(ctx context.Context, conn *codepipeline.Client, pipelineName, executionID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findPipelineExecutionByTwoPartKey(ctx, conn, pipelineName, executionID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitPipelineExecutionSucceeded(ctx context.Context, conn *codepipeline.Client, pipelineName, executionID string, timeout time.Duration) (*types.PipelineExecution, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(types.PipelineExecutionStatusInProgress, types.PipelineExecutionStatusStopping),
+		Target:     enum.Slice(types.PipelineExecutionStatusSucceeded),
+		Refresh:    statusPipelineExecution(ctx, conn, pipelineName, executionID),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.PipelineExecution); ok {
+		if v := aws.ToString(output.StatusSummary); v != "" {
+			tfresource.SetLastError(err, errors.New(v))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// pipelineExecutionStageError describes the failed stages and actions of the specified pipeline execution.
+func pipelineExecutionStageError(ctx context.Context, conn *codepipeline.Client, pipelineName, executionID string) error {
+	state, err := findPipelineStateByName(ctx, conn, pipelineName)
+
+	if err != nil {
+		return nil
+	}
+
+	var stageErrs []error
+
+	for _, stage := range state.StageStates {
+		if v := stage.LatestExecution; v == nil || aws.ToString(v.PipelineExecutionId) != executionID || v.Status != types.StageExecutionStatusFailed {
+			continue
+		}
+
+		stageName := aws.ToString(stage.StageName)
+		actionFailed := false
+
+		for _, action := range stage.ActionStates {
+			v := action.LatestExecution
+
+			if v == nil || v.Status != types.ActionExecutionStatusFailed {
+				continue
+			}
+
+			actionFailed = true
+			message := aws.ToString(v.Summary)
+
+			if v.ErrorDetails != nil {
+				message = aws.ToString(v.ErrorDetails.Message)
+			}
+
+			stageErrs = append(stageErrs, fmt.Errorf("stage (%s) action (%s) failed: %s", stageName, aws.ToString(action.ActionName), message))
+		}
+
+		if !actionFailed {
+			stageErrs = append(stageErrs, fmt.Errorf("stage (%s) failed", stageName))
+		}
+	}
+
+	return errors.Join(stageErrs...)
+}
+
+func expandPipelineVariables(tfList []interface{}) []types.PipelineVariable {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []types.PipelineVariable
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.PipelineVariable{
+			Name:  aws.String(tfMap[names.AttrName].(string)),
+			Value: aws.String(tfMap[names.AttrValue].(string)),
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandSourceRevisionOverrides(tfList []interface{}) []types.SourceRevisionOverride {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []types.SourceRevisionOverride
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.SourceRevisionOverride{
+			ActionName:    aws.String(tfMap["action_name"].(string)),
+			RevisionType:  types.SourceRevisionType(tfMap["revision_type"].(string)),
+			RevisionValue: aws.String(tfMap["revision_value"].(string)),
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenStageResults(apiObjects []types.StageState, executionID string) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		v := apiObject.LatestExecution
+
+		if v == nil || aws.ToString(v.PipelineExecutionId) != executionID {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrName:   aws.ToString(apiObject.StageName),
+			names.AttrStatus: v.Status,
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,251 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codepipeline_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/codepipeline/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcodepipeline "github.com/hashicorp/terraform-provider-aws/internal/service/codepipeline"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCodePipelineExecution_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.PipelineExecution
+	rName := sdkacctest.RandString(10)
+	resourceName := "aws_codepipeline_execution.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodePipelineServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPipelineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExecutionConfig_basic(rName, "v1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckExecutionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "pipeline_name", "aws_codepipeline.test", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, "pipeline_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(types.PipelineExecutionStatusSucceeded)),
+					resource.TestCheckResourceAttr(resourceName, "stage_result.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "stage_result.0.name", "Source"),
+					resource.TestCheckResourceAttr(resourceName, "stage_result.0.status", string(types.StageExecutionStatusSucceeded)),
+					resource.TestCheckResourceAttr(resourceName, "stage_result.1.name", "Deploy"),
+					resource.TestCheckResourceAttr(resourceName, "stage_result.1.status", string(types.StageExecutionStatusSucceeded)),
+					resource.TestCheckResourceAttr(resourceName, "variable.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "variable.0.name", "Version"),
+					resource.TestCheckResourceAttr(resourceName, "variable.0.value", "v1"),
+				),
+			},
+			{
+				Config: testAccExecutionConfig_basic(rName, "v2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckExecutionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(types.PipelineExecutionStatusSucceeded)),
+					resource.TestCheckResourceAttr(resourceName, "variable.0.value", "v2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCodePipelineExecution_failed(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodePipelineServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPipelineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccExecutionConfig_failed(rName),
+				ExpectError: regexache.MustCompile(`stage \(Source\) action \(Source\) failed`),
+			},
+		},
+	})
+}
+
+func testAccCheckExecutionExists(ctx context.Context, n string, v *types.PipelineExecution) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CodePipelineClient(ctx)
+
+		output, err := tfcodepipeline.FindPipelineExecutionByTwoPartKey(ctx, conn, rs.Primary.Attributes["pipeline_name"], rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccExecutionConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = "tf-test-pipeline-%[1]s"
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_versioning" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+
+resource "aws_iam_role" "test" {
+  name = "codepipeline-role-%[1]s"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect    = "Allow"
+      Principal = { Service = "codepipeline.amazonaws.com" }
+      Action    = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Action = [
+        "s3:GetBucketVersioning",
+        "s3:GetObject",
+        "s3:GetObjectVersion",
+        "s3:PutObject",
+      ]
+      Resource = [
+        aws_s3_bucket.test.arn,
+        "${aws_s3_bucket.test.arn}/*",
+      ]
+    }]
+  })
+}
+
+resource "aws_codepipeline" "test" {
+  name          = "test-pipeline-%[1]s"
+  role_arn      = aws_iam_role.test.arn
+  pipeline_type = "V2"
+
+  artifact_store {
+    location = aws_s3_bucket.test.bucket
+    type     = "S3"
+  }
+
+  variable {
+    name          = "Version"
+    default_value = "v0"
+  }
+
+  stage {
+    name = "Source"
+
+    action {
+      name             = "Source"
+      category         = "Source"
+      owner            = "AWS"
+      provider         = "S3"
+      version          = "1"
+      output_artifacts = ["source"]
+
+      configuration = {
+        S3Bucket             = aws_s3_bucket.test.bucket
+        S3ObjectKey          = "source.zip"
+        PollForSourceChanges = "false"
+      }
+    }
+  }
+
+  stage {
+    name = "Deploy"
+
+    action {
+      name            = "Deploy"
+      category        = "Deploy"
+      owner           = "AWS"
+      provider        = "S3"
+      version         = "1"
+      input_artifacts = ["source"]
+
+      configuration = {
+        BucketName = aws_s3_bucket.test.bucket
+        Extract    = "false"
+        ObjectKey  = "deployed/#{variables.Version}.zip"
+      }
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test, aws_s3_bucket_versioning.test]
+}
+`, rName)
+}
+
+func testAccExecutionConfig_basic(rName, version string) string {
+	return acctest.ConfigCompose(testAccExecutionConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "source.zip"
+  content = %[1]q
+
+  depends_on = [aws_s3_bucket_versioning.test]
+}
+
+resource "aws_codepipeline_execution" "test" {
+  pipeline_name = aws_codepipeline.test.name
+
+  variable {
+    name  = "Version"
+    value = %[1]q
+  }
+
+  depends_on = [aws_s3_object.test]
+}
+`, version))
+}
+
+func testAccExecutionConfig_failed(rName string) string {
+	return acctest.ConfigCompose(testAccExecutionConfig_base(rName), `
+resource "aws_codepipeline_execution" "test" {
+  pipeline_name = aws_codepipeline.test.name
+}
+`)
+}
@@ -6,10 +6,12 @@ package codepipeline
 // Exports for use in tests only.
 var (
 	ResourceCustomActionType = resourceCustomActionType
+	ResourceExecution        = resourceExecution
 	ResourcePipeline         = resourcePipeline
 	ResourceWebhook          = resourceWebhook
 
 	FindCustomActionTypeByThreePartKey = findCustomActionTypeByThreePartKey
 	FindPipelineByName                 = findPipelineByName
+	FindPipelineExecutionByTwoPartKey  = findPipelineExecutionByTwoPartKey
 	FindWebhookByARN                   = findWebhookByARN
 )
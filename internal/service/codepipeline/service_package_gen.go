@@ -44,6 +44,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  resourceExecution,
+			TypeName: "aws_codepipeline_execution",
+			Name:     "Execution",
+		},
 		{
 			Factory:  resourceWebhook,
 			TypeName: "aws_codepipeline_webhook",
@@ -16,12 +16,12 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_codestarconnections_connection", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_codestarconnections_connection", &resource.Sweeper{
 		Name: "aws_codestarconnections_connection",
 		F:    sweepConnections,
 	})
 
-	resource.AddTestSweepers("aws_codestarconnections_host", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_codestarconnections_host", &resource.Sweeper{
 		Name: "aws_codestarconnections_host",
 		F:    sweepHosts,
 		Dependencies: []string{
@@ -15,7 +15,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_codestarnotifications_notification_rule", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_codestarnotifications_notification_rule", &resource.Sweeper{
 		Name: "aws_codestarnotifications_notification_rule",
 		F:    sweepNotificationRules,
 	})
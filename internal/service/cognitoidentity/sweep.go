@@ -15,7 +15,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_cognito_identity_pool", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_cognito_identity_pool", &resource.Sweeper{
 		Name: "aws_cognito_identity_pool",
 		F:    sweepIdentityPools,
 	})
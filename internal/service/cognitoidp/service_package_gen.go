@@ -28,6 +28,10 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			Factory: newUserPoolDataSource,
 			Name:    "User Pool",
 		},
+		{
+			Factory: newUserPoolConfigurationDataSource,
+			Name:    "User Pool Configuration",
+		},
 	}
 }
 
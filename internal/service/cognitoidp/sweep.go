@@ -17,12 +17,12 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_cognito_user_pool_domain", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_cognito_user_pool_domain", &resource.Sweeper{
 		Name: "aws_cognito_user_pool_domain",
 		F:    sweepUserPoolDomains,
 	})
 
-	resource.AddTestSweepers("aws_cognito_user_pool", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_cognito_user_pool", &resource.Sweeper{
 		Name: "aws_cognito_user_pool",
 		F:    sweepUserPools,
 		Dependencies: []string{
@@ -0,0 +1,407 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cognitoidp
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider/types"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// userPoolConfiguration is the complete configuration of a user pool and its associated clients and domains.
+type userPoolConfiguration struct {
+	userPool  *awstypes.UserPoolType
+	mfaConfig *cognitoidentityprovider.GetUserPoolMfaConfigOutput
+	clients   []awstypes.UserPoolClientType
+	domains   []awstypes.DomainDescriptionType
+}
+
+// terraformConfiguration returns Terraform configuration, including import blocks, that matches the user pool's configuration.
+// Optional+Computed arguments are written explicitly so that later out-of-band changes show up as drift.
+func (c *userPoolConfiguration) terraformConfiguration(ctx context.Context, resourceName string) []byte {
+	f := hclwrite.NewEmptyFile()
+	body := f.Body()
+
+	userPoolID := aws.ToString(c.userPool.Id)
+	userPoolAddress := hcl.Traversal{
+		hcl.TraverseRoot{Name: "aws_cognito_user_pool"},
+		hcl.TraverseAttr{Name: resourceName},
+	}
+	userPoolIDReference := append(slices.Clone(userPoolAddress), hcl.TraverseAttr{Name: names.AttrID})
+
+	appendImportBlock(body, userPoolAddress, userPoolID)
+	block := body.AppendNewBlock("resource", []string{"aws_cognito_user_pool", resourceName})
+	writeBlockBody(block.Body(), resourceUserPool().SchemaMap(), flattenUserPoolConfiguration(ctx, c.userPool, c.mfaConfig))
+
+	labels := map[string]int{}
+
+	for _, client := range c.clients {
+		label := uniqueResourceName(labels, resourceName+"_"+sanitizeResourceName(aws.ToString(client.ClientName)))
+		address := hcl.Traversal{
+			hcl.TraverseRoot{Name: "aws_cognito_user_pool_client"},
+			hcl.TraverseAttr{Name: label},
+		}
+
+		body.AppendNewline()
+		appendImportBlock(body, address, userPoolID+"/"+aws.ToString(client.ClientId))
+		block := body.AppendNewBlock("resource", []string{"aws_cognito_user_pool_client", label})
+		writeUserPoolClientBody(block.Body(), userPoolIDReference, client)
+	}
+
+	for _, domain := range c.domains {
+		label := uniqueResourceName(labels, resourceName)
+		address := hcl.Traversal{
+			hcl.TraverseRoot{Name: "aws_cognito_user_pool_domain"},
+			hcl.TraverseAttr{Name: label},
+		}
+
+		body.AppendNewline()
+		appendImportBlock(body, address, aws.ToString(domain.Domain))
+		block := body.AppendNewBlock("resource", []string{"aws_cognito_user_pool_domain", label})
+		writeUserPoolDomainBody(block.Body(), userPoolIDReference, domain)
+	}
+
+	return hclwrite.Format(f.Bytes())
+}
+
+// flattenUserPoolConfiguration returns the user pool's configuration keyed by aws_cognito_user_pool argument name.
+func flattenUserPoolConfiguration(ctx context.Context, userPool *awstypes.UserPoolType, mfaConfig *cognitoidentityprovider.GetUserPoolMfaConfigOutput) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"account_recovery_setting":       flattenAccountRecoverySettingType(userPool.AccountRecoverySetting),
+		"admin_create_user_config":       flattenAdminCreateUserConfigType(userPool.AdminCreateUserConfig),
+		"alias_attributes":               userPool.AliasAttributes,
+		"auto_verified_attributes":       userPool.AutoVerifiedAttributes,
+		names.AttrDeletionProtection:     userPool.DeletionProtection,
+		"device_configuration":           flattenDeviceConfigurationType(userPool.DeviceConfiguration),
+		"email_configuration":            flattenEmailConfigurationType(userPool.EmailConfiguration),
+		"lambda_config":                  flattenLambdaConfigType(userPool.LambdaConfig),
+		names.AttrName:                   aws.ToString(userPool.Name),
+		names.AttrSchema:                 flattenSchemaAttributeTypes(nil, userPool.SchemaAttributes),
+		"sms_authentication_message":     aws.ToString(userPool.SmsAuthenticationMessage),
+		"sms_configuration":              flattenSMSConfigurationType(userPool.SmsConfiguration),
+		names.AttrTags:                   KeyValueTags(ctx, userPool.UserPoolTags).IgnoreAWS().Map(),
+		"user_attribute_update_settings": flattenUserAttributeUpdateSettingsType(userPool.UserAttributeUpdateSettings),
+		"user_pool_add_ons":              flattenUserPoolAddOnsType(userPool.UserPoolAddOns),
+		"username_attributes":            userPool.UsernameAttributes,
+		"username_configuration":         flattenUsernameConfigurationType(userPool.UsernameConfiguration),
+		"verification_message_template":  flattenVerificationMessageTemplateType(userPool.VerificationMessageTemplate),
+	}
+
+	if v := userPool.Policies; v != nil {
+		tfMap["password_policy"] = flattenPasswordPolicyType(v.PasswordPolicy)
+	}
+
+	// The top-level verification arguments conflict with verification_message_template, which the API always populates.
+	if userPool.VerificationMessageTemplate == nil {
+		tfMap["email_verification_message"] = aws.ToString(userPool.EmailVerificationMessage)
+		tfMap["email_verification_subject"] = aws.ToString(userPool.EmailVerificationSubject)
+		tfMap["sms_verification_message"] = aws.ToString(userPool.SmsVerificationMessage)
+	}
+
+	if mfaConfig != nil {
+		tfMap["mfa_configuration"] = mfaConfig.MfaConfiguration
+		tfMap["software_token_mfa_configuration"] = flattenSoftwareTokenMFAConfigType(mfaConfig.SoftwareTokenMfaConfiguration)
+	}
+
+	return tfMap
+}
+
+func writeUserPoolClientBody(body *hclwrite.Body, userPoolIDReference hcl.Traversal, apiObject awstypes.UserPoolClientType) {
+	body.SetAttributeValue(names.AttrName, cty.StringVal(aws.ToString(apiObject.ClientName)))
+	body.SetAttributeTraversal(names.AttrUserPoolID, userPoolIDReference)
+
+	setInt32Attribute(body, "access_token_validity", apiObject.AccessTokenValidity)
+	setStringsAttribute(body, "allowed_oauth_flows", apiObject.AllowedOAuthFlows)
+	body.SetAttributeValue("allowed_oauth_flows_user_pool_client", cty.BoolVal(aws.ToBool(apiObject.AllowedOAuthFlowsUserPoolClient)))
+	setStringsAttribute(body, "allowed_oauth_scopes", apiObject.AllowedOAuthScopes)
+	setInt32Attribute(body, "auth_session_validity", apiObject.AuthSessionValidity)
+	setStringsAttribute(body, "callback_urls", apiObject.CallbackURLs)
+	if v := aws.ToString(apiObject.DefaultRedirectURI); v != "" {
+		body.SetAttributeValue("default_redirect_uri", cty.StringVal(v))
+	}
+	body.SetAttributeValue("enable_propagate_additional_user_context_data", cty.BoolVal(aws.ToBool(apiObject.EnablePropagateAdditionalUserContextData)))
+	body.SetAttributeValue("enable_token_revocation", cty.BoolVal(aws.ToBool(apiObject.EnableTokenRevocation)))
+	setStringsAttribute(body, "explicit_auth_flows", apiObject.ExplicitAuthFlows)
+	if apiObject.ClientSecret != nil {
+		body.SetAttributeValue("generate_secret", cty.True)
+	}
+	setInt32Attribute(body, "id_token_validity", apiObject.IdTokenValidity)
+	setStringsAttribute(body, "logout_urls", apiObject.LogoutURLs)
+	if v := apiObject.PreventUserExistenceErrors; v != "" {
+		body.SetAttributeValue("prevent_user_existence_errors", cty.StringVal(string(v)))
+	}
+	setStringsAttribute(body, "read_attributes", apiObject.ReadAttributes)
+	setInt32Attribute(body, "refresh_token_validity", aws.Int32(apiObject.RefreshTokenValidity))
+	setStringsAttribute(body, "supported_identity_providers", apiObject.SupportedIdentityProviders)
+	setStringsAttribute(body, "write_attributes", apiObject.WriteAttributes)
+
+	if v := apiObject.AnalyticsConfiguration; v != nil {
+		block := body.AppendNewBlock("analytics_configuration", nil).Body()
+
+		// application_arn conflicts with the other identifying arguments.
+		if arn := aws.ToString(v.ApplicationArn); arn != "" {
+			block.SetAttributeValue("application_arn", cty.StringVal(arn))
+		} else {
+			block.SetAttributeValue(names.AttrApplicationID, cty.StringVal(aws.ToString(v.ApplicationId)))
+			block.SetAttributeValue(names.AttrExternalID, cty.StringVal(aws.ToString(v.ExternalId)))
+			block.SetAttributeValue(names.AttrRoleARN, cty.StringVal(aws.ToString(v.RoleArn)))
+		}
+
+		block.SetAttributeValue("user_data_shared", cty.BoolVal(v.UserDataShared))
+	}
+
+	if v := apiObject.TokenValidityUnits; v != nil {
+		block := body.AppendNewBlock("token_validity_units", nil).Body()
+
+		if v := v.AccessToken; v != "" {
+			block.SetAttributeValue("access_token", cty.StringVal(string(v)))
+		}
+		if v := v.IdToken; v != "" {
+			block.SetAttributeValue("id_token", cty.StringVal(string(v)))
+		}
+		if v := v.RefreshToken; v != "" {
+			block.SetAttributeValue("refresh_token", cty.StringVal(string(v)))
+		}
+	}
+}
+
+func writeUserPoolDomainBody(body *hclwrite.Body, userPoolIDReference hcl.Traversal, apiObject awstypes.DomainDescriptionType) {
+	body.SetAttributeValue(names.AttrDomain, cty.StringVal(aws.ToString(apiObject.Domain)))
+	body.SetAttributeTraversal(names.AttrUserPoolID, userPoolIDReference)
+
+	if v := apiObject.CustomDomainConfig; v != nil && aws.ToString(v.CertificateArn) != "" {
+		body.SetAttributeValue(names.AttrCertificateARN, cty.StringVal(aws.ToString(v.CertificateArn)))
+	}
+}
+
+func appendImportBlock(body *hclwrite.Body, to hcl.Traversal, id string) {
+	block := body.AppendNewBlock("import", nil).Body()
+	block.SetAttributeTraversal("to", to)
+	block.SetAttributeValue(names.AttrID, cty.StringVal(id))
+	body.AppendNewline()
+}
+
+func setInt32Attribute(body *hclwrite.Body, name string, v *int32) {
+	if v != nil && aws.ToInt32(v) != 0 {
+		body.SetAttributeValue(name, cty.NumberIntVal(int64(aws.ToInt32(v))))
+	}
+}
+
+func setStringsAttribute[T ~string](body *hclwrite.Body, name string, v []T) {
+	if len(v) == 0 {
+		return
+	}
+
+	vals := make([]cty.Value, 0, len(v))
+	for _, v := range v {
+		vals = append(vals, cty.StringVal(string(v)))
+	}
+	slices.SortFunc(vals, func(a, b cty.Value) int {
+		return strings.Compare(a.AsString(), b.AsString())
+	})
+
+	body.SetAttributeValue(name, cty.ListVal(vals))
+}
+
+// writeBlockBody writes the configurable arguments of an SDKv2 resource schema into body.
+// Computed-only attributes are skipped, as are values that match their schema default or Go's zero value.
+func writeBlockBody(body *hclwrite.Body, s map[string]*schema.Schema, tfMap map[string]interface{}) {
+	keys := make([]string, 0, len(tfMap))
+	for k := range tfMap {
+		if v, ok := s[k]; ok && (v.Optional || v.Required) && v.Deprecated == "" {
+			keys = append(keys, k)
+		}
+	}
+	// Write "name" first, then the remaining arguments alphabetically.
+	slices.SortFunc(keys, func(a, b string) int {
+		switch {
+		case a == names.AttrName:
+			return -1
+		case b == names.AttrName:
+			return 1
+		default:
+			return strings.Compare(a, b)
+		}
+	})
+
+	var blocks []string
+
+	for _, k := range keys {
+		v := s[k]
+
+		if _, ok := v.Elem.(*schema.Resource); ok {
+			blocks = append(blocks, k)
+			continue
+		} else if elem, ok := v.Elem.(*schema.Schema); ok && v.Type != schema.TypeMap {
+			if val, ok := ctyListValue(elem, tfMap[k]); ok {
+				body.SetAttributeValue(k, val)
+			}
+			continue
+		}
+
+		if v.Type == schema.TypeMap {
+			if val, ok := ctyMapValue(tfMap[k]); ok {
+				body.SetAttributeValue(k, val)
+			}
+			continue
+		}
+
+		if val, ok := ctyPrimitiveValue(v, tfMap[k]); ok {
+			body.SetAttributeValue(k, val)
+		}
+	}
+
+	for _, k := range blocks {
+		elem := s[k].Elem.(*schema.Resource)
+
+		for _, tfMap := range blockValues(tfMap[k]) {
+			block := body.AppendNewBlock(k, nil)
+			writeBlockBody(block.Body(), elem.SchemaMap(), tfMap)
+
+			if len(block.Body().Attributes()) == 0 && len(block.Body().Blocks()) == 0 {
+				body.RemoveBlock(block)
+			}
+		}
+	}
+}
+
+// blockValues returns the nested block values from a flattened list or set.
+func blockValues(v interface{}) []map[string]interface{} {
+	var tfList []map[string]interface{}
+
+	if v, ok := v.(*schema.Set); ok {
+		return blockValues(v.List())
+	}
+
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice {
+		return nil
+	}
+
+	for i := range rv.Len() {
+		if tfMap, ok := rv.Index(i).Interface().(map[string]interface{}); ok && tfMap != nil {
+			tfList = append(tfList, tfMap)
+		}
+	}
+
+	return tfList
+}
+
+func ctyPrimitiveValue(s *schema.Schema, v interface{}) (cty.Value, bool) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return cty.NilVal, false
+		}
+		rv = rv.Elem()
+	}
+
+	if !rv.IsValid() || rv.IsZero() && rv.Kind() != reflect.Bool {
+		return cty.NilVal, false
+	}
+
+	if s.Default != nil && fmt.Sprint(s.Default) == fmt.Sprint(rv.Interface()) {
+		return cty.NilVal, false
+	}
+
+	switch s.Type {
+	case schema.TypeBool:
+		if rv.Kind() != reflect.Bool || !rv.Bool() && !s.Computed && !s.Required {
+			return cty.NilVal, false
+		}
+		return cty.BoolVal(rv.Bool()), true
+	case schema.TypeInt:
+		switch rv.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return cty.NumberIntVal(rv.Int()), true
+		}
+	case schema.TypeFloat:
+		switch rv.Kind() {
+		case reflect.Float32, reflect.Float64:
+			return cty.NumberFloatVal(rv.Float()), true
+		}
+	case schema.TypeString:
+		if rv.Kind() == reflect.String {
+			return cty.StringVal(rv.String()), true
+		}
+	}
+
+	return cty.NilVal, false
+}
+
+func ctyListValue(elem *schema.Schema, v interface{}) (cty.Value, bool) {
+	if v, ok := v.(*schema.Set); ok {
+		return ctyListValue(elem, v.List())
+	}
+
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice || rv.Len() == 0 {
+		return cty.NilVal, false
+	}
+
+	vals := make([]cty.Value, 0, rv.Len())
+	for i := range rv.Len() {
+		if val, ok := ctyPrimitiveValue(elem, rv.Index(i).Interface()); ok {
+			vals = append(vals, val)
+		}
+	}
+
+	if len(vals) == 0 {
+		return cty.NilVal, false
+	}
+
+	return cty.ListVal(vals), true
+}
+
+func ctyMapValue(v interface{}) (cty.Value, bool) {
+	tfMap, ok := v.(map[string]string)
+	if !ok || len(tfMap) == 0 {
+		return cty.NilVal, false
+	}
+
+	vals := make(map[string]cty.Value, len(tfMap))
+	for k, v := range tfMap {
+		vals[k] = cty.StringVal(v)
+	}
+
+	return cty.MapVal(vals), true
+}
+
+var (
+	resourceNameRegexp        = regexache.MustCompile(`^[A-Za-z_][0-9A-Za-z_-]*$`)
+	resourceNameInvalidRegexp = regexache.MustCompile(`[^0-9a-z_-]+`)
+)
+
+// sanitizeResourceName converts s into a valid Terraform resource name.
+func sanitizeResourceName(s string) string {
+	s = strings.Trim(resourceNameInvalidRegexp.ReplaceAllString(strings.ToLower(s), "_"), "_-")
+
+	if !resourceNameRegexp.MatchString(s) {
+		s = "_" + s
+	}
+
+	return s
+}
+
+func uniqueResourceName(seen map[string]int, name string) string {
+	seen[name]++
+
+	if n := seen[name]; n > 1 {
+		return fmt.Sprintf("%s_%d", name, n)
+	}
+
+	return name
+}
@@ -0,0 +1,285 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cognitoidp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider/types"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_cognito_user_pool_configuration", name="User Pool Configuration")
+func newUserPoolConfigurationDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &userPoolConfigurationDataSource{}, nil
+}
+
+type userPoolConfigurationDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*userPoolConfigurationDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_cognito_user_pool_configuration"
+}
+
+func (d *userPoolConfigurationDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"client": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[userPoolConfigurationClientModel](ctx),
+				Computed:   true,
+				ElementType: types.ObjectType{
+					AttrTypes: fwtypes.AttributeTypesMust[userPoolConfigurationClientModel](ctx),
+				},
+			},
+			names.AttrDomain: schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[userPoolConfigurationDomainModel](ctx),
+				Computed:   true,
+				ElementType: types.ObjectType{
+					AttrTypes: fwtypes.AttributeTypesMust[userPoolConfigurationDomainModel](ctx),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"mfa_configuration": schema.StringAttribute{
+				CustomType: jsontypes.NormalizedType{},
+				Computed:   true,
+			},
+			"resource_name": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(resourceNameRegexp, "must be a valid Terraform resource name"),
+				},
+			},
+			"terraform_configuration": schema.StringAttribute{
+				Computed: true,
+			},
+			"user_pool_configuration": schema.StringAttribute{
+				CustomType: jsontypes.NormalizedType{},
+				Computed:   true,
+			},
+			names.AttrUserPoolID: schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (d *userPoolConfigurationDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data userPoolConfigurationDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().CognitoIDPClient(ctx)
+
+	userPoolID := data.UserPoolID.ValueString()
+	configuration, err := findUserPoolConfigurationByID(ctx, conn, userPoolID)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Cognito User Pool (%s) configuration", userPoolID), err.Error())
+
+		return
+	}
+
+	data.ID = types.StringValue(userPoolID)
+
+	userPoolJSON, err := json.Marshal(configuration.userPool)
+
+	if err != nil {
+		response.Diagnostics.AddError("marshalling Cognito User Pool configuration", err.Error())
+
+		return
+	}
+
+	data.UserPoolConfiguration = jsontypes.NewNormalizedValue(string(userPoolJSON))
+
+	mfaJSON, err := json.Marshal(userPoolMFAConfiguration{
+		EmailMfaConfiguration:         configuration.mfaConfig.EmailMfaConfiguration,
+		MfaConfiguration:              configuration.mfaConfig.MfaConfiguration,
+		SmsMfaConfiguration:           configuration.mfaConfig.SmsMfaConfiguration,
+		SoftwareTokenMfaConfiguration: configuration.mfaConfig.SoftwareTokenMfaConfiguration,
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError("marshalling Cognito User Pool MFA configuration", err.Error())
+
+		return
+	}
+
+	data.MFAConfiguration = jsontypes.NewNormalizedValue(string(mfaJSON))
+
+	var clients []*userPoolConfigurationClientModel
+
+	for _, client := range configuration.clients {
+		// Never export client secrets.
+		client.ClientSecret = nil
+
+		clientJSON, err := json.Marshal(client)
+
+		if err != nil {
+			response.Diagnostics.AddError("marshalling Cognito User Pool Client configuration", err.Error())
+
+			return
+		}
+
+		clients = append(clients, &userPoolConfigurationClientModel{
+			ClientID:      types.StringPointerValue(client.ClientId),
+			Configuration: jsontypes.NewNormalizedValue(string(clientJSON)),
+			Name:          types.StringPointerValue(client.ClientName),
+		})
+	}
+
+	data.Clients = fwtypes.NewListNestedObjectValueOfSliceMust(ctx, clients)
+
+	var domains []*userPoolConfigurationDomainModel
+
+	for _, domain := range configuration.domains {
+		domainJSON, err := json.Marshal(domain)
+
+		if err != nil {
+			response.Diagnostics.AddError("marshalling Cognito User Pool Domain configuration", err.Error())
+
+			return
+		}
+
+		domains = append(domains, &userPoolConfigurationDomainModel{
+			Configuration: jsontypes.NewNormalizedValue(string(domainJSON)),
+			Domain:        types.StringPointerValue(domain.Domain),
+		})
+	}
+
+	data.Domains = fwtypes.NewListNestedObjectValueOfSliceMust(ctx, domains)
+
+	resourceName := data.ResourceName.ValueString()
+	if resourceName == "" {
+		resourceName = sanitizeResourceName(aws.ToString(configuration.userPool.Name))
+	}
+
+	data.TerraformConfiguration = types.StringValue(string(configuration.terraformConfiguration(ctx, resourceName)))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findUserPoolConfigurationByID(ctx context.Context, conn *cognitoidentityprovider.Client, id string) (*userPoolConfiguration, error) {
+	userPool, err := findUserPoolByID(ctx, conn, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	mfaConfig, err := findUserPoolMFAConfigByID(ctx, conn, id)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading MFA configuration: %w", err)
+	}
+
+	clients, err := findUserPoolClientsByUserPoolID(ctx, conn, id)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading clients: %w", err)
+	}
+
+	var domains []awstypes.DomainDescriptionType
+
+	for _, v := range []*string{userPool.Domain, userPool.CustomDomain} {
+		if aws.ToString(v) == "" {
+			continue
+		}
+
+		domain, err := findUserPoolDomain(ctx, conn, aws.ToString(v))
+
+		if err != nil {
+			return nil, fmt.Errorf("reading domain (%s): %w", aws.ToString(v), err)
+		}
+
+		domains = append(domains, *domain)
+	}
+
+	return &userPoolConfiguration{
+		clients:   clients,
+		domains:   domains,
+		mfaConfig: mfaConfig,
+		userPool:  userPool,
+	}, nil
+}
+
+func findUserPoolClientsByUserPoolID(ctx context.Context, conn *cognitoidentityprovider.Client, userPoolID string) ([]awstypes.UserPoolClientType, error) {
+	input := &cognitoidentityprovider.ListUserPoolClientsInput{
+		UserPoolId: aws.String(userPoolID),
+	}
+	var output []awstypes.UserPoolClientType
+
+	pages := cognitoidentityprovider.NewListUserPoolClientsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.UserPoolClients {
+			client, err := findUserPoolClientByTwoPartKey(ctx, conn, userPoolID, aws.ToString(v.ClientId))
+
+			if err != nil {
+				return nil, err
+			}
+
+			output = append(output, *client)
+		}
+	}
+
+	return output, nil
+}
+
+type userPoolConfigurationDataSourceModel struct {
+	Clients                fwtypes.ListNestedObjectValueOf[userPoolConfigurationClientModel] `tfsdk:"client"`
+	Domains                fwtypes.ListNestedObjectValueOf[userPoolConfigurationDomainModel] `tfsdk:"domain"`
+	ID                     types.String                                                      `tfsdk:"id"`
+	MFAConfiguration       jsontypes.Normalized                                              `tfsdk:"mfa_configuration"`
+	ResourceName           types.String                                                      `tfsdk:"resource_name"`
+	TerraformConfiguration types.String                                                      `tfsdk:"terraform_configuration"`
+	UserPoolConfiguration  jsontypes.Normalized                                              `tfsdk:"user_pool_configuration"`
+	UserPoolID             types.String                                                      `tfsdk:"user_pool_id"`
+}
+
+type userPoolConfigurationClientModel struct {
+	ClientID      types.String         `tfsdk:"client_id"`
+	Configuration jsontypes.Normalized `tfsdk:"configuration"`
+	Name          types.String         `tfsdk:"name"`
+}
+
+type userPoolConfigurationDomainModel struct {
+	Configuration jsontypes.Normalized `tfsdk:"configuration"`
+	Domain        types.String         `tfsdk:"domain"`
+}
+
+// userPoolMFAConfiguration is GetUserPoolMfaConfig's output without the response metadata.
+type userPoolMFAConfiguration struct {
+	EmailMfaConfiguration         *awstypes.EmailMfaConfigType
+	MfaConfiguration              awstypes.UserPoolMfaType
+	SmsMfaConfiguration           *awstypes.SmsMfaConfigType
+	SoftwareTokenMfaConfiguration *awstypes.SoftwareTokenMfaConfigType
+}
@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cognitoidp_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCognitoIDPUserPoolConfigurationDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cognito_user_pool_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckIdentityProvider(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CognitoIDPServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserPoolDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserPoolConfigurationDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrID, "aws_cognito_user_pool.test", names.AttrID),
+					resource.TestCheckResourceAttr(dataSourceName, "client.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "client.0.client_id", "aws_cognito_user_pool_client.test", names.AttrID),
+					resource.TestCheckResourceAttr(dataSourceName, "client.0.name", rName),
+					resource.TestCheckResourceAttr(dataSourceName, "domain.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "domain.0.domain", rName),
+					resource.TestMatchResourceAttr(dataSourceName, "mfa_configuration", regexache.MustCompile(`"MfaConfiguration":"OFF"`)),
+					resource.TestMatchResourceAttr(dataSourceName, "user_pool_configuration", regexache.MustCompile(`"PreSignUp":"arn:`)),
+					resource.TestMatchResourceAttr(dataSourceName, "terraform_configuration", regexache.MustCompile(`resource "aws_cognito_user_pool" "imported"`)),
+					resource.TestMatchResourceAttr(dataSourceName, "terraform_configuration", regexache.MustCompile(`resource "aws_cognito_user_pool_client" "imported_`)),
+					resource.TestMatchResourceAttr(dataSourceName, "terraform_configuration", regexache.MustCompile(`resource "aws_cognito_user_pool_domain" "imported"`)),
+					resource.TestMatchResourceAttr(dataSourceName, "terraform_configuration", regexache.MustCompile(`pre_sign_up\s+= "arn:`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccUserPoolConfigurationDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccUserPoolLambdaConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_cognito_user_pool" "test" {
+  name = %[1]q
+
+  lambda_config {
+    pre_sign_up = aws_lambda_function.test.arn
+  }
+}
+
+resource "aws_cognito_user_pool_client" "test" {
+  name         = %[1]q
+  user_pool_id = aws_cognito_user_pool.test.id
+}
+
+resource "aws_cognito_user_pool_domain" "test" {
+  domain       = %[1]q
+  user_pool_id = aws_cognito_user_pool.test.id
+}
+
+data "aws_cognito_user_pool_configuration" "test" {
+  user_pool_id  = aws_cognito_user_pool.test.id
+  resource_name = "imported"
+
+  depends_on = [aws_cognito_user_pool_client.test, aws_cognito_user_pool_domain.test]
+}
+`, rName))
+}
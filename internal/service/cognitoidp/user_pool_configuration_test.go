@@ -0,0 +1,201 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cognitoidp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider/types"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestUserPoolConfigurationTerraformConfiguration(t *testing.T) {
+	t.Parallel()
+
+	configuration := &userPoolConfiguration{
+		userPool: &awstypes.UserPoolType{
+			AccountRecoverySetting: &awstypes.AccountRecoverySettingType{
+				RecoveryMechanisms: []awstypes.RecoveryOptionType{
+					{Name: awstypes.RecoveryOptionNameTypeVerifiedEmail, Priority: aws.Int32(1)},
+				},
+			},
+			AdminCreateUserConfig: &awstypes.AdminCreateUserConfigType{
+				AllowAdminCreateUserOnly: false,
+			},
+			AutoVerifiedAttributes: []awstypes.VerifiedAttributeType{awstypes.VerifiedAttributeTypeEmail},
+			DeletionProtection:     awstypes.DeletionProtectionTypeActive,
+			Domain:                 aws.String("example-auth"),
+			EmailConfiguration: &awstypes.EmailConfigurationType{
+				EmailSendingAccount: awstypes.EmailSendingAccountTypeCognitoDefault,
+			},
+			EmailVerificationMessage: aws.String("Your code is {####}"),
+			Id:                       aws.String("us-west-2_abc123"),
+			LambdaConfig: &awstypes.LambdaConfigType{
+				PreSignUp: aws.String("arn:aws:lambda:us-west-2:123456789012:function:pre-sign-up"),
+			},
+			Name: aws.String("Example Pool"),
+			Policies: &awstypes.UserPoolPolicyType{
+				PasswordPolicy: &awstypes.PasswordPolicyType{
+					MinimumLength:                 aws.Int32(12),
+					RequireLowercase:              true,
+					RequireNumbers:                true,
+					RequireSymbols:                false,
+					RequireUppercase:              true,
+					TemporaryPasswordValidityDays: 7,
+				},
+			},
+			SchemaAttributes: []awstypes.SchemaAttributeType{
+				{
+					AttributeDataType:      awstypes.AttributeDataTypeString,
+					DeveloperOnlyAttribute: aws.Bool(false),
+					Mutable:                aws.Bool(true),
+					Name:                   aws.String("custom:tenant"),
+					Required:               aws.Bool(false),
+					StringAttributeConstraints: &awstypes.StringAttributeConstraintsType{
+						MaxLength: aws.String("64"),
+						MinLength: aws.String("1"),
+					},
+				},
+			},
+			UserPoolTags: map[string]string{
+				"Environment":    "test",
+				"aws:cloudforma": "ignored",
+			},
+			VerificationMessageTemplate: &awstypes.VerificationMessageTemplateType{
+				DefaultEmailOption: awstypes.DefaultEmailOptionTypeConfirmWithCode,
+				EmailMessage:       aws.String("Your code is {####}"),
+			},
+		},
+		mfaConfig: &cognitoidentityprovider.GetUserPoolMfaConfigOutput{
+			MfaConfiguration: awstypes.UserPoolMfaTypeOff,
+		},
+		clients: []awstypes.UserPoolClientType{
+			{
+				AllowedOAuthFlows:               []awstypes.OAuthFlowType{awstypes.OAuthFlowTypeCode},
+				AllowedOAuthFlowsUserPoolClient: aws.Bool(true),
+				AllowedOAuthScopes:              []string{"openid", "email"},
+				CallbackURLs:                    []string{"https://example.com/callback"},
+				ClientId:                        aws.String("client1"),
+				ClientName:                      aws.String("Web App"),
+				ClientSecret:                    aws.String("secret"),
+				EnableTokenRevocation:           aws.Bool(true),
+				RefreshTokenValidity:            30,
+				TokenValidityUnits: &awstypes.TokenValidityUnitsType{
+					AccessToken:  awstypes.TimeUnitsTypeHours,
+					IdToken:      awstypes.TimeUnitsTypeHours,
+					RefreshToken: awstypes.TimeUnitsTypeDays,
+				},
+			},
+		},
+		domains: []awstypes.DomainDescriptionType{
+			{
+				Domain:     aws.String("example-auth"),
+				UserPoolId: aws.String("us-west-2_abc123"),
+			},
+		},
+	}
+
+	got := string(configuration.terraformConfiguration(context.Background(), sanitizeResourceName(aws.ToString(configuration.userPool.Name))))
+	want := `import {
+  to = aws_cognito_user_pool.example_pool
+  id = "us-west-2_abc123"
+}
+
+resource "aws_cognito_user_pool" "example_pool" {
+  name                     = "Example Pool"
+  auto_verified_attributes = ["email"]
+  deletion_protection      = "ACTIVE"
+  tags = {
+    Environment = "test"
+  }
+  account_recovery_setting {
+    recovery_mechanism {
+      name     = "verified_email"
+      priority = 1
+    }
+  }
+  lambda_config {
+    pre_sign_up = "arn:aws:lambda:us-west-2:123456789012:function:pre-sign-up"
+  }
+  password_policy {
+    minimum_length                   = 12
+    require_lowercase                = true
+    require_numbers                  = true
+    require_uppercase                = true
+    temporary_password_validity_days = 7
+  }
+  schema {
+    name                = "tenant"
+    attribute_data_type = "String"
+    mutable             = true
+    string_attribute_constraints {
+      max_length = "64"
+      min_length = "1"
+    }
+  }
+  verification_message_template {
+    email_message = "Your code is {####}"
+  }
+}
+
+import {
+  to = aws_cognito_user_pool_client.example_pool_web_app
+  id = "us-west-2_abc123/client1"
+}
+
+resource "aws_cognito_user_pool_client" "example_pool_web_app" {
+  name                                          = "Web App"
+  user_pool_id                                  = aws_cognito_user_pool.example_pool.id
+  allowed_oauth_flows                           = ["code"]
+  allowed_oauth_flows_user_pool_client          = true
+  allowed_oauth_scopes                          = ["email", "openid"]
+  callback_urls                                 = ["https://example.com/callback"]
+  enable_propagate_additional_user_context_data = false
+  enable_token_revocation                       = true
+  generate_secret                               = true
+  refresh_token_validity                        = 30
+  token_validity_units {
+    access_token  = "hours"
+    id_token      = "hours"
+    refresh_token = "days"
+  }
+}
+
+import {
+  to = aws_cognito_user_pool_domain.example_pool
+  id = "example-auth"
+}
+
+resource "aws_cognito_user_pool_domain" "example_pool" {
+  domain       = "example-auth"
+  user_pool_id = aws_cognito_user_pool.example_pool.id
+}
+`
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+want, -got): %s", diff)
+	}
+}
+
+func TestSanitizeResourceName(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		"example":          "example",
+		"Example Pool":     "example_pool",
+		"my-pool.prod":     "my-pool_prod",
+		"123 pool":         "_123_pool",
+		"  --weird!!name ": "weird_name",
+		"":                 "_",
+	}
+
+	for input, expected := range testCases {
+		if got := sanitizeResourceName(input); got != expected {
+			t.Errorf("sanitizeResourceName(%q) = %q, want %q", input, got, expected)
+		}
+	}
+}
@@ -0,0 +1,353 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package configservice
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/configservice"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/configservice/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Compliance Gate")
+func newComplianceGateResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &complianceGateResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type complianceGateResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpRead
+	framework.WithNoOpDelete
+	framework.WithTimeouts
+}
+
+func (*complianceGateResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_config_compliance_gate"
+}
+
+func (r *complianceGateResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"config_rule_names": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeBetween(1, 25),
+				},
+			},
+			"max_non_compliant_resources": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(0),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"non_compliant_resource_count": schema.Int64Attribute{
+				Computed: true,
+			},
+			"start_evaluation": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"triggers": schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+		},
+	}
+}
+
+func (r *complianceGateResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data complianceGateResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(r.evaluate(ctx, &data, r.CreateTimeout(ctx, data.Timeouts))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *complianceGateResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new complianceGateResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(r.evaluate(ctx, &new, r.UpdateTimeout(ctx, new.Timeouts))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+// evaluate waits for the configured rules' next evaluation and fails if too many resources are non-compliant.
+func (r *complianceGateResource) evaluate(ctx context.Context, data *complianceGateResourceModel, timeout time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := r.Meta().ConfigServiceClient(ctx)
+
+	configRuleNames := fwflex.ExpandFrameworkStringValueSet(ctx, data.ConfigRuleNames)
+	id := strings.Join(configRuleNames, ",")
+
+	// Without an explicit evaluation only the rules' first evaluation is waited for.
+	baseline := make(map[string]awstypes.ConfigRuleEvaluationStatus)
+
+	if data.StartEvaluation.ValueBool() {
+		statuses, err := findConfigRuleEvaluationStatuses(ctx, conn, &configservice.DescribeConfigRuleEvaluationStatusInput{
+			ConfigRuleNames: configRuleNames,
+		})
+
+		if err != nil {
+			diags.AddError(fmt.Sprintf("reading ConfigService Config Rules (%s) evaluation status", id), err.Error())
+
+			return diags
+		}
+
+		for _, v := range statuses {
+			baseline[aws.ToString(v.ConfigRuleName)] = v
+		}
+
+		input := &configservice.StartConfigRulesEvaluationInput{
+			ConfigRuleNames: configRuleNames,
+		}
+
+		// LimitExceededException is returned while an evaluation is already in progress.
+		_, err = tfresource.RetryWhenIsA[*awstypes.LimitExceededException](ctx, timeout, func() (interface{}, error) {
+			return conn.StartConfigRulesEvaluation(ctx, input)
+		})
+
+		if err != nil {
+			diags.AddError(fmt.Sprintf("starting ConfigService Config Rules (%s) evaluation", id), err.Error())
+
+			return diags
+		}
+	}
+
+	if _, err := waitConfigRulesEvaluated(ctx, conn, configRuleNames, baseline, timeout); err != nil {
+		diags.AddError(fmt.Sprintf("waiting for ConfigService Config Rules (%s) evaluation", id), err.Error())
+
+		return diags
+	}
+
+	var nonCompliant []awstypes.EvaluationResult
+
+	for _, name := range configRuleNames {
+		input := &configservice.GetComplianceDetailsByConfigRuleInput{
+			ComplianceTypes: []awstypes.ComplianceType{awstypes.ComplianceTypeNonCompliant},
+			ConfigRuleName:  aws.String(name),
+		}
+
+		output, err := findComplianceDetailsByConfigRule(ctx, conn, input)
+
+		if err != nil {
+			diags.AddError(fmt.Sprintf("reading ConfigService Config Rule (%s) compliance", name), err.Error())
+
+			return diags
+		}
+
+		nonCompliant = append(nonCompliant, output...)
+	}
+
+	// A resource that's non-compliant with more than one rule has an evaluation result for each rule.
+	n := countEvaluatedResources(nonCompliant)
+	data.NonCompliantResourceCount = types.Int64Value(n)
+
+	if threshold := data.MaxNonCompliantResources.ValueInt64(); n > threshold {
+		diags.AddError(
+			fmt.Sprintf("ConfigService Config Rules (%s) compliance gate failed", id),
+			fmt.Sprintf("%d non-compliant resources exceed the maximum of %d:\n%s", n, threshold, formatEvaluationResults(nonCompliant)),
+		)
+	}
+
+	return diags
+}
+
+func findConfigRuleEvaluationStatuses(ctx context.Context, conn *configservice.Client, input *configservice.DescribeConfigRuleEvaluationStatusInput) ([]awstypes.ConfigRuleEvaluationStatus, error) {
+	var output []awstypes.ConfigRuleEvaluationStatus
+
+	pages := configservice.NewDescribeConfigRuleEvaluationStatusPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.NoSuchConfigRuleException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.ConfigRulesEvaluationStatus...)
+	}
+
+	return output, nil
+}
+
+const (
+	configRuleEvaluationStatusEvaluated = "Evaluated"
+	configRuleEvaluationStatusPending   = "Pending"
+)
+
+func statusConfigRulesEvaluation(ctx context.Context, conn *configservice.Client, names []string, baseline map[string]awstypes.ConfigRuleEvaluationStatus) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &configservice.DescribeConfigRuleEvaluationStatusInput{
+			ConfigRuleNames: names,
+		}
+
+		output, err := findConfigRuleEvaluationStatuses(ctx, conn, input)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		for _, v := range output {
+			name := aws.ToString(v.ConfigRuleName)
+			previous := baseline[name]
+
+			if isTimeAfter(v.LastSuccessfulEvaluationTime, previous.LastSuccessfulEvaluationTime) {
+				continue
+			}
+
+			if isTimeAfter(v.LastFailedEvaluationTime, previous.LastFailedEvaluationTime) {
+				return nil, "", fmt.Errorf("Config Rule (%s) evaluation failed: %s: %s", name, aws.ToString(v.LastErrorCode), aws.ToString(v.LastErrorMessage))
+			}
+
+			return output, configRuleEvaluationStatusPending, nil
+		}
+
+		return output, configRuleEvaluationStatusEvaluated, nil
+	}
+}
+
+func waitConfigRulesEvaluated(ctx context.Context, conn *configservice.Client, names []string, baseline map[string]awstypes.ConfigRuleEvaluationStatus, timeout time.Duration) ([]awstypes.ConfigRuleEvaluationStatus, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:      []string{configRuleEvaluationStatusPending},
+		Target:       []string{configRuleEvaluationStatusEvaluated},
+		Refresh:      statusConfigRulesEvaluation(ctx, conn, names, baseline),
+		Timeout:      timeout,
+		PollInterval: 15 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.([]awstypes.ConfigRuleEvaluationStatus); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// isTimeAfter returns whether t is set and later than previous.
+func isTimeAfter(t, previous *time.Time) bool {
+	return t != nil && (previous == nil || t.After(*previous))
+}
+
+// countEvaluatedResources returns the number of distinct resources, by resource type and ID, in the specified evaluation results.
+func countEvaluatedResources(apiObjects []awstypes.EvaluationResult) int64 {
+	type resourceKey struct {
+		resourceType, resourceID string
+	}
+
+	resources := make(map[resourceKey]struct{})
+
+	for _, v := range apiObjects {
+		var key resourceKey
+		if v := v.EvaluationResultIdentifier; v != nil && v.EvaluationResultQualifier != nil {
+			key.resourceType = aws.ToString(v.EvaluationResultQualifier.ResourceType)
+			key.resourceID = aws.ToString(v.EvaluationResultQualifier.ResourceId)
+		}
+
+		resources[key] = struct{}{}
+	}
+
+	return int64(len(resources))
+}
+
+func formatEvaluationResults(apiObjects []awstypes.EvaluationResult) string {
+	const maxResults = 25
+
+	var lines []string
+
+	for i, v := range apiObjects {
+		if i == maxResults {
+			lines = append(lines, fmt.Sprintf("  ... and %d more", len(apiObjects)-maxResults))
+			break
+		}
+
+		var ruleName, resourceType, resourceID string
+		if v := v.EvaluationResultIdentifier; v != nil && v.EvaluationResultQualifier != nil {
+			ruleName = aws.ToString(v.EvaluationResultQualifier.ConfigRuleName)
+			resourceType = aws.ToString(v.EvaluationResultQualifier.ResourceType)
+			resourceID = aws.ToString(v.EvaluationResultQualifier.ResourceId)
+		}
+
+		line := fmt.Sprintf("  - %s: %s (%s)", ruleName, resourceID, resourceType)
+		if v := aws.ToString(v.Annotation); v != "" {
+			line += ": " + v
+		}
+
+		lines = append(lines, line)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+type complianceGateResourceModel struct {
+	ConfigRuleNames           fwtypes.SetValueOf[types.String] `tfsdk:"config_rule_names"`
+	MaxNonCompliantResources  types.Int64                      `tfsdk:"max_non_compliant_resources"`
+	NonCompliantResourceCount types.Int64                      `tfsdk:"non_compliant_resource_count"`
+	StartEvaluation           types.Bool                       `tfsdk:"start_evaluation"`
+	Timeouts                  timeouts.Value                   `tfsdk:"timeouts"`
+	Triggers                  fwtypes.MapValueOf[types.String] `tfsdk:"triggers"`
+}
@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package configservice_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/configservice/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfconfig "github.com/hashicorp/terraform-provider-aws/internal/service/configservice"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestCountEvaluatedResources(t *testing.T) {
+	t.Parallel()
+
+	evaluationResult := func(ruleName, resourceType, resourceID string) awstypes.EvaluationResult {
+		return awstypes.EvaluationResult{
+			EvaluationResultIdentifier: &awstypes.EvaluationResultIdentifier{
+				EvaluationResultQualifier: &awstypes.EvaluationResultQualifier{
+					ConfigRuleName: aws.String(ruleName),
+					ResourceId:     aws.String(resourceID),
+					ResourceType:   aws.String(resourceType),
+				},
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		input    []awstypes.EvaluationResult
+		expected int64
+	}{
+		"empty": {
+			expected: 0,
+		},
+		"one rule": {
+			input: []awstypes.EvaluationResult{
+				evaluationResult("rule-1", "AWS::S3::Bucket", "bucket-1"),
+				evaluationResult("rule-1", "AWS::S3::Bucket", "bucket-2"),
+			},
+			expected: 2,
+		},
+		"same resource, several rules": {
+			input: []awstypes.EvaluationResult{
+				evaluationResult("rule-1", "AWS::S3::Bucket", "bucket-1"),
+				evaluationResult("rule-2", "AWS::S3::Bucket", "bucket-1"),
+				evaluationResult("rule-2", "AWS::S3::Bucket", "bucket-2"),
+			},
+			expected: 2,
+		},
+		"same ID, different resource types": {
+			input: []awstypes.EvaluationResult{
+				evaluationResult("rule-1", "AWS::S3::Bucket", "example"),
+				evaluationResult("rule-2", "AWS::SNS::Topic", "example"),
+			},
+			expected: 2,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfconfig.CountEvaluatedResources(testCase.input), testCase.expected; got != want {
+				t.Errorf("CountEvaluatedResources() = %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+func testAccComplianceGate_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_config_compliance_gate.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConfigServiceServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComplianceGateConfig_basic(rName, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "config_rule_names.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "max_non_compliant_resources", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "non_compliant_resource_count"),
+					resource.TestCheckResourceAttr(resourceName, "start_evaluation", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
+func testAccComplianceGate_thresholdExceeded(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConfigServiceServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				// The account's IAM password policy is never compliant with an impossible minimum length.
+				Config:      testAccComplianceGateConfig_thresholdExceeded(rName),
+				ExpectError: regexache.MustCompile(`1 non-compliant resources exceed the maximum of 0`),
+			},
+		},
+	})
+}
+
+func testAccComplianceGateConfig_basic(rName string, maxNonCompliantResources int) string {
+	return acctest.ConfigCompose(testAccConfigRuleConfig_base(rName), fmt.Sprintf(`
+resource "aws_config_config_rule" "test" {
+  name = %[1]q
+
+  source {
+    owner             = "AWS"
+    source_identifier = "IAM_PASSWORD_POLICY"
+  }
+
+  depends_on = [aws_config_configuration_recorder.test]
+}
+
+resource "aws_config_compliance_gate" "test" {
+  config_rule_names           = [aws_config_config_rule.test.name]
+  max_non_compliant_resources = %[2]d
+}
+`, rName, maxNonCompliantResources))
+}
+
+func testAccComplianceGateConfig_thresholdExceeded(rName string) string {
+	return acctest.ConfigCompose(testAccConfigRuleConfig_base(rName), fmt.Sprintf(`
+resource "aws_config_config_rule" "test" {
+  name = %[1]q
+
+  source {
+    owner             = "AWS"
+    source_identifier = "IAM_PASSWORD_POLICY"
+  }
+
+  input_parameters = jsonencode({
+    MinimumPasswordLength = "128"
+  })
+
+  depends_on = [aws_config_configuration_recorder.test]
+}
+
+resource "aws_config_compliance_gate" "test" {
+  config_rule_names = [aws_config_config_rule.test.name]
+}
+`, rName))
+}
@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package configservice
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/configservice"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/configservice/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Rule Compliance")
+func newConfigRuleComplianceDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &configRuleComplianceDataSource{}, nil
+}
+
+type configRuleComplianceDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*configRuleComplianceDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_config_rule_compliance"
+}
+
+func (d *configRuleComplianceDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"compliance_type": schema.StringAttribute{
+				Computed: true,
+			},
+			"compliant_resource_count": schema.Int64Attribute{
+				Computed: true,
+			},
+			"compliant_resources": framework.DataSourceComputedListOfObjectAttribute[evaluationResultModel](ctx),
+			"config_rule_name": schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"non_compliant_resource_count": schema.Int64Attribute{
+				Computed: true,
+			},
+			"non_compliant_resources": framework.DataSourceComputedListOfObjectAttribute[evaluationResultModel](ctx),
+		},
+	}
+}
+
+func (d *configRuleComplianceDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data configRuleComplianceDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().ConfigServiceClient(ctx)
+
+	name := data.ConfigRuleName.ValueString()
+	input := &configservice.GetComplianceDetailsByConfigRuleInput{
+		ComplianceTypes: []awstypes.ComplianceType{awstypes.ComplianceTypeCompliant, awstypes.ComplianceTypeNonCompliant},
+		ConfigRuleName:  aws.String(name),
+	}
+
+	results, err := findComplianceDetailsByConfigRule(ctx, conn, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading ConfigService Config Rule (%s) compliance", name), err.Error())
+
+		return
+	}
+
+	var compliant, nonCompliant []evaluationResultModel
+	for _, v := range results {
+		switch v.ComplianceType {
+		case awstypes.ComplianceTypeCompliant:
+			compliant = append(compliant, newEvaluationResultModel(ctx, v.EvaluationResultIdentifier, v.Annotation, v.ResultRecordedTime))
+		case awstypes.ComplianceTypeNonCompliant:
+			nonCompliant = append(nonCompliant, newEvaluationResultModel(ctx, v.EvaluationResultIdentifier, v.Annotation, v.ResultRecordedTime))
+		}
+	}
+
+	data.ComplianceType = types.StringValue(string(summarizeComplianceType(len(compliant), len(nonCompliant))))
+	data.CompliantResourceCount = types.Int64Value(int64(len(compliant)))
+	data.CompliantResources = fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, compliant)
+	data.ID = types.StringValue(name)
+	data.NonCompliantResourceCount = types.Int64Value(int64(len(nonCompliant)))
+	data.NonCompliantResources = fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, nonCompliant)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findComplianceDetailsByConfigRule(ctx context.Context, conn *configservice.Client, input *configservice.GetComplianceDetailsByConfigRuleInput) ([]awstypes.EvaluationResult, error) {
+	var output []awstypes.EvaluationResult
+
+	pages := configservice.NewGetComplianceDetailsByConfigRulePaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.NoSuchConfigRuleException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.EvaluationResults...)
+	}
+
+	return output, nil
+}
+
+// summarizeComplianceType returns the overall compliance of a set of evaluation results.
+func summarizeComplianceType(compliant, nonCompliant int) awstypes.ComplianceType {
+	switch {
+	case nonCompliant > 0:
+		return awstypes.ComplianceTypeNonCompliant
+	case compliant > 0:
+		return awstypes.ComplianceTypeCompliant
+	default:
+		return awstypes.ComplianceTypeInsufficientData
+	}
+}
+
+func newEvaluationResultModel(ctx context.Context, apiObject *awstypes.EvaluationResultIdentifier, annotation *string, resultRecordedTime *time.Time) evaluationResultModel {
+	model := evaluationResultModel{
+		Annotation:         fwflex.StringToFramework(ctx, annotation),
+		ResultRecordedTime: timetypes.NewRFC3339TimePointerValue(resultRecordedTime),
+	}
+
+	if apiObject != nil {
+		if v := apiObject.EvaluationResultQualifier; v != nil {
+			model.ConfigRuleName = fwflex.StringToFramework(ctx, v.ConfigRuleName)
+			model.ResourceID = fwflex.StringToFramework(ctx, v.ResourceId)
+			model.ResourceType = fwflex.StringToFramework(ctx, v.ResourceType)
+		}
+	}
+
+	return model
+}
+
+type configRuleComplianceDataSourceModel struct {
+	ComplianceType            types.String                                           `tfsdk:"compliance_type"`
+	CompliantResourceCount    types.Int64                                            `tfsdk:"compliant_resource_count"`
+	CompliantResources        fwtypes.ListNestedObjectValueOf[evaluationResultModel] `tfsdk:"compliant_resources"`
+	ConfigRuleName            types.String                                           `tfsdk:"config_rule_name"`
+	ID                        types.String                                           `tfsdk:"id"`
+	NonCompliantResourceCount types.Int64                                            `tfsdk:"non_compliant_resource_count"`
+	NonCompliantResources     fwtypes.ListNestedObjectValueOf[evaluationResultModel] `tfsdk:"non_compliant_resources"`
+}
+
+type evaluationResultModel struct {
+	Annotation         types.String      `tfsdk:"annotation"`
+	ConfigRuleName     types.String      `tfsdk:"config_rule_name"`
+	ResourceID         types.String      `tfsdk:"resource_id"`
+	ResourceType       types.String      `tfsdk:"resource_type"`
+	ResultRecordedTime timetypes.RFC3339 `tfsdk:"result_recorded_time"`
+}
@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package configservice_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccConfigRuleComplianceDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_config_rule_compliance.test"
+	resourceName := "aws_config_config_rule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConfigServiceServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigRuleComplianceDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "compliance_type"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "compliant_resource_count"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "config_rule_name", resourceName, names.AttrName),
+					resource.TestCheckResourceAttrSet(dataSourceName, "non_compliant_resource_count"),
+				),
+			},
+		},
+	})
+}
+
+func testAccConfigRuleComplianceDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccConfigRuleConfig_basic(rName), `
+data "aws_config_rule_compliance" "test" {
+  config_rule_name = aws_config_config_rule.test.name
+}
+`)
+}
@@ -14,6 +14,10 @@ func TestAccConfigService_serial(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]map[string]func(t *testing.T){
+		"ComplianceGate": {
+			acctest.CtBasic:     testAccComplianceGate_basic,
+			"thresholdExceeded": testAccComplianceGate_thresholdExceeded,
+		},
 		"ConfigRule": {
 			acctest.CtBasic:      testAccConfigRule_basic,
 			"ownerAws":           testAccConfigRule_ownerAWS,
@@ -26,6 +30,9 @@ func TestAccConfigService_serial(t *testing.T) {
 			"tags":               testAccConfigRule_tags,
 			acctest.CtDisappears: testAccConfigRule_disappears,
 		},
+		"ConfigRuleComplianceDataSource": {
+			acctest.CtBasic: testAccConfigRuleComplianceDataSource_basic,
+		},
 		"ConfigurationRecorderStatus": {
 			acctest.CtBasic:      testAccConfigurationRecorderStatus_basic,
 			"startEnabled":       testAccConfigurationRecorderStatus_startEnabled,
@@ -50,6 +57,9 @@ func TestAccConfigService_serial(t *testing.T) {
 			"updateS3Template":          testAccConformancePack_updateS3Template,
 			"updateTemplateBody":        testAccConformancePack_updateTemplateBody,
 		},
+		"ConformancePackComplianceDataSource": {
+			acctest.CtBasic: testAccConformancePackComplianceDataSource_basic,
+		},
 		"DeliveryChannel": {
 			acctest.CtBasic:      testAccDeliveryChannel_basic,
 			"allParams":          testAccDeliveryChannel_allParams,
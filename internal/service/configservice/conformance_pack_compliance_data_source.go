@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package configservice
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/configservice"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/configservice/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Conformance Pack Compliance")
+func newConformancePackComplianceDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &conformancePackComplianceDataSource{}, nil
+}
+
+type conformancePackComplianceDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*conformancePackComplianceDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_config_conformance_pack_compliance"
+}
+
+func (d *conformancePackComplianceDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"compliance_type": schema.StringAttribute{
+				Computed: true,
+			},
+			"compliant_resource_count": schema.Int64Attribute{
+				Computed: true,
+			},
+			"compliant_resources": framework.DataSourceComputedListOfObjectAttribute[evaluationResultModel](ctx),
+			"config_rule_names": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"conformance_pack_name": schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"non_compliant_resource_count": schema.Int64Attribute{
+				Computed: true,
+			},
+			"non_compliant_resources": framework.DataSourceComputedListOfObjectAttribute[evaluationResultModel](ctx),
+		},
+	}
+}
+
+func (d *conformancePackComplianceDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data conformancePackComplianceDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().ConfigServiceClient(ctx)
+
+	name := data.ConformancePackName.ValueString()
+	input := &configservice.GetConformancePackComplianceDetailsInput{
+		ConformancePackName: aws.String(name),
+	}
+
+	if configRuleNames := fwflex.ExpandFrameworkStringValueSet(ctx, data.ConfigRuleNames); len(configRuleNames) > 0 {
+		input.Filters = &awstypes.ConformancePackEvaluationFilters{
+			ConfigRuleNames: configRuleNames,
+		}
+	}
+
+	results, err := findConformancePackComplianceDetails(ctx, conn, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading ConfigService Conformance Pack (%s) compliance", name), err.Error())
+
+		return
+	}
+
+	var compliant, nonCompliant []evaluationResultModel
+	for _, v := range results {
+		switch v.ComplianceType {
+		case awstypes.ConformancePackComplianceTypeCompliant:
+			compliant = append(compliant, newEvaluationResultModel(ctx, v.EvaluationResultIdentifier, v.Annotation, v.ResultRecordedTime))
+		case awstypes.ConformancePackComplianceTypeNonCompliant:
+			nonCompliant = append(nonCompliant, newEvaluationResultModel(ctx, v.EvaluationResultIdentifier, v.Annotation, v.ResultRecordedTime))
+		}
+	}
+
+	data.ComplianceType = types.StringValue(string(summarizeComplianceType(len(compliant), len(nonCompliant))))
+	data.CompliantResourceCount = types.Int64Value(int64(len(compliant)))
+	data.CompliantResources = fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, compliant)
+	data.ID = types.StringValue(name)
+	data.NonCompliantResourceCount = types.Int64Value(int64(len(nonCompliant)))
+	data.NonCompliantResources = fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, nonCompliant)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findConformancePackComplianceDetails(ctx context.Context, conn *configservice.Client, input *configservice.GetConformancePackComplianceDetailsInput) ([]awstypes.ConformancePackEvaluationResult, error) {
+	var output []awstypes.ConformancePackEvaluationResult
+
+	pages := configservice.NewGetConformancePackComplianceDetailsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.NoSuchConformancePackException](err) || errs.IsA[*awstypes.NoSuchConfigRuleInConformancePackException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.ConformancePackRuleEvaluationResults...)
+	}
+
+	return output, nil
+}
+
+type conformancePackComplianceDataSourceModel struct {
+	ComplianceType            types.String                                           `tfsdk:"compliance_type"`
+	CompliantResourceCount    types.Int64                                            `tfsdk:"compliant_resource_count"`
+	CompliantResources        fwtypes.ListNestedObjectValueOf[evaluationResultModel] `tfsdk:"compliant_resources"`
+	ConfigRuleNames           fwtypes.SetValueOf[types.String]                       `tfsdk:"config_rule_names"`
+	ConformancePackName       types.String                                           `tfsdk:"conformance_pack_name"`
+	ID                        types.String                                           `tfsdk:"id"`
+	NonCompliantResourceCount types.Int64                                            `tfsdk:"non_compliant_resource_count"`
+	NonCompliantResources     fwtypes.ListNestedObjectValueOf[evaluationResultModel] `tfsdk:"non_compliant_resources"`
+}
@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package configservice_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccConformancePackComplianceDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_config_conformance_pack_compliance.test"
+	resourceName := "aws_config_conformance_pack.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConfigServiceServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConformancePackComplianceDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "compliance_type"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "compliant_resource_count"),
+					resource.TestCheckResourceAttr(dataSourceName, "config_rule_names.#", "0"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "conformance_pack_name", resourceName, names.AttrName),
+					resource.TestCheckResourceAttrSet(dataSourceName, "non_compliant_resource_count"),
+				),
+			},
+		},
+	})
+}
+
+func testAccConformancePackComplianceDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccConformancePackConfig_basic(rName), `
+data "aws_config_conformance_pack_compliance" "test" {
+  conformance_pack_name = aws_config_conformance_pack.test.name
+}
+`)
+}
@@ -31,4 +31,6 @@ var (
 	FindOrganizationManagedRuleByName            = findOrganizationManagedRuleByName
 	FindRemediationConfigurationByConfigRuleName = findRemediationConfigurationByConfigRuleName
 	FindRetentionConfigurationByName             = findRetentionConfigurationByName
+
+	CountEvaluatedResources = countEvaluatedResources
 )
@@ -15,11 +15,24 @@ import (
 type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
-	return []*types.ServicePackageFrameworkDataSource{}
+	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory: newConfigRuleComplianceDataSource,
+			Name:    "Rule Compliance",
+		},
+		{
+			Factory: newConformancePackComplianceDataSource,
+			Name:    "Conformance Pack Compliance",
+		},
+	}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
 	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory: newComplianceGateResource,
+			Name:    "Compliance Gate",
+		},
 		{
 			Factory: newRetentionConfigurationResource,
 			Name:    "Retention Configuration",
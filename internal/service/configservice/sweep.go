@@ -21,12 +21,12 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_config_aggregate_authorization", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_config_aggregate_authorization", &resource.Sweeper{
 		Name: "aws_config_aggregate_authorization",
 		F:    sweepAggregateAuthorizations,
 	})
 
-	resource.AddTestSweepers("aws_config_config_rule", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_config_config_rule", &resource.Sweeper{
 		Name: "aws_config_config_rule",
 		F:    sweepConfigRules,
 		Dependencies: []string{
@@ -34,22 +34,22 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_config_configuration_aggregator", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_config_configuration_aggregator", &resource.Sweeper{
 		Name: "aws_config_configuration_aggregator",
 		F:    sweepConfigurationAggregators,
 	})
 
-	resource.AddTestSweepers("aws_config_configuration_recorder", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_config_configuration_recorder", &resource.Sweeper{
 		Name: "aws_config_configuration_recorder",
 		F:    sweepConfigurationRecorder,
 	})
 
-	resource.AddTestSweepers("aws_config_conformance_pack", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_config_conformance_pack", &resource.Sweeper{
 		Name: "aws_config_conformance_pack",
 		F:    sweepConformancePacks,
 	})
 
-	resource.AddTestSweepers("aws_config_delivery_channel", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_config_delivery_channel", &resource.Sweeper{
 		Name: "aws_config_delivery_channel",
 		F:    sweepDeliveryChannels,
 		Dependencies: []string{
@@ -57,7 +57,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_config_remediation_configuration", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_config_remediation_configuration", &resource.Sweeper{
 		Name: "aws_config_remediation_configuration",
 		F:    sweepRemediationConfigurations,
 	})
@@ -15,7 +15,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_dataexchange_data_set", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_dataexchange_data_set", &resource.Sweeper{
 		Name: "aws_dataexchange_data_set",
 		F:    sweepDataSets,
 	})
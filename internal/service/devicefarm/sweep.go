@@ -15,12 +15,12 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_devicefarm_project", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_devicefarm_project", &resource.Sweeper{
 		Name: "aws_devicefarm_project",
 		F:    sweepProjects,
 	})
 
-	resource.AddTestSweepers("aws_devicefarm_test_grid_project", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_devicefarm_test_grid_project", &resource.Sweeper{
 		Name: "aws_devicefarm_test_grid_project",
 		F:    sweepTestGridProjects,
 	})
@@ -19,17 +19,17 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_dx_connection", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_dx_connection", &resource.Sweeper{
 		Name: "aws_dx_connection",
 		F:    sweepConnections,
 	})
 
-	resource.AddTestSweepers("aws_dx_gateway_association_proposal", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_dx_gateway_association_proposal", &resource.Sweeper{
 		Name: "aws_dx_gateway_association_proposal",
 		F:    sweepGatewayAssociationProposals,
 	})
 
-	resource.AddTestSweepers("aws_dx_gateway_association", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_dx_gateway_association", &resource.Sweeper{
 		Name: "aws_dx_gateway_association",
 		F:    sweepGatewayAssociations,
 		Dependencies: []string{
@@ -37,7 +37,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_dx_gateway", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_dx_gateway", &resource.Sweeper{
 		Name: "aws_dx_gateway",
 		F:    sweepGateways,
 		Dependencies: []string{
@@ -45,13 +45,13 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_dx_lag", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_dx_lag", &resource.Sweeper{
 		Name:         "aws_dx_lag",
 		F:            sweepLags,
 		Dependencies: []string{"aws_dx_connection"},
 	})
 
-	resource.AddTestSweepers("aws_dx_macsec_key", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_dx_macsec_key", &resource.Sweeper{
 		Name:         "aws_dx_macsec_key",
 		F:            sweepMacSecKeys,
 		Dependencies: []string{},
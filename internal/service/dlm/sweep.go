@@ -16,7 +16,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_dlm_lifecycle_policy", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_dlm_lifecycle_policy", &resource.Sweeper{
 		Name: "aws_dlm_lifecycle_policy",
 		F:    sweepLifecyclePolicies,
 	})
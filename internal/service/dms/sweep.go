@@ -15,7 +15,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_dms_endpoint", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_dms_endpoint", &resource.Sweeper{
 		Name: "aws_dms_endpoint",
 		F:    sweepEndpoints,
 		Dependencies: []string{
@@ -23,12 +23,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_dms_replication_config", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_dms_replication_config", &resource.Sweeper{
 		Name: "aws_dms_replication_config",
 		F:    sweepReplicationConfigs,
 	})
 
-	resource.AddTestSweepers("aws_dms_replication_instance", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_dms_replication_instance", &resource.Sweeper{
 		Name: "aws_dms_replication_instance",
 		F:    sweepReplicationInstances,
 		Dependencies: []string{
@@ -36,7 +36,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_dms_replication_subnet_group", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_dms_replication_subnet_group", &resource.Sweeper{
 		Name: "aws_dms_replication_subnet_group",
 		F:    sweepReplicationSubnetGroups,
 		Dependencies: []string{
@@ -44,7 +44,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_dms_replication_task", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_dms_replication_task", &resource.Sweeper{
 		Name: "aws_dms_replication_task",
 		F:    sweepReplicationTasks,
 	})
@@ -34,6 +34,7 @@ import (
 
 // @SDKResource("aws_docdb_cluster", name="Cluster")
 // @Tags(identifierAttribute="arn")
+// @Stateful
 func ResourceCluster() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceClusterCreate,
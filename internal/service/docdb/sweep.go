@@ -17,7 +17,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_docdb_cluster", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_docdb_cluster", &resource.Sweeper{
 		Name: "aws_docdb_cluster",
 		F:    sweepClusters,
 		Dependencies: []string{
@@ -25,12 +25,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_docdb_cluster_instance", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_docdb_cluster_instance", &resource.Sweeper{
 		Name: "aws_docdb_cluster_instance",
 		F:    sweepClusterInstances,
 	})
 
-	resource.AddTestSweepers("aws_docdb_cluster_parameter_group", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_docdb_cluster_parameter_group", &resource.Sweeper{
 		Name: "aws_docdb_cluster_parameter_group",
 		F:    sweepClusterParameterGroups,
 		Dependencies: []string{
@@ -38,7 +38,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_docdb_cluster_snapshot", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_docdb_cluster_snapshot", &resource.Sweeper{
 		Name: "aws_docdb_cluster_snapshot",
 		F:    sweepClusterSnapshots,
 		Dependencies: []string{
@@ -46,12 +46,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_docdb_event_subscription", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_docdb_event_subscription", &resource.Sweeper{
 		Name: "aws_docdb_event_subscription",
 		F:    sweepEventSubscriptions,
 	})
 
-	resource.AddTestSweepers("aws_docdb_global_cluster", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_docdb_global_cluster", &resource.Sweeper{
 		Name: "aws_docdb_global_cluster",
 		F:    sweepGlobalClusters,
 		Dependencies: []string{
@@ -59,7 +59,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_docdb_subnet_group", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_docdb_subnet_group", &resource.Sweeper{
 		Name: "aws_docdb_subnet_group",
 		F:    sweepSubnetGroups,
 		Dependencies: []string{
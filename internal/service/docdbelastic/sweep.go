@@ -17,7 +17,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_docdbelastic_cluster", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_docdbelastic_cluster", &resource.Sweeper{
 		Name: "aws_docdbelastic_cluster",
 		F:    sweepClusters,
 	})
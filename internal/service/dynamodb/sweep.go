@@ -22,12 +22,12 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_dynamodb_table", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_dynamodb_table", &resource.Sweeper{
 		Name: "aws_dynamodb_table",
 		F:    sweepTables,
 	})
 
-	resource.AddTestSweepers("aws_dynamodb_backup", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_dynamodb_backup", &resource.Sweeper{
 		Name: "aws_dynamodb_backup",
 		F:    sweepBackups,
 	})
@@ -48,6 +48,7 @@ const (
 // @SDKResource("aws_dynamodb_table", name="Table")
 // @Tags(identifierAttribute="arn")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/dynamodb/types;types.TableDescription")
+// @Stateful
 func resourceTable() *schema.Resource {
 	//lintignore:R011
 	return &schema.Resource{
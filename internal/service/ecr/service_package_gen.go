@@ -98,6 +98,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Stateful: true,
 		},
 		{
 			Factory:  resourceRepositoryCreationTemplate,
@@ -16,7 +16,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_ecrpublic_repository", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_ecrpublic_repository", &resource.Sweeper{
 		Name: "aws_ecrpublic_repository",
 		F:    sweepRepositories,
 	})
@@ -30,6 +30,7 @@ import (
 
 // @SDKResource("aws_efs_file_system", name="File System")
 // @Tags(identifierAttribute="id")
+// @Stateful
 func resourceFileSystem() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceFileSystemCreate,
@@ -71,6 +71,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			},
+			Stateful: true,
 		},
 		{
 			Factory:  resourceFileSystemPolicy,
@@ -32,6 +32,7 @@ import (
 
 // @SDKResource("aws_eks_cluster", name="Cluster")
 // @Tags(identifierAttribute="arn")
+// @Testing(tagsTest=false)
 func resourceCluster() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceClusterCreate,
@@ -23,4 +23,7 @@ var (
 	FindNodegroupByTwoPartKey                  = findNodegroupByTwoPartKey
 	FindOIDCIdentityProviderConfigByTwoPartKey = findOIDCIdentityProviderConfigByTwoPartKey
 	FindPodIdentityAssociationByTwoPartKey     = findPodIdentityAssociationByTwoPartKey
+
+	ExpandAccessEntryUsername = expandAccessEntryUsername
+	NewKubeconfigExecConfig   = newKubeconfigExecConfig
 )
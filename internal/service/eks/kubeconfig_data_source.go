@@ -0,0 +1,445 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsarn "github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	kubeconfigAuthModeExec  = "exec"
+	kubeconfigAuthModeToken = "token"
+
+	kubeconfigDefaultExecAPIVersion = "client.authentication.k8s.io/v1beta1"
+	kubeconfigDefaultExecCommand    = "aws"
+)
+
+// @FrameworkDataSource("aws_eks_kubeconfig", name="Kubeconfig")
+func newKubeconfigDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &kubeconfigDataSource{}, nil
+}
+
+type kubeconfigDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*kubeconfigDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_eks_kubeconfig"
+}
+
+func (d *kubeconfigDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"auth_mode": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(kubeconfigAuthModeExec, kubeconfigAuthModeToken),
+				},
+			},
+			"cluster_names": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+			"current_context": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"exec_api_version": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"exec_command": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"exec_profile": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"exec_role_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+				Computed:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"include_access_entry_user_names": schema.BoolAttribute{
+				Optional: true,
+			},
+			"kubeconfig": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"user_names": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *kubeconfigDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data kubeconfigDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().EKSClient(ctx)
+	region := d.Meta().Region
+
+	var clusterNames []string
+	response.Diagnostics.Append(data.ClusterNames.ElementsAs(ctx, &clusterNames, false)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.AuthMode.IsNull() {
+		data.AuthMode = types.StringValue(kubeconfigAuthModeExec)
+	}
+	if data.CurrentContext.IsNull() {
+		data.CurrentContext = types.StringValue(clusterNames[0])
+	} else if !slices.Contains(clusterNames, data.CurrentContext.ValueString()) {
+		response.Diagnostics.AddAttributeError(path.Root("current_context"), "Invalid Attribute Value", "current_context must be one of cluster_names")
+
+		return
+	}
+	if data.ExecAPIVersion.IsNull() {
+		data.ExecAPIVersion = types.StringValue(kubeconfigDefaultExecAPIVersion)
+	}
+	if data.ExecCommand.IsNull() {
+		data.ExecCommand = types.StringValue(kubeconfigDefaultExecCommand)
+	}
+	// The exec plugin runs outside of Terraform, so it's pointed at the same profile and role as the provider.
+	if data.ExecProfile.IsNull() {
+		data.ExecProfile = fwflex.StringValueToFramework(ctx, d.Meta().Profile(ctx))
+	}
+	if v := d.Meta().AssumeRoleARN(ctx); data.ExecRoleARN.IsNull() && v != "" {
+		data.ExecRoleARN = fwtypes.ARNValue(v)
+	}
+
+	var callerIdentity *sts.GetCallerIdentityOutput
+	if data.IncludeAccessEntryUserNames.ValueBool() {
+		output, err := d.Meta().STSClient(ctx).GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+
+		if err != nil {
+			response.Diagnostics.AddError("reading STS Caller Identity", err.Error())
+
+			return
+		}
+
+		callerIdentity = output
+	}
+
+	var tokenGenerator Generator
+	if data.AuthMode.ValueString() == kubeconfigAuthModeToken {
+		generator, err := NewGenerator(false, false)
+
+		if err != nil {
+			response.Diagnostics.AddError("creating EKS token generator", err.Error())
+
+			return
+		}
+
+		tokenGenerator = generator
+	}
+
+	config := newKubeconfig()
+	userNames := make(map[string]string, len(clusterNames))
+
+	for _, clusterName := range clusterNames {
+		cluster, err := findClusterByName(ctx, conn, clusterName)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading EKS Cluster (%s)", clusterName), err.Error())
+
+			return
+		}
+
+		var user kubeconfigUser
+		switch data.AuthMode.ValueString() {
+		case kubeconfigAuthModeToken:
+			// Tokens are generated with the provider's credentials, including any assumed role.
+			token, err := tokenGenerator.GetWithSTS(ctx, clusterName, d.Meta().STSClient(ctx))
+
+			if err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("reading EKS Cluster (%s) Authentication Token", clusterName), err.Error())
+
+				return
+			}
+
+			user.Token = token.Token
+		default:
+			user.Exec = newKubeconfigExecConfig(data.ExecAPIVersion.ValueString(), data.ExecCommand.ValueString(), region, clusterName, data.ExecRoleARN.ValueString(), data.ExecProfile.ValueString())
+		}
+
+		userName := aws.ToString(cluster.Arn)
+		if callerIdentity != nil {
+			accessEntry, err := findAccessEntryByCallerARN(ctx, conn, clusterName, aws.ToString(callerIdentity.Arn))
+
+			switch {
+			case tfresource.NotFound(err):
+				response.Diagnostics.AddWarning(
+					fmt.Sprintf("EKS Cluster (%s) access entry not found", clusterName),
+					fmt.Sprintf("No access entry found for %s, the cluster ARN is used as the kubeconfig user name.", aws.ToString(callerIdentity.Arn)),
+				)
+			case err != nil:
+				response.Diagnostics.AddError(fmt.Sprintf("reading EKS Cluster (%s) access entry for %s", clusterName, aws.ToString(callerIdentity.Arn)), err.Error())
+
+				return
+			default:
+				if v := aws.ToString(accessEntry.Username); v != "" {
+					_, sessionName := tfiam.RoleNameSessionFromARN(aws.ToString(callerIdentity.Arn))
+					userName = expandAccessEntryUsername(v, aws.ToString(callerIdentity.Account), sessionName)
+				}
+			}
+		}
+
+		var caData string
+		if cluster.CertificateAuthority != nil {
+			caData = aws.ToString(cluster.CertificateAuthority.Data)
+		}
+
+		userNames[clusterName] = config.addCluster(aws.ToString(cluster.Arn), aws.ToString(cluster.Endpoint), caData, userName, user)
+
+		if clusterName == data.CurrentContext.ValueString() {
+			config.CurrentContext = aws.ToString(cluster.Arn)
+		}
+	}
+
+	b, err := yaml.Marshal(config)
+
+	if err != nil {
+		response.Diagnostics.AddError("rendering kubeconfig", err.Error())
+
+		return
+	}
+
+	data.ID = types.StringValue(strings.Join(clusterNames, ","))
+	data.Kubeconfig = types.StringValue(string(b))
+	data.UserNames = fwflex.FlattenFrameworkStringValueMap(ctx, userNames)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// findAccessEntryByCallerARN returns the cluster's access entry for the IAM principal behind an STS caller ARN.
+func findAccessEntryByCallerARN(ctx context.Context, conn *eks.Client, clusterName, callerARN string) (*awstypes.AccessEntry, error) {
+	principalARN, err := Canonicalize(callerARN)
+
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := findAccessEntryByTwoPartKey(ctx, conn, clusterName, principalARN)
+
+	if !tfresource.NotFound(err) {
+		return output, err
+	}
+
+	notFoundErr := err
+
+	// Role ARNs derived from assumed-role ARNs don't include the role's path.
+	arn, err := awsarn.Parse(principalARN)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !strings.HasPrefix(arn.Resource, "role/") {
+		return nil, notFoundErr
+	}
+
+	roleName := arn.Resource[strings.LastIndex(arn.Resource, "/")+1:]
+	input := &eks.ListAccessEntriesInput{
+		ClusterName: aws.String(clusterName),
+	}
+
+	pages := eks.NewListAccessEntriesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.AccessEntries {
+			entryARN, err := awsarn.Parse(v)
+
+			if err != nil || entryARN.AccountID != arn.AccountID || !strings.HasPrefix(entryARN.Resource, "role/") {
+				continue
+			}
+
+			if strings.HasSuffix(entryARN.Resource, "/"+roleName) {
+				return findAccessEntryByTwoPartKey(ctx, conn, clusterName, v)
+			}
+		}
+	}
+
+	return nil, notFoundErr
+}
+
+// expandAccessEntryUsername expands the caller-specific template variables in an access entry's Kubernetes username.
+// See https://docs.aws.amazon.com/eks/latest/userguide/creating-access-entries.html.
+func expandAccessEntryUsername(username, accountID, sessionName string) string {
+	return strings.NewReplacer(
+		"{{AccountID}}", accountID,
+		"{{SessionNameRaw}}", sessionName,
+		"{{SessionName}}", strings.ReplaceAll(sessionName, "@", "-"),
+	).Replace(username)
+}
+
+func newKubeconfigExecConfig(apiVersion, command, region, clusterName, roleARN, profile string) *kubeconfigExecConfig {
+	config := &kubeconfigExecConfig{
+		APIVersion: apiVersion,
+		Command:    command,
+		Args:       []string{"--region", region, "eks", "get-token", "--cluster-name", clusterName, "--output", "json"},
+	}
+
+	if roleARN != "" {
+		config.Args = append(config.Args, "--role-arn", roleARN)
+	}
+
+	if profile != "" {
+		config.Env = append(config.Env, kubeconfigExecEnvVar{Name: "AWS_PROFILE", Value: profile})
+	}
+
+	// The v1 ExecConfig API requires interactiveMode.
+	if apiVersion == "client.authentication.k8s.io/v1" {
+		config.InteractiveMode = "Never"
+	}
+
+	return config
+}
+
+type kubeconfigDataSourceModel struct {
+	AuthMode                    types.String                      `tfsdk:"auth_mode"`
+	ClusterNames                fwtypes.ListValueOf[types.String] `tfsdk:"cluster_names"`
+	CurrentContext              types.String                      `tfsdk:"current_context"`
+	ExecAPIVersion              types.String                      `tfsdk:"exec_api_version"`
+	ExecCommand                 types.String                      `tfsdk:"exec_command"`
+	ExecProfile                 types.String                      `tfsdk:"exec_profile"`
+	ExecRoleARN                 fwtypes.ARN                       `tfsdk:"exec_role_arn"`
+	ID                          types.String                      `tfsdk:"id"`
+	IncludeAccessEntryUserNames types.Bool                        `tfsdk:"include_access_entry_user_names"`
+	Kubeconfig                  types.String                      `tfsdk:"kubeconfig"`
+	UserNames                   types.Map                         `tfsdk:"user_names"`
+}
+
+// kubeconfig is the subset of the client-go clientcmd v1 Config that's rendered.
+type kubeconfig struct {
+	APIVersion     string                   `yaml:"apiVersion"`
+	Kind           string                   `yaml:"kind"`
+	Clusters       []kubeconfigNamedCluster `yaml:"clusters"`
+	Contexts       []kubeconfigNamedContext `yaml:"contexts"`
+	CurrentContext string                   `yaml:"current-context"`
+	Preferences    struct{}                 `yaml:"preferences"`
+	Users          []kubeconfigNamedUser    `yaml:"users"`
+}
+
+type kubeconfigNamedCluster struct {
+	Name    string            `yaml:"name"`
+	Cluster kubeconfigCluster `yaml:"cluster"`
+}
+
+type kubeconfigCluster struct {
+	CertificateAuthorityData string `yaml:"certificate-authority-data,omitempty"`
+	Server                   string `yaml:"server"`
+}
+
+type kubeconfigNamedContext struct {
+	Name    string            `yaml:"name"`
+	Context kubeconfigContext `yaml:"context"`
+}
+
+type kubeconfigContext struct {
+	Cluster string `yaml:"cluster"`
+	User    string `yaml:"user"`
+}
+
+type kubeconfigNamedUser struct {
+	Name string         `yaml:"name"`
+	User kubeconfigUser `yaml:"user"`
+}
+
+type kubeconfigUser struct {
+	Exec  *kubeconfigExecConfig `yaml:"exec,omitempty"`
+	Token string                `yaml:"token,omitempty"`
+}
+
+type kubeconfigExecConfig struct {
+	APIVersion      string                 `yaml:"apiVersion"`
+	Command         string                 `yaml:"command"`
+	Args            []string               `yaml:"args,omitempty"`
+	Env             []kubeconfigExecEnvVar `yaml:"env,omitempty"`
+	InteractiveMode string                 `yaml:"interactiveMode,omitempty"`
+}
+
+type kubeconfigExecEnvVar struct {
+	Name  string `yaml:"name"`
+	Value string `yaml:"value"`
+}
+
+func newKubeconfig() *kubeconfig {
+	return &kubeconfig{
+		APIVersion: "v1",
+		Kind:       "Config",
+	}
+}
+
+// addCluster adds a cluster, a context and a user, all named after the cluster, and returns the user's name.
+// If userName is already in use by another cluster, the cluster name is appended to keep it unique.
+func (k *kubeconfig) addCluster(name, server, caData, userName string, user kubeconfigUser) string {
+	if slices.ContainsFunc(k.Users, func(v kubeconfigNamedUser) bool { return v.Name == userName }) {
+		userName = userName + "@" + name
+	}
+
+	k.Clusters = append(k.Clusters, kubeconfigNamedCluster{
+		Name: name,
+		Cluster: kubeconfigCluster{
+			CertificateAuthorityData: caData,
+			Server:                   server,
+		},
+	})
+	k.Contexts = append(k.Contexts, kubeconfigNamedContext{
+		Name: name,
+		Context: kubeconfigContext{
+			Cluster: name,
+			User:    userName,
+		},
+	})
+	k.Users = append(k.Users, kubeconfigNamedUser{
+		Name: userName,
+		User: user,
+	})
+
+	return userName
+}
@@ -0,0 +1,215 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfeks "github.com/hashicorp/terraform-provider-aws/internal/service/eks"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestExpandAccessEntryUsername(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		username    string
+		accountID   string
+		sessionName string
+		expected    string
+	}{
+		"no variables": {
+			username: "ci-deployer",
+			expected: "ci-deployer",
+		},
+		"session name": {
+			username:    "deployer:{{SessionName}}",
+			sessionName: "jane@example.com",
+			expected:    "deployer:jane-example.com",
+		},
+		"raw session name": {
+			username:    "deployer:{{SessionNameRaw}}",
+			sessionName: "jane@example.com",
+			expected:    "deployer:jane@example.com",
+		},
+		"account ID": {
+			username:    "{{AccountID}}:{{SessionName}}",
+			accountID:   "123456789012",
+			sessionName: "ci",
+			expected:    "123456789012:ci",
+		},
+		"node variables left": {
+			username: "system:node:{{EC2PrivateDNSName}}",
+			expected: "system:node:{{EC2PrivateDNSName}}",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfeks.ExpandAccessEntryUsername(testCase.username, testCase.accountID, testCase.sessionName), testCase.expected; got != want {
+				t.Errorf("username = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestNewKubeconfigExecConfig(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		apiVersion          string
+		roleARN             string
+		profile             string
+		wantArgs            []string
+		wantEnv             int
+		wantInteractiveMode string
+	}{
+		"defaults": {
+			apiVersion: "client.authentication.k8s.io/v1beta1",
+			wantArgs:   []string{"--region", "us-west-2", "eks", "get-token", "--cluster-name", "example", "--output", "json"},
+		},
+		"role and profile": {
+			apiVersion: "client.authentication.k8s.io/v1beta1",
+			roleARN:    "arn:aws:iam::123456789012:role/deployer",
+			profile:    "ci",
+			wantArgs:   []string{"--region", "us-west-2", "eks", "get-token", "--cluster-name", "example", "--output", "json", "--role-arn", "arn:aws:iam::123456789012:role/deployer"},
+			wantEnv:    1,
+		},
+		"v1": {
+			apiVersion:          "client.authentication.k8s.io/v1",
+			wantArgs:            []string{"--region", "us-west-2", "eks", "get-token", "--cluster-name", "example", "--output", "json"},
+			wantInteractiveMode: "Never",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfeks.NewKubeconfigExecConfig(testCase.apiVersion, "aws", "us-west-2", "example", testCase.roleARN, testCase.profile)
+
+			if !reflect.DeepEqual(got.Args, testCase.wantArgs) {
+				t.Errorf("args = %q, want %q", got.Args, testCase.wantArgs)
+			}
+
+			if got, want := len(got.Env), testCase.wantEnv; got != want {
+				t.Errorf("len(env) = %d, want %d", got, want)
+			}
+
+			if got, want := got.InteractiveMode, testCase.wantInteractiveMode; got != want {
+				t.Errorf("interactiveMode = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestAccEKSKubeconfigDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_eks_kubeconfig.test"
+	clusterResourceName := "aws_eks_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubeconfigDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "auth_mode", "exec"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "current_context", clusterResourceName, names.AttrName),
+					resource.TestCheckResourceAttr(dataSourceName, "exec_api_version", "client.authentication.k8s.io/v1beta1"),
+					resource.TestCheckResourceAttr(dataSourceName, "exec_command", "aws"),
+					resource.TestMatchResourceAttr(dataSourceName, "kubeconfig", regexache.MustCompile(`certificate-authority-data: \S+`)),
+					resource.TestMatchResourceAttr(dataSourceName, "kubeconfig", regexache.MustCompile(`- get-token`)),
+					resource.TestCheckResourceAttr(dataSourceName, "user_names.%", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, fmt.Sprintf("user_names.%s", rName), clusterResourceName, names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEKSKubeconfigDataSource_token(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_eks_kubeconfig.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubeconfigDataSourceConfig_token(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "auth_mode", "token"),
+					resource.TestMatchResourceAttr(dataSourceName, "kubeconfig", regexache.MustCompile(`token: k8s-aws-v1\.`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEKSKubeconfigDataSource_accessEntryUserNames(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_eks_kubeconfig.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubeconfigDataSourceConfig_accessEntryUserNames(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "user_names.%", "1"),
+					// The cluster creator's access entry uses the caller's ARN as its username.
+					resource.TestMatchResourceAttr(dataSourceName, fmt.Sprintf("user_names.%s", rName), regexache.MustCompile(`^arn:`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccKubeconfigDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccClusterConfig_basic(rName), `
+data "aws_eks_kubeconfig" "test" {
+  cluster_names = [aws_eks_cluster.test.name]
+}
+`)
+}
+
+func testAccKubeconfigDataSourceConfig_token(rName string) string {
+	return acctest.ConfigCompose(testAccClusterConfig_basic(rName), `
+data "aws_eks_kubeconfig" "test" {
+  cluster_names = [aws_eks_cluster.test.name]
+  auth_mode     = "token"
+}
+`)
+}
+
+func testAccKubeconfigDataSourceConfig_accessEntryUserNames(rName string) string {
+	return acctest.ConfigCompose(testAccClusterConfig_accessConfig(rName, types.AuthenticationModeApi), `
+data "aws_eks_kubeconfig" "test" {
+  cluster_names = [aws_eks_cluster.test.name]
+
+  include_access_entry_user_names = true
+}
+`)
+}
@@ -15,7 +15,12 @@ import (
 type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
-	return []*types.ServicePackageFrameworkDataSource{}
+	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory: newKubeconfigDataSource,
+			Name:    "Kubeconfig",
+		},
+	}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
@@ -26,6 +31,15 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "association_arn",
 			},
+			Identity: &types.ServicePackageResourceIdentity{
+				Attributes: []string{
+					names.AttrClusterName,
+					names.AttrAssociationID,
+				},
+				IDAttribute:  names.AttrAssociationID,
+				ARNAttribute: "association_arn",
+				ARNResource:  "podidentityassociation/{cluster_name}/{association_id}",
+			},
 		},
 	}
 }
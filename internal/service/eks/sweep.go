@@ -18,12 +18,12 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_eks_addon", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_eks_addon", &resource.Sweeper{
 		Name: "aws_eks_addon",
 		F:    sweepAddons,
 	})
 
-	resource.AddTestSweepers("aws_eks_cluster", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_eks_cluster", &resource.Sweeper{
 		Name: "aws_eks_cluster",
 		F:    sweepClusters,
 		Dependencies: []string{
@@ -35,17 +35,17 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_eks_fargate_profile", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_eks_fargate_profile", &resource.Sweeper{
 		Name: "aws_eks_fargate_profile",
 		F:    sweepFargateProfiles,
 	})
 
-	resource.AddTestSweepers("aws_eks_identity_provider_config", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_eks_identity_provider_config", &resource.Sweeper{
 		Name: "aws_eks_identity_provider_config",
 		F:    sweepIdentityProvidersConfig,
 	})
 
-	resource.AddTestSweepers("aws_eks_node_group", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_eks_node_group", &resource.Sweeper{
 		Name: "aws_eks_node_group",
 		F:    sweepNodeGroups,
 	})
@@ -42,6 +42,7 @@ const (
 
 // @SDKResource("aws_elasticache_cluster", name="Cluster")
 // @Tags(identifierAttribute="arn")
+// @Stateful
 func resourceCluster() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceClusterCreate,
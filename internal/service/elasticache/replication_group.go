@@ -44,6 +44,7 @@ const (
 
 // @SDKResource("aws_elasticache_replication_group", name="Replication Group")
 // @Tags(identifierAttribute="arn")
+// @Stateful
 func resourceReplicationGroup() *schema.Resource {
 	//lintignore:R011
 	return &schema.Resource{
@@ -78,6 +78,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Stateful: true,
 		},
 		{
 			Factory:  resourceGlobalReplicationGroup,
@@ -99,6 +100,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Stateful: true,
 		},
 		{
 			Factory:  resourceSubnetGroup,
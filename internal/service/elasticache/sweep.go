@@ -28,7 +28,7 @@ const (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_elasticache_cluster", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_elasticache_cluster", &resource.Sweeper{
 		Name: "aws_elasticache_cluster",
 		F:    sweepClusters,
 		Dependencies: []string{
@@ -36,12 +36,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_elasticache_global_replication_group", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_elasticache_global_replication_group", &resource.Sweeper{
 		Name: "aws_elasticache_global_replication_group",
 		F:    sweepGlobalReplicationGroups,
 	})
 
-	resource.AddTestSweepers("aws_elasticache_parameter_group", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_elasticache_parameter_group", &resource.Sweeper{
 		Name: "aws_elasticache_parameter_group",
 		F:    sweepParameterGroups,
 		Dependencies: []string{
@@ -50,7 +50,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_elasticache_replication_group", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_elasticache_replication_group", &resource.Sweeper{
 		Name: "aws_elasticache_replication_group",
 		F:    sweepReplicationGroups,
 		Dependencies: []string{
@@ -58,7 +58,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_elasticache_subnet_group", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_elasticache_subnet_group", &resource.Sweeper{
 		Name: "aws_elasticache_subnet_group",
 		F:    sweepSubnetGroups,
 		Dependencies: []string{
@@ -67,7 +67,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_elasticache_user", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_elasticache_user", &resource.Sweeper{
 		Name: "aws_elasticache_user",
 		F:    sweepUsers,
 		Dependencies: []string{
@@ -75,12 +75,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_elasticache_user_group", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_elasticache_user_group", &resource.Sweeper{
 		Name: "aws_elasticache_user_group",
 		F:    sweepUserGroups,
 	})
 
-	resource.AddTestSweepers("aws_elasticache_serverless_cache", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_elasticache_serverless_cache", &resource.Sweeper{
 		Name: "aws_elasticache_serverless_cache",
 		F:    sweepServerlessCaches,
 	})
@@ -15,13 +15,13 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_elastic_beanstalk_application", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_elastic_beanstalk_application", &resource.Sweeper{
 		Name:         "aws_elastic_beanstalk_application",
 		Dependencies: []string{"aws_elastic_beanstalk_environment"},
 		F:            sweepApplications,
 	})
 
-	resource.AddTestSweepers("aws_elastic_beanstalk_environment", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_elastic_beanstalk_environment", &resource.Sweeper{
 		Name: "aws_elastic_beanstalk_environment",
 		F:    sweepEnvironments,
 	})
@@ -37,6 +37,7 @@ import (
 
 // @SDKResource("aws_elasticsearch_domain", name="Domain")
 // @Tags(identifierAttribute="id")
+// @Stateful
 func resourceDomain() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceDomainCreate,
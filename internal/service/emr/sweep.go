@@ -17,12 +17,12 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_emr_cluster", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_emr_cluster", &resource.Sweeper{
 		Name: "aws_emr_cluster",
 		F:    sweepClusters,
 	})
 
-	resource.AddTestSweepers("aws_emr_studio", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_emr_studio", &resource.Sweeper{
 		Name: "aws_emr_studio",
 		F:    sweepStudios,
 	})
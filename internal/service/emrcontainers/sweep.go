@@ -16,12 +16,12 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_emrcontainers_virtual_cluster", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_emrcontainers_virtual_cluster", &resource.Sweeper{
 		Name: "aws_emrcontainers_virtual_cluster",
 		F:    sweepVirtualClusters,
 	})
 
-	resource.AddTestSweepers("aws_emrcontainers_job_template", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_emrcontainers_job_template", &resource.Sweeper{
 		Name: "aws_emrcontainers_job_template",
 		F:    sweepJobTemplates,
 	})
@@ -16,7 +16,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_cloudwatch_event_api_destination", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_cloudwatch_event_api_destination", &resource.Sweeper{
 		Name: "aws_cloudwatch_event_api_destination",
 		F:    sweepAPIDestination,
 		Dependencies: []string{
@@ -24,7 +24,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_event_archive", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_cloudwatch_event_archive", &resource.Sweeper{
 		Name: "aws_cloudwatch_event_archive",
 		F:    sweepArchives,
 		Dependencies: []string{
@@ -32,7 +32,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_event_bus", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_cloudwatch_event_bus", &resource.Sweeper{
 		Name: "aws_cloudwatch_event_bus",
 		F:    sweepBuses,
 		Dependencies: []string{
@@ -42,12 +42,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_event_connection", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_cloudwatch_event_connection", &resource.Sweeper{
 		Name: "aws_cloudwatch_event_connection",
 		F:    sweepConnection,
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_event_rule", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_cloudwatch_event_rule", &resource.Sweeper{
 		Name: "aws_cloudwatch_event_rule",
 		F:    sweepRules,
 		Dependencies: []string{
@@ -55,7 +55,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_event_target", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_cloudwatch_event_target", &resource.Sweeper{
 		Name: "aws_cloudwatch_event_target",
 		F:    sweepTargets,
 	})
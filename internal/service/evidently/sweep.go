@@ -16,11 +16,11 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_evidently_project", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_evidently_project", &resource.Sweeper{
 		Name: "aws_evidently_project",
 		F:    sweepProjects,
 	})
-	resource.AddTestSweepers("aws_evidently_segment", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_evidently_segment", &resource.Sweeper{
 		Name: "aws_evidently_segment",
 		F:    sweepSegments,
 	})
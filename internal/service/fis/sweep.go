@@ -15,7 +15,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_fis_experiment_template", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_fis_experiment_template", &resource.Sweeper{
 		Name: "aws_fis_experiment_template",
 		F:    sweepExperimentTemplates,
 	})
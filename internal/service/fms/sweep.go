@@ -27,7 +27,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_fms_admin_account", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_fms_admin_account", &resource.Sweeper{
 		Name: "aws_fms_admin_account",
 		F:    sweepAdminAccount,
 	})
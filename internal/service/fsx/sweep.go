@@ -16,12 +16,12 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_fsx_backup", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_fsx_backup", &resource.Sweeper{
 		Name: "aws_fsx_backup",
 		F:    sweepBackups,
 	})
 
-	resource.AddTestSweepers("aws_fsx_lustre_file_system", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_fsx_lustre_file_system", &resource.Sweeper{
 		Name: "aws_fsx_lustre_file_system",
 		F:    sweepLustreFileSystems,
 		Dependencies: []string{
@@ -30,7 +30,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_fsx_ontap_file_system", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_fsx_ontap_file_system", &resource.Sweeper{
 		Name: "aws_fsx_ontap_file_system",
 		F:    sweepONTAPFileSystems,
 		Dependencies: []string{
@@ -40,7 +40,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_fsx_ontap_storage_virtual_machine", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_fsx_ontap_storage_virtual_machine", &resource.Sweeper{
 		Name: "aws_fsx_ontap_storage_virtual_machine",
 		F:    sweepONTAPStorageVirtualMachine,
 		Dependencies: []string{
@@ -48,12 +48,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_fsx_ontap_volume", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_fsx_ontap_volume", &resource.Sweeper{
 		Name: "aws_fsx_ontap_volume",
 		F:    sweepONTAPVolumes,
 	})
 
-	resource.AddTestSweepers("aws_fsx_openzfs_file_system", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_fsx_openzfs_file_system", &resource.Sweeper{
 		Name: "aws_fsx_openzfs_file_system",
 		F:    sweepOpenZFSFileSystems,
 		Dependencies: []string{
@@ -63,12 +63,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_fsx_openzfs_volume", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_fsx_openzfs_volume", &resource.Sweeper{
 		Name: "aws_fsx_openzfs_volume",
 		F:    sweepOpenZFSVolume,
 	})
 
-	resource.AddTestSweepers("aws_fsx_windows_file_system", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_fsx_windows_file_system", &resource.Sweeper{
 		Name: "aws_fsx_windows_file_system",
 		F:    sweepWindowsFileSystems,
 		Dependencies: []string{
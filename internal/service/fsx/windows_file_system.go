@@ -32,6 +32,7 @@ import (
 
 // @SDKResource("aws_fsx_windows_file_system", name="Windows File System")
 // @Tags(identifierAttribute="arn")
+// @Stateful
 func resourceWindowsFileSystem() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceWindowsFileSystemCreate,
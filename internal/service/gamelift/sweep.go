@@ -15,7 +15,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_gamelift_alias", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_gamelift_alias", &resource.Sweeper{
 		Name: "aws_gamelift_alias",
 		Dependencies: []string{
 			"aws_gamelift_fleet",
@@ -23,17 +23,17 @@ func RegisterSweepers() {
 		F: sweepAliases,
 	})
 
-	resource.AddTestSweepers("aws_gamelift_build", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_gamelift_build", &resource.Sweeper{
 		Name: "aws_gamelift_build",
 		F:    sweepBuilds,
 	})
 
-	resource.AddTestSweepers("aws_gamelift_script", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_gamelift_script", &resource.Sweeper{
 		Name: "aws_gamelift_script",
 		F:    sweepScripts,
 	})
 
-	resource.AddTestSweepers("aws_gamelift_fleet", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_gamelift_fleet", &resource.Sweeper{
 		Name: "aws_gamelift_fleet",
 		Dependencies: []string{
 			"aws_gamelift_build",
@@ -41,12 +41,12 @@ func RegisterSweepers() {
 		F: sweepFleets,
 	})
 
-	resource.AddTestSweepers("aws_gamelift_game_server_group", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_gamelift_game_server_group", &resource.Sweeper{
 		Name: "aws_gamelift_game_server_group",
 		F:    sweepGameServerGroups,
 	})
 
-	resource.AddTestSweepers("aws_gamelift_game_session_queue", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_gamelift_game_session_queue", &resource.Sweeper{
 		Name: "aws_gamelift_game_session_queue",
 		F:    sweepGameSessionQueue,
 	})
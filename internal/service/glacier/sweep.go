@@ -15,7 +15,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_glacier_vault", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_glacier_vault", &resource.Sweeper{
 		Name: "aws_glacier_vault",
 		F:    sweepVaults,
 	})
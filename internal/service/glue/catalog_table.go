@@ -100,11 +100,14 @@ func ResourceCatalogTable() *schema.Resource {
 					},
 				},
 			},
+			"derived_columns":        catalogTableDerivedColumnsSchema(),
+			"derived_partition_keys": catalogTableDerivedColumnsSchema(),
 			"retention": {
 				Type:         schema.TypeInt,
 				Optional:     true,
 				ValidateFunc: validation.IntAtLeast(0),
 			},
+			"schema_source": catalogTableSchemaSourceSchema(),
 			"storage_descriptor": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -393,6 +396,8 @@ func ResourceCatalogTable() *schema.Resource {
 				},
 			},
 		},
+
+		CustomizeDiff: resourceCatalogTableCustomizeDiff,
 	}
 }
 
@@ -469,8 +474,24 @@ func resourceCatalogTableRead(ctx context.Context, d *schema.ResourceData, meta
 		return sdkdiag.AppendErrorf(diags, "setting storage_descriptor: %s", err)
 	}
 
-	if err := d.Set("partition_keys", flattenColumns(table.PartitionKeys)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting partition_keys: %s", err)
+	// Columns and partition keys derived from a schema source are tracked separately from any configured ones.
+	if v, ok := d.GetOk("schema_source"); ok && len(v.([]interface{})) > 0 {
+		var columns []awstypes.Column
+		if table.StorageDescriptor != nil {
+			columns = table.StorageDescriptor.Columns
+		}
+		if err := d.Set("derived_columns", flattenCatalogTableDerivedColumns(columns)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting derived_columns: %s", err)
+		}
+		if err := d.Set("derived_partition_keys", flattenCatalogTableDerivedColumns(table.PartitionKeys)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting derived_partition_keys: %s", err)
+		}
+	} else {
+		if err := d.Set("partition_keys", flattenColumns(table.PartitionKeys)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting partition_keys: %s", err)
+		}
+		d.Set("derived_columns", nil)
+		d.Set("derived_partition_keys", nil)
 	}
 
 	d.Set("view_original_text", table.ViewOriginalText)
@@ -633,6 +654,14 @@ func expandTableInput(d *schema.ResourceData) *awstypes.TableInput {
 		tableInput.PartitionKeys = []awstypes.Column{}
 	}
 
+	if v, ok := d.GetOk("schema_source"); ok && len(v.([]interface{})) > 0 {
+		if tableInput.StorageDescriptor == nil {
+			tableInput.StorageDescriptor = &awstypes.StorageDescriptor{}
+		}
+		tableInput.StorageDescriptor.Columns = expandColumns(d.Get("derived_columns").([]interface{}))
+		tableInput.PartitionKeys = expandColumns(d.Get("derived_partition_keys").([]interface{}))
+	}
+
 	if v, ok := d.GetOk("view_original_text"); ok {
 		tableInput.ViewOriginalText = aws.String(v.(string))
 	}
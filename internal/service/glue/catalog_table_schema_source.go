@@ -0,0 +1,839 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package glue
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/glue"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/glue/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+var catalogTableSchemaSourceKeys = []string{
+	"schema_source.0.avro_schema",
+	"schema_source.0.json_schema",
+	"schema_source.0.parquet_file",
+	"schema_source.0.schema_registry",
+}
+
+func catalogTableSchemaSourceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		RequiredWith:  []string{"storage_descriptor"},
+		ConflictsWith: []string{"partition_keys", "storage_descriptor.0.columns", "storage_descriptor.0.schema_reference"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"avro_schema": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsJSON,
+					ExactlyOneOf: catalogTableSchemaSourceKeys,
+				},
+				"json_schema": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsJSON,
+					ExactlyOneOf: catalogTableSchemaSourceKeys,
+				},
+				"parquet_file": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					ExactlyOneOf: catalogTableSchemaSourceKeys,
+				},
+				"partition_keys": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringLenBetween(1, 255),
+					},
+				},
+				"schema_registry": {
+					Type:         schema.TypeList,
+					Optional:     true,
+					MaxItems:     1,
+					ExactlyOneOf: catalogTableSchemaSourceKeys,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"schema_arn": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: verify.ValidARN,
+							},
+							"schema_version_number": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntBetween(1, 100000),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func catalogTableDerivedColumnsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				names.AttrComment: {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				names.AttrName: {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				names.AttrType: {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+// resourceCatalogTableCustomizeDiff derives the table's columns and partition keys from any configured schema source.
+// Deriving them during planning means that schema evolution is reported as column-level differences.
+func resourceCatalogTableCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	tfList := d.Get("schema_source").([]interface{})
+
+	if len(tfList) == 0 || tfList[0] == nil {
+		for _, key := range []string{"derived_columns", "derived_partition_keys"} {
+			if len(d.Get(key).([]interface{})) > 0 {
+				if err := d.SetNew(key, []interface{}{}); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	}
+
+	for _, key := range append(catalogTableSchemaSourceKeys, "schema_source.0.partition_keys") {
+		if !d.NewValueKnown(key) {
+			if err := d.SetNewComputed("derived_columns"); err != nil {
+				return err
+			}
+
+			return d.SetNewComputed("derived_partition_keys")
+		}
+	}
+
+	columns, partitionKeys, err := deriveCatalogTableColumns(ctx, meta, tfList[0].(map[string]interface{}))
+
+	if err != nil {
+		return fmt.Errorf("schema_source: %w", err)
+	}
+
+	if err := d.SetNew("derived_columns", flattenCatalogTableDerivedColumns(columns)); err != nil {
+		return err
+	}
+
+	return d.SetNew("derived_partition_keys", flattenCatalogTableDerivedColumns(partitionKeys))
+}
+
+func deriveCatalogTableColumns(ctx context.Context, meta interface{}, tfMap map[string]interface{}) ([]awstypes.Column, []awstypes.Column, error) {
+	var columns []awstypes.Column
+	var err error
+
+	if v, ok := tfMap["avro_schema"].(string); ok && v != "" {
+		columns, err = avroSchemaColumns([]byte(v))
+	} else if v, ok := tfMap["json_schema"].(string); ok && v != "" {
+		columns, err = jsonSchemaColumns([]byte(v))
+	} else if v, ok := tfMap["parquet_file"].(string); ok && v != "" {
+		columns, err = parquetFileColumns(v)
+	} else if v, ok := tfMap["schema_registry"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		columns, err = schemaRegistryColumns(ctx, meta.(*conns.AWSClient).GlueClient(ctx), v[0].(map[string]interface{}))
+	} else {
+		return nil, nil, errors.New("no schema source configured")
+	}
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var partitionKeys []awstypes.Column
+
+	for _, name := range flex.ExpandStringValueList(tfMap["partition_keys"].([]interface{})) {
+		i := slices.IndexFunc(columns, func(v awstypes.Column) bool {
+			return aws.ToString(v.Name) == name
+		})
+
+		if i == -1 {
+			return nil, nil, fmt.Errorf("partition key %q is not a top-level field of the schema", name)
+		}
+
+		partitionKeys = append(partitionKeys, columns[i])
+		columns = slices.Delete(columns, i, i+1)
+	}
+
+	return columns, partitionKeys, nil
+}
+
+func schemaRegistryColumns(ctx context.Context, conn *glue.Client, tfMap map[string]interface{}) ([]awstypes.Column, error) {
+	schemaARN := tfMap["schema_arn"].(string)
+	input := &glue.GetSchemaVersionInput{
+		SchemaId: createSchemaID(schemaARN),
+		SchemaVersionNumber: &awstypes.SchemaVersionNumber{
+			LatestVersion: true,
+		},
+	}
+
+	if v, ok := tfMap["schema_version_number"].(int); ok && v > 0 {
+		input.SchemaVersionNumber = &awstypes.SchemaVersionNumber{
+			VersionNumber: aws.Int64(int64(v)),
+		}
+	}
+
+	output, err := conn.GetSchemaVersion(ctx, input)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading Glue Schema (%s) version: %w", schemaARN, err)
+	}
+
+	definition := []byte(aws.ToString(output.SchemaDefinition))
+
+	switch output.DataFormat {
+	case awstypes.DataFormatAvro:
+		return avroSchemaColumns(definition)
+	case awstypes.DataFormatJson:
+		return jsonSchemaColumns(definition)
+	default:
+		return nil, fmt.Errorf("data format %s of Glue Schema (%s) is not supported", output.DataFormat, schemaARN)
+	}
+}
+
+func flattenCatalogTableDerivedColumns(apiObjects []awstypes.Column) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrComment: aws.ToString(apiObject.Comment),
+			names.AttrName:    aws.ToString(apiObject.Name),
+			names.AttrType:    aws.ToString(apiObject.Type),
+		})
+	}
+
+	return tfList
+}
+
+func newDerivedColumn(name, typ, comment string) awstypes.Column {
+	column := awstypes.Column{
+		Name: aws.String(name),
+		Type: aws.String(typ),
+	}
+
+	if comment != "" {
+		column.Comment = aws.String(comment)
+	}
+
+	return column
+}
+
+// avroSchemaColumns maps the fields of a top-level Avro record schema to Glue columns.
+func avroSchemaColumns(definition []byte) ([]awstypes.Column, error) {
+	var v any
+
+	if err := json.Unmarshal(definition, &v); err != nil {
+		return nil, fmt.Errorf("parsing Avro schema: %w", err)
+	}
+
+	record, ok := v.(map[string]any)
+	if !ok || (record[names.AttrType] != "record" && record[names.AttrType] != "error") {
+		return nil, errors.New("schema must be an Avro record")
+	}
+
+	c := &avroTypeMapper{
+		named:      make(map[string]any),
+		inProgress: make(map[string]bool),
+	}
+
+	fields, err := c.recordFields(record, "")
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid Avro schema: %w", err)
+	}
+
+	columns := make([]awstypes.Column, 0, len(fields))
+	for _, field := range fields {
+		columns = append(columns, newDerivedColumn(field.name, field.typ, field.comment))
+	}
+
+	return columns, nil
+}
+
+type derivedField struct {
+	name    string
+	typ     string
+	comment string
+}
+
+type avroTypeMapper struct {
+	named      map[string]any
+	inProgress map[string]bool
+}
+
+func avroFullName(name, namespace string) string {
+	if strings.Contains(name, ".") || namespace == "" {
+		return name
+	}
+
+	return namespace + "." + name
+}
+
+// register records a named type (record, enum or fixed) and returns its full name and namespace.
+func (c *avroTypeMapper) register(v map[string]any, namespace string) (string, string, error) {
+	name, _ := v[names.AttrName].(string)
+
+	if name == "" {
+		return "", "", fmt.Errorf("named type %q has no name", v[names.AttrType])
+	}
+
+	if ns, ok := v["namespace"].(string); ok {
+		namespace = ns
+	}
+
+	fullName := avroFullName(name, namespace)
+	if i := strings.LastIndex(fullName, "."); i >= 0 {
+		namespace = fullName[:i]
+	}
+
+	c.named[fullName] = v
+
+	return fullName, namespace, nil
+}
+
+func (c *avroTypeMapper) recordFields(v map[string]any, namespace string) ([]derivedField, error) {
+	fullName, namespace, err := c.register(v, namespace)
+
+	if err != nil {
+		return nil, err
+	}
+
+	c.inProgress[fullName] = true
+	defer delete(c.inProgress, fullName)
+
+	fieldsRaw, ok := v["fields"].([]any)
+	if !ok {
+		return nil, fmt.Errorf("record %q has no fields", fullName)
+	}
+
+	fields := make([]derivedField, 0, len(fieldsRaw))
+	for _, fieldRaw := range fieldsRaw {
+		field, ok := fieldRaw.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("record %q has an invalid field", fullName)
+		}
+
+		name, _ := field[names.AttrName].(string)
+		typ, err := c.glueType(field[names.AttrType], namespace)
+
+		if err != nil {
+			return nil, fmt.Errorf("field %q: %w", name, err)
+		}
+
+		doc, _ := field["doc"].(string)
+		fields = append(fields, derivedField{name: name, typ: typ, comment: doc})
+	}
+
+	return fields, nil
+}
+
+func (c *avroTypeMapper) glueType(v any, namespace string) (string, error) {
+	switch v := v.(type) {
+	case string:
+		switch v {
+		case "boolean":
+			return "boolean", nil
+		case "int":
+			return "int", nil
+		case "long":
+			return "bigint", nil
+		case "float":
+			return "float", nil
+		case "double":
+			return "double", nil
+		case "bytes":
+			return "binary", nil
+		case "string":
+			return "string", nil
+		case "null":
+			return "", errors.New(`type "null" has no Glue equivalent`)
+		}
+
+		fullName := avroFullName(v, namespace)
+		definition, ok := c.named[fullName]
+		if !ok {
+			if definition, ok = c.named[v]; !ok {
+				return "", fmt.Errorf("undefined type %q", v)
+			}
+			fullName = v
+		}
+
+		if c.inProgress[fullName] {
+			return "", fmt.Errorf("recursive type %q is not supported", fullName)
+		}
+
+		// Re-registration of a referenced named type is harmless.
+		return c.glueType(definition, namespace)
+
+	case []any:
+		types := slices.DeleteFunc(slices.Clone(v), func(v any) bool {
+			return v == "null"
+		})
+
+		if len(types) != 1 {
+			return "", errors.New("unions of more than one non-null type are not supported")
+		}
+
+		return c.glueType(types[0], namespace)
+
+	case map[string]any:
+		switch logicalType, _ := v["logicalType"].(string); logicalType {
+		case "decimal":
+			precision, _ := v["precision"].(float64)
+			scale, _ := v["scale"].(float64)
+
+			if precision < 1 {
+				return "", errors.New("decimal logical type requires a precision")
+			}
+
+			return fmt.Sprintf("decimal(%d,%d)", int(precision), int(scale)), nil
+		case "date":
+			return "date", nil
+		case "timestamp-millis", "timestamp-micros", "local-timestamp-millis", "local-timestamp-micros":
+			return "timestamp", nil
+		case "uuid":
+			return "string", nil
+		}
+
+		switch typ := v[names.AttrType]; typ {
+		case "record", "error":
+			fields, err := c.recordFields(v, namespace)
+
+			if err != nil {
+				return "", err
+			}
+
+			return glueStructType(fields), nil
+		case "enum":
+			if _, _, err := c.register(v, namespace); err != nil {
+				return "", err
+			}
+
+			return "string", nil
+		case "fixed":
+			if _, _, err := c.register(v, namespace); err != nil {
+				return "", err
+			}
+
+			return "binary", nil
+		case "array":
+			items, err := c.glueType(v["items"], namespace)
+
+			if err != nil {
+				return "", err
+			}
+
+			return fmt.Sprintf("array<%s>", items), nil
+		case "map":
+			values, err := c.glueType(v[names.AttrValues], namespace)
+
+			if err != nil {
+				return "", err
+			}
+
+			return fmt.Sprintf("map<string,%s>", values), nil
+		default:
+			return c.glueType(typ, namespace)
+		}
+	}
+
+	return "", fmt.Errorf("invalid type %v", v)
+}
+
+func glueStructType(fields []derivedField) string {
+	parts := make([]string, 0, len(fields))
+
+	for _, field := range fields {
+		parts = append(parts, field.name+":"+field.typ)
+	}
+
+	return fmt.Sprintf("struct<%s>", strings.Join(parts, ","))
+}
+
+// orderedJSONObject is a decoded JSON object that preserves its key order.
+type orderedJSONObject struct {
+	keys   []string
+	values map[string]any
+}
+
+func (o *orderedJSONObject) get(key string) (any, bool) {
+	v, ok := o.values[key]
+	return v, ok
+}
+
+func decodeOrderedJSON(data []byte) (any, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	v, err := decodeOrderedJSONValue(dec)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, errors.New("unexpected data after top-level value")
+	}
+
+	return v, nil
+}
+
+func decodeOrderedJSONValue(dec *json.Decoder) (any, error) {
+	token, err := dec.Token()
+
+	if err != nil {
+		return nil, err
+	}
+
+	switch token {
+	case json.Delim('{'):
+		obj := &orderedJSONObject{values: make(map[string]any)}
+
+		for dec.More() {
+			token, err := dec.Token()
+
+			if err != nil {
+				return nil, err
+			}
+
+			key := token.(string)
+			value, err := decodeOrderedJSONValue(dec)
+
+			if err != nil {
+				return nil, err
+			}
+
+			if _, ok := obj.values[key]; !ok {
+				obj.keys = append(obj.keys, key)
+			}
+			obj.values[key] = value
+		}
+
+		_, err := dec.Token()
+
+		return obj, err
+	case json.Delim('['):
+		var list []any
+
+		for dec.More() {
+			value, err := decodeOrderedJSONValue(dec)
+
+			if err != nil {
+				return nil, err
+			}
+
+			list = append(list, value)
+		}
+
+		_, err := dec.Token()
+
+		return list, err
+	default:
+		return token, nil
+	}
+}
+
+// jsonSchemaColumns maps the properties of a top-level JSON Schema object to Glue columns.
+func jsonSchemaColumns(definition []byte) ([]awstypes.Column, error) {
+	root, err := decodeOrderedJSON(definition)
+
+	if err != nil {
+		return nil, fmt.Errorf("parsing JSON Schema: %w", err)
+	}
+
+	c := &jsonSchemaTypeMapper{
+		root:       root,
+		inProgress: make(map[string]bool),
+	}
+
+	obj, release, err := c.resolve(root)
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid JSON Schema: %w", err)
+	}
+	defer release()
+
+	if obj == nil || jsonSchemaTypeName(obj) != "object" {
+		return nil, errors.New("schema must describe a JSON object")
+	}
+
+	fields, err := c.properties(obj)
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid JSON Schema: %w", err)
+	}
+
+	if len(fields) == 0 {
+		return nil, errors.New("schema must describe a JSON object with properties")
+	}
+
+	columns := make([]awstypes.Column, 0, len(fields))
+	for _, field := range fields {
+		columns = append(columns, newDerivedColumn(field.name, field.typ, field.comment))
+	}
+
+	return columns, nil
+}
+
+type jsonSchemaTypeMapper struct {
+	root       any
+	inProgress map[string]bool
+}
+
+// resolve follows any local "$ref" and strips nullable alternatives.
+// References followed are marked as in progress until the returned release function is called.
+func (c *jsonSchemaTypeMapper) resolve(v any) (*orderedJSONObject, func(), error) {
+	var refs []string
+	release := func() {
+		for _, ref := range refs {
+			delete(c.inProgress, ref)
+		}
+	}
+
+	obj, err := c.resolveRefs(v, &refs)
+
+	if err != nil {
+		release()
+		return nil, nil, err
+	}
+
+	return obj, release, nil
+}
+
+func (c *jsonSchemaTypeMapper) resolveRefs(v any, refs *[]string) (*orderedJSONObject, error) {
+	obj, ok := v.(*orderedJSONObject)
+	if !ok {
+		return nil, errors.New("schema must be an object")
+	}
+
+	if ref, ok := obj.get("$ref"); ok {
+		ref, _ := ref.(string)
+
+		if c.inProgress[ref] {
+			return nil, fmt.Errorf("recursive reference %q is not supported", ref)
+		}
+
+		target, err := c.lookup(ref)
+
+		if err != nil {
+			return nil, err
+		}
+
+		c.inProgress[ref] = true
+		*refs = append(*refs, ref)
+
+		return c.resolveRefs(target, refs)
+	}
+
+	for _, key := range []string{"anyOf", "oneOf"} {
+		if alternatives, ok := obj.get(key); ok {
+			alternatives, _ := alternatives.([]any)
+			alternatives = slices.DeleteFunc(slices.Clone(alternatives), func(v any) bool {
+				alternative, ok := v.(*orderedJSONObject)
+				return ok && jsonSchemaTypeName(alternative) == "null"
+			})
+
+			if len(alternatives) != 1 {
+				return nil, fmt.Errorf("%s with more than one non-null alternative is not supported", key)
+			}
+
+			return c.resolveRefs(alternatives[0], refs)
+		}
+	}
+
+	return obj, nil
+}
+
+// typeOf returns the resolved schema and Glue type of the specified schema.
+func (c *jsonSchemaTypeMapper) typeOf(v any) (*orderedJSONObject, string, error) {
+	obj, release, err := c.resolve(v)
+
+	if err != nil {
+		return nil, "", err
+	}
+	defer release()
+
+	typ, err := c.glueType(obj)
+
+	if err != nil {
+		return nil, "", err
+	}
+
+	return obj, typ, nil
+}
+
+func (c *jsonSchemaTypeMapper) lookup(ref string) (any, error) {
+	path, ok := strings.CutPrefix(ref, "#/")
+	if !ok {
+		return nil, fmt.Errorf("only local references are supported, found %q", ref)
+	}
+
+	v := c.root
+	for _, part := range strings.Split(path, "/") {
+		part = strings.ReplaceAll(strings.ReplaceAll(part, "~1", "/"), "~0", "~")
+
+		obj, ok := v.(*orderedJSONObject)
+		if !ok {
+			return nil, fmt.Errorf("reference %q not found", ref)
+		}
+
+		if v, ok = obj.get(part); !ok {
+			return nil, fmt.Errorf("reference %q not found", ref)
+		}
+	}
+
+	return v, nil
+}
+
+// jsonSchemaTypeName returns the single non-null type of a schema.
+func jsonSchemaTypeName(obj *orderedJSONObject) string {
+	switch v, _ := obj.get(names.AttrType); v := v.(type) {
+	case string:
+		return v
+	case []any:
+		var types []string
+
+		for _, v := range v {
+			if v, ok := v.(string); ok && v != "null" {
+				types = append(types, v)
+			}
+		}
+
+		if len(types) == 1 {
+			return types[0]
+		}
+
+		if len(types) == 0 && slices.Contains(v, any("null")) {
+			return "null"
+		}
+	}
+
+	if _, ok := obj.get("enum"); ok {
+		return "string"
+	}
+
+	if _, ok := obj.get("properties"); ok {
+		return "object"
+	}
+
+	return ""
+}
+
+func (c *jsonSchemaTypeMapper) properties(obj *orderedJSONObject) ([]derivedField, error) {
+	v, _ := obj.get("properties")
+	properties, _ := v.(*orderedJSONObject)
+
+	if properties == nil {
+		return nil, nil
+	}
+
+	fields := make([]derivedField, 0, len(properties.keys))
+	for _, name := range properties.keys {
+		property, typ, err := c.typeOf(properties.values[name])
+
+		if err != nil {
+			return nil, fmt.Errorf("property %q: %w", name, err)
+		}
+
+		description, _ := property.get(names.AttrDescription)
+		comment, _ := description.(string)
+		fields = append(fields, derivedField{name: name, typ: typ, comment: comment})
+	}
+
+	return fields, nil
+}
+
+func (c *jsonSchemaTypeMapper) glueType(obj *orderedJSONObject) (string, error) {
+	switch typ := jsonSchemaTypeName(obj); typ {
+	case "string":
+		switch format, _ := obj.get("format"); format {
+		case "date":
+			return "date", nil
+		case "date-time":
+			return "timestamp", nil
+		default:
+			return "string", nil
+		}
+	case "integer":
+		return "bigint", nil
+	case "number":
+		return "double", nil
+	case "boolean":
+		return "boolean", nil
+	case "array":
+		v, ok := obj.get("items")
+		if !ok {
+			return "", errors.New("array has no items schema")
+		}
+
+		_, itemsType, err := c.typeOf(v)
+
+		if err != nil {
+			return "", err
+		}
+
+		return fmt.Sprintf("array<%s>", itemsType), nil
+	case "object":
+		fields, err := c.properties(obj)
+
+		if err != nil {
+			return "", err
+		}
+
+		if len(fields) > 0 {
+			return glueStructType(fields), nil
+		}
+
+		if v, ok := obj.get("additionalProperties"); ok {
+			if _, ok := v.(*orderedJSONObject); ok {
+				_, valuesType, err := c.typeOf(v)
+
+				if err != nil {
+					return "", err
+				}
+
+				return fmt.Sprintf("map<string,%s>", valuesType), nil
+			}
+		}
+
+		return "", errors.New("object has no properties or additionalProperties schema")
+	case "":
+		return "", errors.New("schema has no type")
+	default:
+		return "", fmt.Errorf("type %q has no Glue equivalent", typ)
+	}
+}
@@ -0,0 +1,743 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package glue
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/glue/types"
+)
+
+// Parquet file metadata is a Thrift compact protocol encoded FileMetaData structure stored in the file footer.
+// See https://github.com/apache/parquet-format/blob/master/src/main/thrift/parquet.thrift.
+// Only the schema is decoded; everything else is skipped.
+
+const (
+	parquetMagic = "PAR1"
+)
+
+// Parquet physical types.
+const (
+	parquetTypeBoolean           = 0
+	parquetTypeInt32             = 1
+	parquetTypeInt64             = 2
+	parquetTypeInt96             = 3
+	parquetTypeFloat             = 4
+	parquetTypeDouble            = 5
+	parquetTypeByteArray         = 6
+	parquetTypeFixedLenByteArray = 7
+)
+
+// Parquet field repetition types.
+const (
+	parquetRepetitionRepeated = 2
+)
+
+// Parquet (legacy) converted types.
+const (
+	parquetConvertedTypeUTF8            = 0
+	parquetConvertedTypeMap             = 1
+	parquetConvertedTypeMapKeyValue     = 2
+	parquetConvertedTypeList            = 3
+	parquetConvertedTypeEnum            = 4
+	parquetConvertedTypeDecimal         = 5
+	parquetConvertedTypeDate            = 6
+	parquetConvertedTypeTimeMillis      = 7
+	parquetConvertedTypeTimeMicros      = 8
+	parquetConvertedTypeTimestampMillis = 9
+	parquetConvertedTypeTimestampMicros = 10
+	parquetConvertedTypeUint8           = 11
+	parquetConvertedTypeUint16          = 12
+	parquetConvertedTypeUint32          = 13
+	parquetConvertedTypeUint64          = 14
+	parquetConvertedTypeInt8            = 15
+	parquetConvertedTypeInt16           = 16
+	parquetConvertedTypeInt32           = 17
+	parquetConvertedTypeInt64           = 18
+	parquetConvertedTypeJSON            = 19
+)
+
+// Parquet logical types (the LogicalType union's field IDs).
+const (
+	parquetLogicalTypeString    = 1
+	parquetLogicalTypeMap       = 2
+	parquetLogicalTypeList      = 3
+	parquetLogicalTypeEnum      = 4
+	parquetLogicalTypeDecimal   = 5
+	parquetLogicalTypeDate      = 6
+	parquetLogicalTypeTimestamp = 8
+	parquetLogicalTypeInteger   = 10
+	parquetLogicalTypeJSON      = 12
+	parquetLogicalTypeUUID      = 14
+)
+
+type parquetSchemaElement struct {
+	name          string
+	physicalType  int32
+	hasType       bool
+	repetition    int32
+	numChildren   int32
+	convertedType int32
+	hasConverted  bool
+	logicalType   int16
+	scale         int32
+	precision     int32
+	intBitWidth   int8
+	intSigned     bool
+	children      []*parquetSchemaElement
+}
+
+// parquetFileColumns reads the schema from a local Parquet file's footer and maps its top-level fields to Glue columns.
+func parquetFileColumns(path string) ([]awstypes.Column, error) {
+	f, err := os.Open(path)
+
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	fi, err := f.Stat()
+
+	if err != nil {
+		return nil, err
+	}
+
+	size := fi.Size()
+	if size < int64(2*len(parquetMagic)+4) {
+		return nil, fmt.Errorf("%s is not a Parquet file", path)
+	}
+
+	header := make([]byte, len(parquetMagic))
+	if _, err := f.ReadAt(header, 0); err != nil {
+		return nil, err
+	}
+
+	footer := make([]byte, 4+len(parquetMagic))
+	if _, err := f.ReadAt(footer, size-int64(len(footer))); err != nil {
+		return nil, err
+	}
+
+	if string(header) != parquetMagic || string(footer[4:]) != parquetMagic {
+		return nil, fmt.Errorf("%s is not a Parquet file", path)
+	}
+
+	metadataLength := int64(binary.LittleEndian.Uint32(footer[:4]))
+	if metadataLength <= 0 || metadataLength > size-int64(len(header)+len(footer)) {
+		return nil, fmt.Errorf("%s has an invalid Parquet footer", path)
+	}
+
+	metadata := make([]byte, metadataLength)
+	if _, err := f.ReadAt(metadata, size-int64(len(footer))-metadataLength); err != nil {
+		return nil, err
+	}
+
+	columns, err := parquetMetadataColumns(metadata)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading Parquet file (%s): %w", path, err)
+	}
+
+	return columns, nil
+}
+
+func parquetMetadataColumns(metadata []byte) ([]awstypes.Column, error) {
+	elements, err := decodeParquetSchema(metadata)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(elements) == 0 {
+		return nil, errors.New("file metadata contains no schema")
+	}
+
+	root, rest, err := buildParquetSchemaTree(elements)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rest) > 0 {
+		return nil, errors.New("schema contains elements outside the root")
+	}
+
+	columns := make([]awstypes.Column, 0, len(root.children))
+	for _, child := range root.children {
+		typ, err := child.glueFieldType()
+
+		if err != nil {
+			return nil, fmt.Errorf("field %q: %w", child.name, err)
+		}
+
+		columns = append(columns, newDerivedColumn(child.name, typ, ""))
+	}
+
+	return columns, nil
+}
+
+func buildParquetSchemaTree(elements []*parquetSchemaElement) (*parquetSchemaElement, []*parquetSchemaElement, error) {
+	if len(elements) == 0 {
+		return nil, nil, errors.New("schema ended unexpectedly")
+	}
+
+	node, rest := elements[0], elements[1:]
+
+	for range node.numChildren {
+		var child *parquetSchemaElement
+		var err error
+
+		if child, rest, err = buildParquetSchemaTree(rest); err != nil {
+			return nil, nil, err
+		}
+
+		node.children = append(node.children, child)
+	}
+
+	return node, rest, nil
+}
+
+func (e *parquetSchemaElement) isGroup() bool {
+	return !e.hasType || e.numChildren > 0
+}
+
+func (e *parquetSchemaElement) annotatedAs(converted int32, logical int16) bool {
+	return (e.logicalType != 0 && e.logicalType == logical) || (e.hasConverted && e.convertedType == converted)
+}
+
+// glueFieldType returns the Glue type of a field, including any repetition.
+func (e *parquetSchemaElement) glueFieldType() (string, error) {
+	typ, err := e.glueType()
+
+	if err != nil {
+		return "", err
+	}
+
+	if e.repetition == parquetRepetitionRepeated {
+		return fmt.Sprintf("array<%s>", typ), nil
+	}
+
+	return typ, nil
+}
+
+// glueType returns the Glue type of a field, ignoring its repetition.
+func (e *parquetSchemaElement) glueType() (string, error) {
+	if e.isGroup() {
+		switch {
+		case e.annotatedAs(parquetConvertedTypeList, parquetLogicalTypeList):
+			if len(e.children) != 1 || e.children[0].repetition != parquetRepetitionRepeated {
+				return "", errors.New("LIST must contain a single repeated field")
+			}
+
+			repeated := e.children[0]
+
+			// See the backward-compatibility rules in the Parquet LogicalTypes documentation.
+			if repeated.isGroup() && len(repeated.children) == 1 && repeated.name != "array" && repeated.name != e.name+"_tuple" {
+				element, err := repeated.children[0].glueFieldType()
+
+				if err != nil {
+					return "", err
+				}
+
+				return fmt.Sprintf("array<%s>", element), nil
+			}
+
+			element, err := repeated.glueType()
+
+			if err != nil {
+				return "", err
+			}
+
+			return fmt.Sprintf("array<%s>", element), nil
+
+		case e.annotatedAs(parquetConvertedTypeMap, parquetLogicalTypeMap), e.hasConverted && e.convertedType == parquetConvertedTypeMapKeyValue:
+			if len(e.children) != 1 || len(e.children[0].children) != 2 {
+				return "", errors.New("MAP must contain a single repeated key_value group with key and value fields")
+			}
+
+			key, err := e.children[0].children[0].glueFieldType()
+
+			if err != nil {
+				return "", err
+			}
+
+			value, err := e.children[0].children[1].glueFieldType()
+
+			if err != nil {
+				return "", err
+			}
+
+			return fmt.Sprintf("map<%s,%s>", key, value), nil
+		}
+
+		if len(e.children) == 0 {
+			return "", errors.New("group has no fields")
+		}
+
+		fields := make([]derivedField, 0, len(e.children))
+		for _, child := range e.children {
+			typ, err := child.glueFieldType()
+
+			if err != nil {
+				return "", fmt.Errorf("field %q: %w", child.name, err)
+			}
+
+			fields = append(fields, derivedField{name: child.name, typ: typ})
+		}
+
+		return glueStructType(fields), nil
+	}
+
+	switch {
+	case e.annotatedAs(parquetConvertedTypeUTF8, parquetLogicalTypeString),
+		e.annotatedAs(parquetConvertedTypeEnum, parquetLogicalTypeEnum),
+		e.annotatedAs(parquetConvertedTypeJSON, parquetLogicalTypeJSON),
+		e.logicalType == parquetLogicalTypeUUID:
+		return "string", nil
+	case e.annotatedAs(parquetConvertedTypeDecimal, parquetLogicalTypeDecimal):
+		return fmt.Sprintf("decimal(%d,%d)", e.precision, e.scale), nil
+	case e.annotatedAs(parquetConvertedTypeDate, parquetLogicalTypeDate):
+		return "date", nil
+	case e.annotatedAs(parquetConvertedTypeTimestampMillis, parquetLogicalTypeTimestamp),
+		e.hasConverted && e.convertedType == parquetConvertedTypeTimestampMicros:
+		return "timestamp", nil
+	case e.logicalType == parquetLogicalTypeInteger:
+		return glueIntegerType(e.intBitWidth, e.intSigned), nil
+	case e.hasConverted:
+		switch e.convertedType {
+		case parquetConvertedTypeInt8:
+			return glueIntegerType(8, true), nil
+		case parquetConvertedTypeInt16:
+			return glueIntegerType(16, true), nil
+		case parquetConvertedTypeInt32:
+			return glueIntegerType(32, true), nil
+		case parquetConvertedTypeInt64:
+			return glueIntegerType(64, true), nil
+		case parquetConvertedTypeUint8:
+			return glueIntegerType(8, false), nil
+		case parquetConvertedTypeUint16:
+			return glueIntegerType(16, false), nil
+		case parquetConvertedTypeUint32:
+			return glueIntegerType(32, false), nil
+		case parquetConvertedTypeUint64:
+			return glueIntegerType(64, false), nil
+		case parquetConvertedTypeTimeMillis, parquetConvertedTypeTimeMicros:
+			// Glue has no time-of-day type; use the physical type.
+		}
+	}
+
+	switch e.physicalType {
+	case parquetTypeBoolean:
+		return "boolean", nil
+	case parquetTypeInt32:
+		return "int", nil
+	case parquetTypeInt64:
+		return "bigint", nil
+	case parquetTypeInt96:
+		return "timestamp", nil
+	case parquetTypeFloat:
+		return "float", nil
+	case parquetTypeDouble:
+		return "double", nil
+	case parquetTypeByteArray, parquetTypeFixedLenByteArray:
+		return "binary", nil
+	default:
+		return "", fmt.Errorf("unknown physical type %d", e.physicalType)
+	}
+}
+
+func glueIntegerType(bitWidth int8, signed bool) string {
+	if !signed {
+		bitWidth *= 2
+	}
+
+	switch {
+	case bitWidth <= 8:
+		return "tinyint"
+	case bitWidth <= 16:
+		return "smallint"
+	case bitWidth <= 32:
+		return "int"
+	case bitWidth <= 64:
+		return "bigint"
+	default:
+		return "decimal(20,0)"
+	}
+}
+
+// Thrift compact protocol types.
+const (
+	thriftCompactBooleanTrue  = 1
+	thriftCompactBooleanFalse = 2
+	thriftCompactByte         = 3
+	thriftCompactI16          = 4
+	thriftCompactI32          = 5
+	thriftCompactI64          = 6
+	thriftCompactDouble       = 7
+	thriftCompactBinary       = 8
+	thriftCompactList         = 9
+	thriftCompactSet          = 10
+	thriftCompactMap          = 11
+	thriftCompactStruct       = 12
+)
+
+type thriftCompactReader struct {
+	r *bytes.Reader
+}
+
+func (r *thriftCompactReader) readByte() (byte, error) {
+	b, err := r.r.ReadByte()
+
+	if errors.Is(err, io.EOF) {
+		return 0, io.ErrUnexpectedEOF
+	}
+
+	return b, err
+}
+
+func (r *thriftCompactReader) readVarint() (uint64, error) {
+	v, err := binary.ReadUvarint(r.r)
+
+	if errors.Is(err, io.EOF) {
+		return 0, io.ErrUnexpectedEOF
+	}
+
+	return v, err
+}
+
+func (r *thriftCompactReader) readZigZag() (int64, error) {
+	v, err := r.readVarint()
+
+	if err != nil {
+		return 0, err
+	}
+
+	return int64(v>>1) ^ -int64(v&1), nil
+}
+
+func (r *thriftCompactReader) readBinary() ([]byte, error) {
+	n, err := r.readVarint()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if n > uint64(r.r.Len()) {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	b := make([]byte, n)
+	_, err = io.ReadFull(r.r, b)
+
+	return b, err
+}
+
+// readFieldHeader returns the next field's ID and type. A type of 0 indicates the end of the struct.
+func (r *thriftCompactReader) readFieldHeader(lastID int16) (int16, byte, error) {
+	b, err := r.readByte()
+
+	if err != nil {
+		return 0, 0, err
+	}
+
+	typ := b & 0x0f
+	if typ == 0 {
+		return 0, 0, nil
+	}
+
+	if delta := int16(b >> 4); delta != 0 {
+		return lastID + delta, typ, nil
+	}
+
+	id, err := r.readZigZag()
+
+	return int16(id), typ, err
+}
+
+func (r *thriftCompactReader) readListHeader() (int, byte, error) {
+	b, err := r.readByte()
+
+	if err != nil {
+		return 0, 0, err
+	}
+
+	size, typ := int(b>>4), b&0x0f
+	if size == 15 {
+		n, err := r.readVarint()
+
+		if err != nil {
+			return 0, 0, err
+		}
+
+		if n > uint64(r.r.Len()) {
+			return 0, 0, io.ErrUnexpectedEOF
+		}
+
+		size = int(n)
+	}
+
+	return size, typ, nil
+}
+
+func (r *thriftCompactReader) readI32(typ byte) (int32, error) {
+	if typ != thriftCompactI32 && typ != thriftCompactI16 && typ != thriftCompactByte {
+		return 0, fmt.Errorf("unexpected Thrift type %d for integer field", typ)
+	}
+
+	if typ == thriftCompactByte {
+		b, err := r.readByte()
+		return int32(int8(b)), err
+	}
+
+	v, err := r.readZigZag()
+
+	return int32(v), err
+}
+
+// skip skips a value of the specified type.
+func (r *thriftCompactReader) skip(typ byte) error {
+	switch typ {
+	case thriftCompactBooleanTrue, thriftCompactBooleanFalse:
+		return nil
+	case thriftCompactByte:
+		_, err := r.readByte()
+		return err
+	case thriftCompactI16, thriftCompactI32, thriftCompactI64:
+		_, err := r.readVarint()
+		return err
+	case thriftCompactDouble:
+		_, err := r.r.Seek(8, io.SeekCurrent)
+		return err
+	case thriftCompactBinary:
+		_, err := r.readBinary()
+		return err
+	case thriftCompactList, thriftCompactSet:
+		size, elemType, err := r.readListHeader()
+
+		if err != nil {
+			return err
+		}
+
+		for range size {
+			// Booleans in collections are encoded as a single byte.
+			if elemType == thriftCompactBooleanTrue || elemType == thriftCompactBooleanFalse {
+				elemType = thriftCompactByte
+			}
+
+			if err := r.skip(elemType); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	case thriftCompactMap:
+		size, err := r.readVarint()
+
+		if err != nil {
+			return err
+		}
+
+		if size == 0 {
+			return nil
+		}
+
+		b, err := r.readByte()
+
+		if err != nil {
+			return err
+		}
+
+		keyType, valueType := b>>4, b&0x0f
+		for range size {
+			if err := r.skip(keyType); err != nil {
+				return err
+			}
+
+			if err := r.skip(valueType); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	case thriftCompactStruct:
+		return r.readStruct(func(int16, byte) (bool, error) {
+			return false, nil
+		})
+	default:
+		return fmt.Errorf("unknown Thrift type %d", typ)
+	}
+}
+
+// readStruct reads a struct, calling f for each field. Fields for which f returns false are skipped.
+func (r *thriftCompactReader) readStruct(f func(id int16, typ byte) (bool, error)) error {
+	var lastID int16
+
+	for {
+		id, typ, err := r.readFieldHeader(lastID)
+
+		if err != nil {
+			return err
+		}
+
+		if typ == 0 {
+			return nil
+		}
+
+		lastID = id
+
+		handled, err := f(id, typ)
+
+		if err != nil {
+			return err
+		}
+
+		if !handled {
+			if err := r.skip(typ); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// decodeParquetSchema decodes the flattened list of schema elements from Thrift-encoded Parquet file metadata.
+func decodeParquetSchema(metadata []byte) ([]*parquetSchemaElement, error) {
+	r := &thriftCompactReader{r: bytes.NewReader(metadata)}
+
+	var elements []*parquetSchemaElement
+
+	err := r.readStruct(func(id int16, typ byte) (bool, error) {
+		// FileMetaData.schema.
+		if id != 2 || typ != thriftCompactList {
+			return false, nil
+		}
+
+		size, elemType, err := r.readListHeader()
+
+		if err != nil {
+			return false, err
+		}
+
+		if elemType != thriftCompactStruct {
+			return false, fmt.Errorf("unexpected Thrift type %d for schema element", elemType)
+		}
+
+		for range size {
+			element, err := r.readSchemaElement()
+
+			if err != nil {
+				return false, err
+			}
+
+			elements = append(elements, element)
+		}
+
+		return true, nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("decoding Parquet file metadata: %w", err)
+	}
+
+	return elements, nil
+}
+
+func (r *thriftCompactReader) readSchemaElement() (*parquetSchemaElement, error) {
+	element := &parquetSchemaElement{}
+
+	err := r.readStruct(func(id int16, typ byte) (bool, error) {
+		var err error
+
+		switch id {
+		case 1:
+			element.physicalType, err = r.readI32(typ)
+			element.hasType = true
+		case 3:
+			element.repetition, err = r.readI32(typ)
+		case 4:
+			if typ != thriftCompactBinary {
+				return false, nil
+			}
+			var b []byte
+			b, err = r.readBinary()
+			element.name = string(b)
+		case 5:
+			element.numChildren, err = r.readI32(typ)
+		case 6:
+			element.convertedType, err = r.readI32(typ)
+			element.hasConverted = true
+		case 7:
+			element.scale, err = r.readI32(typ)
+		case 8:
+			element.precision, err = r.readI32(typ)
+		case 10:
+			if typ != thriftCompactStruct {
+				return false, nil
+			}
+			err = r.readLogicalType(element)
+		default:
+			return false, nil
+		}
+
+		return true, err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if element.numChildren < 0 {
+		return nil, fmt.Errorf("schema element %q has an invalid number of children", element.name)
+	}
+
+	return element, nil
+}
+
+// readLogicalType reads the LogicalType union.
+func (r *thriftCompactReader) readLogicalType(element *parquetSchemaElement) error {
+	return r.readStruct(func(id int16, typ byte) (bool, error) {
+		if typ != thriftCompactStruct {
+			return false, nil
+		}
+
+		element.logicalType = id
+
+		switch id {
+		case parquetLogicalTypeDecimal:
+			return true, r.readStruct(func(id int16, typ byte) (bool, error) {
+				var err error
+
+				switch id {
+				case 1:
+					element.scale, err = r.readI32(typ)
+				case 2:
+					element.precision, err = r.readI32(typ)
+				default:
+					return false, nil
+				}
+
+				return true, err
+			})
+		case parquetLogicalTypeInteger:
+			return true, r.readStruct(func(id int16, typ byte) (bool, error) {
+				switch id {
+				case 1:
+					v, err := r.readI32(typ)
+					element.intBitWidth = int8(v)
+					return true, err
+				case 2:
+					element.intSigned = typ == thriftCompactBooleanTrue
+					return true, nil
+				default:
+					return false, nil
+				}
+			})
+		default:
+			return false, nil
+		}
+	})
+}
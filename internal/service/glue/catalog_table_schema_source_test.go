@@ -0,0 +1,412 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package glue
+
+import (
+	"bytes"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/glue/types"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestAvroSchemaColumns(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema        string
+		expected      []string
+		expectedError string
+	}{
+		"primitive and logical types": {
+			schema: `{
+  "type": "record",
+  "name": "Order",
+  "namespace": "com.example",
+  "fields": [
+    {"name": "id", "type": "long", "doc": "Order identifier."},
+    {"name": "customer", "type": ["null", "string"]},
+    {"name": "paid", "type": "boolean"},
+    {"name": "total", "type": {"type": "bytes", "logicalType": "decimal", "precision": 10, "scale": 2}},
+    {"name": "placed_at", "type": {"type": "long", "logicalType": "timestamp-millis"}},
+    {"name": "ship_date", "type": {"type": "int", "logicalType": "date"}},
+    {"name": "status", "type": {"type": "enum", "name": "Status", "symbols": ["NEW", "SHIPPED"]}},
+    {"name": "checksum", "type": {"type": "fixed", "name": "MD5", "size": 16}}
+  ]
+}`,
+			expected: []string{
+				"id:bigint:Order identifier.",
+				"customer:string:",
+				"paid:boolean:",
+				"total:decimal(10,2):",
+				"placed_at:timestamp:",
+				"ship_date:date:",
+				"status:string:",
+				"checksum:binary:",
+			},
+		},
+		"nested types": {
+			schema: `{
+  "type": "record",
+  "name": "Order",
+  "fields": [
+    {"name": "address", "type": {"type": "record", "name": "Address", "fields": [
+      {"name": "street", "type": "string"},
+      {"name": "zip", "type": "int"}
+    ]}},
+    {"name": "billing", "type": "Address"},
+    {"name": "items", "type": {"type": "array", "items": {"type": "record", "name": "Item", "fields": [
+      {"name": "sku", "type": "string"},
+      {"name": "quantity", "type": "int"}
+    ]}}},
+    {"name": "attributes", "type": {"type": "map", "values": "double"}}
+  ]
+}`,
+			expected: []string{
+				"address:struct<street:string,zip:int>:",
+				"billing:struct<street:string,zip:int>:",
+				"items:array<struct<sku:string,quantity:int>>:",
+				"attributes:map<string,double>:",
+			},
+		},
+		"not a record": {
+			schema:        `"string"`,
+			expectedError: "schema must be an Avro record",
+		},
+		"recursive": {
+			schema:        `{"type": "record", "name": "Node", "fields": [{"name": "next", "type": ["null", "Node"]}]}`,
+			expectedError: `field "next": recursive type "Node" is not supported`,
+		},
+		"union": {
+			schema:        `{"type": "record", "name": "R", "fields": [{"name": "v", "type": ["int", "string"]}]}`,
+			expectedError: "unions of more than one non-null type are not supported",
+		},
+		"undefined type": {
+			schema:        `{"type": "record", "name": "R", "fields": [{"name": "v", "type": "Missing"}]}`,
+			expectedError: `undefined type "Missing"`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			columns, err := avroSchemaColumns([]byte(testCase.schema))
+
+			testDerivedColumns(t, columns, err, testCase.expected, testCase.expectedError)
+		})
+	}
+}
+
+func TestJSONSchemaColumns(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema        string
+		expected      []string
+		expectedError string
+	}{
+		"property order and types": {
+			schema: `{
+  "type": "object",
+  "properties": {
+    "zeta": {"type": "string", "description": "Last in the alphabet."},
+    "alpha": {"type": ["integer", "null"]},
+    "score": {"type": "number"},
+    "active": {"type": "boolean"},
+    "born": {"type": "string", "format": "date"},
+    "updated": {"type": "string", "format": "date-time"},
+    "color": {"enum": ["red", "green"]},
+    "nickname": {"anyOf": [{"type": "null"}, {"type": "string"}]}
+  }
+}`,
+			expected: []string{
+				"zeta:string:Last in the alphabet.",
+				"alpha:bigint:",
+				"score:double:",
+				"active:boolean:",
+				"born:date:",
+				"updated:timestamp:",
+				"color:string:",
+				"nickname:string:",
+			},
+		},
+		"nested types and references": {
+			schema: `{
+  "$defs": {
+    "address": {"type": "object", "properties": {"street": {"type": "string"}, "zip": {"type": "integer"}}}
+  },
+  "type": "object",
+  "properties": {
+    "home": {"$ref": "#/$defs/address"},
+    "tags": {"type": "array", "items": {"type": "string"}},
+    "labels": {"type": "object", "additionalProperties": {"type": "string"}}
+  }
+}`,
+			expected: []string{
+				"home:struct<street:string,zip:bigint>:",
+				"tags:array<string>:",
+				"labels:map<string,string>:",
+			},
+		},
+		"not an object": {
+			schema:        `{"type": "string"}`,
+			expectedError: "schema must describe a JSON object",
+		},
+		"recursive reference": {
+			schema:        `{"type": "object", "properties": {"node": {"$ref": "#/definitions/node"}}, "definitions": {"node": {"type": "object", "properties": {"next": {"$ref": "#/definitions/node"}}}}}`,
+			expectedError: `recursive reference "#/definitions/node" is not supported`,
+		},
+		"missing items": {
+			schema:        `{"type": "object", "properties": {"tags": {"type": "array"}}}`,
+			expectedError: `property "tags": array has no items schema`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			columns, err := jsonSchemaColumns([]byte(testCase.schema))
+
+			testDerivedColumns(t, columns, err, testCase.expected, testCase.expectedError)
+		})
+	}
+}
+
+func TestParquetFileColumns(t *testing.T) {
+	t.Parallel()
+
+	// message schema {
+	//   required int64 id;
+	//   optional binary name (STRING);
+	//   optional fixed_len_byte_array(5) price (DECIMAL(9,2));
+	//   optional int32 day (DATE);
+	//   optional int96 legacy_ts;
+	//   optional int32 small (INT_16);
+	//   optional group tags (LIST) {
+	//     repeated group list {
+	//       optional binary element (UTF8);
+	//     }
+	//   }
+	//   optional group attributes (MAP) {
+	//     repeated group key_value {
+	//       required binary key (UTF8);
+	//       optional double value;
+	//     }
+	//   }
+	//   optional group address {
+	//     optional binary street (UTF8);
+	//     repeated int32 codes;
+	//   }
+	// }
+	elements := []testParquetSchemaElement{
+		{name: "schema", numChildren: 9},
+		{name: "id", physicalType: aws.Int32(parquetTypeInt64), repetition: 0},
+		{name: "name", physicalType: aws.Int32(parquetTypeByteArray), repetition: 1, logicalType: parquetLogicalTypeString},
+		{name: "price", physicalType: aws.Int32(parquetTypeFixedLenByteArray), repetition: 1, convertedType: aws.Int32(parquetConvertedTypeDecimal), scale: 2, precision: 9},
+		{name: "day", physicalType: aws.Int32(parquetTypeInt32), repetition: 1, convertedType: aws.Int32(parquetConvertedTypeDate)},
+		{name: "legacy_ts", physicalType: aws.Int32(parquetTypeInt96), repetition: 1},
+		{name: "small", physicalType: aws.Int32(parquetTypeInt32), repetition: 1, convertedType: aws.Int32(parquetConvertedTypeInt16)},
+		{name: "tags", repetition: 1, numChildren: 1, convertedType: aws.Int32(parquetConvertedTypeList)},
+		{name: "list", repetition: 2, numChildren: 1},
+		{name: "element", physicalType: aws.Int32(parquetTypeByteArray), repetition: 1, convertedType: aws.Int32(parquetConvertedTypeUTF8)},
+		{name: "attributes", repetition: 1, numChildren: 1, convertedType: aws.Int32(parquetConvertedTypeMap)},
+		{name: "key_value", repetition: 2, numChildren: 2},
+		{name: "key", physicalType: aws.Int32(parquetTypeByteArray), repetition: 0, convertedType: aws.Int32(parquetConvertedTypeUTF8)},
+		{name: "value", physicalType: aws.Int32(parquetTypeDouble), repetition: 1},
+		{name: "address", repetition: 1, numChildren: 2},
+		{name: "street", physicalType: aws.Int32(parquetTypeByteArray), repetition: 1, convertedType: aws.Int32(parquetConvertedTypeUTF8)},
+		{name: "codes", physicalType: aws.Int32(parquetTypeInt32), repetition: 2},
+	}
+
+	path := filepath.Join(t.TempDir(), "test.parquet")
+	if err := os.WriteFile(path, testParquetFile(elements), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	columns, err := parquetFileColumns(path)
+
+	testDerivedColumns(t, columns, err, []string{
+		"id:bigint:",
+		"name:string:",
+		"price:decimal(9,2):",
+		"day:date:",
+		"legacy_ts:timestamp:",
+		"small:smallint:",
+		"tags:array<string>:",
+		"attributes:map<string,double>:",
+		"address:struct<street:string,codes:array<int>>:",
+	}, "")
+
+	notParquet := filepath.Join(t.TempDir(), "test.csv")
+	if err := os.WriteFile(notParquet, []byte("id,name\n1,test\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = parquetFileColumns(notParquet)
+
+	testDerivedColumns(t, nil, err, nil, "is not a Parquet file")
+}
+
+func testDerivedColumns(t *testing.T, columns []awstypes.Column, err error, expected []string, expectedError string) {
+	t.Helper()
+
+	if expectedError != "" {
+		if err == nil {
+			t.Fatalf("expected error containing %q, got none", expectedError)
+		}
+
+		if !strings.Contains(err.Error(), expectedError) {
+			t.Fatalf("expected error containing %q, got %q", expectedError, err)
+		}
+
+		return
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var got []string
+	for _, column := range columns {
+		got = append(got, aws.ToString(column.Name)+":"+aws.ToString(column.Type)+":"+aws.ToString(column.Comment))
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+type testParquetSchemaElement struct {
+	name          string
+	physicalType  *int32
+	repetition    int32
+	numChildren   int32
+	convertedType *int32
+	scale         int32
+	precision     int32
+	logicalType   int16
+}
+
+// testParquetFile returns a minimal Parquet file with the specified schema and no row groups.
+func testParquetFile(elements []testParquetSchemaElement) []byte {
+	w := &testThriftCompactWriter{}
+
+	// FileMetaData.
+	w.push()
+	w.fieldHeader(1, thriftCompactI32) // version
+	w.zigZag(1)
+	w.fieldHeader(2, thriftCompactList) // schema
+	w.listHeader(len(elements), thriftCompactStruct)
+	for _, e := range elements {
+		w.push()
+		if e.physicalType != nil {
+			w.fieldHeader(1, thriftCompactI32)
+			w.zigZag(int64(*e.physicalType))
+		}
+		if e.repetition != 0 || e.physicalType != nil {
+			w.fieldHeader(3, thriftCompactI32)
+			w.zigZag(int64(e.repetition))
+		}
+		w.fieldHeader(4, thriftCompactBinary)
+		w.binary(e.name)
+		if e.numChildren > 0 {
+			w.fieldHeader(5, thriftCompactI32)
+			w.zigZag(int64(e.numChildren))
+		}
+		if e.convertedType != nil {
+			w.fieldHeader(6, thriftCompactI32)
+			w.zigZag(int64(*e.convertedType))
+		}
+		if e.scale != 0 {
+			w.fieldHeader(7, thriftCompactI32)
+			w.zigZag(int64(e.scale))
+		}
+		if e.precision != 0 {
+			w.fieldHeader(8, thriftCompactI32)
+			w.zigZag(int64(e.precision))
+		}
+		if e.logicalType != 0 {
+			w.fieldHeader(10, thriftCompactStruct)
+			w.push()
+			w.fieldHeader(e.logicalType, thriftCompactStruct)
+			w.push()
+			w.stop()
+			w.stop()
+		}
+		w.stop()
+	}
+	w.fieldHeader(3, thriftCompactI64) // num_rows
+	w.zigZag(0)
+	w.fieldHeader(4, thriftCompactList) // row_groups
+	w.listHeader(0, thriftCompactStruct)
+	w.fieldHeader(6, thriftCompactBinary) // created_by
+	w.binary("terraform-provider-aws test")
+	w.stop()
+
+	var b bytes.Buffer
+	b.WriteString(parquetMagic)
+	b.Write(w.buf.Bytes())
+	b.Write(binary.LittleEndian.AppendUint32(nil, uint32(w.buf.Len())))
+	b.WriteString(parquetMagic)
+
+	return b.Bytes()
+}
+
+type testThriftCompactWriter struct {
+	buf    bytes.Buffer
+	lastID []int16
+}
+
+func (w *testThriftCompactWriter) push() {
+	w.lastID = append(w.lastID, 0)
+}
+
+func (w *testThriftCompactWriter) stop() {
+	w.buf.WriteByte(0)
+	if len(w.lastID) > 0 {
+		w.lastID = w.lastID[:len(w.lastID)-1]
+	}
+}
+
+func (w *testThriftCompactWriter) fieldHeader(id int16, typ byte) {
+	var lastID int16
+	if len(w.lastID) > 0 {
+		lastID = w.lastID[len(w.lastID)-1]
+		w.lastID[len(w.lastID)-1] = id
+	}
+
+	if delta := id - lastID; delta > 0 && delta <= 15 {
+		w.buf.WriteByte(byte(delta)<<4 | typ)
+		return
+	}
+
+	w.buf.WriteByte(typ)
+	w.zigZag(int64(id))
+}
+
+func (w *testThriftCompactWriter) zigZag(v int64) {
+	w.buf.Write(binary.AppendUvarint(nil, uint64((v<<1)^(v>>63))))
+}
+
+func (w *testThriftCompactWriter) binary(s string) {
+	w.buf.Write(binary.AppendUvarint(nil, uint64(len(s))))
+	w.buf.WriteString(s)
+}
+
+func (w *testThriftCompactWriter) listHeader(size int, typ byte) {
+	if size < 15 {
+		w.buf.WriteByte(byte(size)<<4 | typ)
+		return
+	}
+
+	w.buf.WriteByte(0xf0 | typ)
+	w.buf.Write(binary.AppendUvarint(nil, uint64(size)))
+}
@@ -10,6 +10,7 @@ import (
 
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -63,6 +64,54 @@ func TestAccGlueCatalogTable_basic(t *testing.T) {
 	})
 }
 
+func TestAccGlueCatalogTable_schemaSourceAvro(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_glue_catalog_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlueServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCatalogTableConfig_schemaSourceAvro(rName, `{"name": "amount", "type": "double"}`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCatalogTableExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "derived_columns.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "derived_columns.0.name", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "derived_columns.0.type", "bigint"),
+					resource.TestCheckResourceAttr(resourceName, "derived_columns.0.comment", "Order identifier."),
+					resource.TestCheckResourceAttr(resourceName, "derived_columns.1.name", "items"),
+					resource.TestCheckResourceAttr(resourceName, "derived_columns.1.type", "array<struct<sku:string,quantity:int>>"),
+					resource.TestCheckResourceAttr(resourceName, "derived_columns.2.name", "amount"),
+					resource.TestCheckResourceAttr(resourceName, "derived_columns.2.type", "double"),
+					resource.TestCheckResourceAttr(resourceName, "derived_partition_keys.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "derived_partition_keys.0.name", "dt"),
+					resource.TestCheckResourceAttr(resourceName, "derived_partition_keys.0.type", "string"),
+					resource.TestCheckResourceAttr(resourceName, "partition_keys.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.0.columns.#", "3"),
+				),
+			},
+			{
+				Config: testAccCatalogTableConfig_schemaSourceAvro(rName, `{"name": "amount", "type": {"type": "bytes", "logicalType": "decimal", "precision": 12, "scale": 2}}`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCatalogTableExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "derived_columns.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "derived_columns.2.name", "amount"),
+					resource.TestCheckResourceAttr(resourceName, "derived_columns.2.type", "decimal(12,2)"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccGlueCatalogTable_columnParameters(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -741,6 +790,51 @@ resource "aws_glue_catalog_table" "test" {
 `, rName)
 }
 
+func testAccCatalogTableConfig_schemaSourceAvro(rName, amountField string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+}
+
+resource "aws_glue_catalog_table" "test" {
+  name          = %[1]q
+  database_name = aws_glue_catalog_database.test.name
+  table_type    = "EXTERNAL_TABLE"
+
+  schema_source {
+    avro_schema = jsonencode({
+      type = "record"
+      name = "Order"
+      fields = [
+        { name = "id", type = "long", doc = "Order identifier." },
+        { name = "dt", type = "string" },
+        {
+          name = "items"
+          type = {
+            type  = "array"
+            items = { type = "record", name = "Item", fields = [{ name = "sku", type = "string" }, { name = "quantity", type = "int" }] }
+          }
+        },
+        jsondecode(%[2]q),
+      ]
+    })
+
+    partition_keys = ["dt"]
+  }
+
+  storage_descriptor {
+    location      = "s3://%[1]s/orders/"
+    input_format  = "org.apache.hadoop.hive.ql.io.avro.AvroContainerInputFormat"
+    output_format = "org.apache.hadoop.hive.ql.io.avro.AvroContainerOutputFormat"
+
+    ser_de_info {
+      serialization_library = "org.apache.hadoop.hive.serde2.avro.AvroSerDe"
+    }
+  }
+}
+`, rName, amountField)
+}
+
 func testAccCatalogTableConfig_full(rName, desc string) string {
 	return fmt.Sprintf(`
 resource "aws_glue_catalog_database" "test" {
@@ -15,7 +15,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_grafana_workspace", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_grafana_workspace", &resource.Sweeper{
 		Name: "aws_grafana_workspace",
 		F:    sweepWorkSpaces,
 	})
@@ -65,55 +65,7 @@ func ResourceFilter() *schema.Resource {
 				Type:     schema.TypeList,
 				MaxItems: 1,
 				Required: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"criterion": {
-							Type:     schema.TypeSet,
-							MinItems: 1,
-							Required: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"equals": {
-										Type:     schema.TypeList,
-										Optional: true,
-										MinItems: 1,
-										Elem:     &schema.Schema{Type: schema.TypeString},
-									},
-									names.AttrField: {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									"greater_than": {
-										Type:         schema.TypeString,
-										Optional:     true,
-										ValidateFunc: verify.ValidStringDateOrPositiveInt,
-									},
-									"greater_than_or_equal": {
-										Type:         schema.TypeString,
-										Optional:     true,
-										ValidateFunc: verify.ValidStringDateOrPositiveInt,
-									},
-									"less_than": {
-										Type:         schema.TypeString,
-										Optional:     true,
-										ValidateFunc: verify.ValidStringDateOrPositiveInt,
-									},
-									"less_than_or_equal": {
-										Type:         schema.TypeString,
-										Optional:     true,
-										ValidateFunc: verify.ValidStringDateOrPositiveInt,
-									},
-									"not_equals": {
-										Type:     schema.TypeList,
-										Optional: true,
-										MinItems: 1,
-										Elem:     &schema.Schema{Type: schema.TypeString},
-									},
-								},
-							},
-						},
-					},
-				},
+				Elem:     findingCriteriaSchema(),
 			},
 			names.AttrName: {
 				Type:     schema.TypeString,
@@ -137,6 +89,58 @@ func ResourceFilter() *schema.Resource {
 	}
 }
 
+func findingCriteriaSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"criterion": {
+				Type:     schema.TypeSet,
+				MinItems: 1,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"equals": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						names.AttrField: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"greater_than": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidStringDateOrPositiveInt,
+						},
+						"greater_than_or_equal": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidStringDateOrPositiveInt,
+						},
+						"less_than": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidStringDateOrPositiveInt,
+						},
+						"less_than_or_equal": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidStringDateOrPositiveInt,
+						},
+						"not_equals": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func resourceFilterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).GuardDutyClient(ctx)
@@ -0,0 +1,267 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package guardduty
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/guardduty"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/guardduty/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	findingsPageSize          = 50
+	findingsDefaultMaxResults = 100
+	findingsMaxMaxResults     = 1000
+)
+
+// @SDKDataSource("aws_guardduty_findings", name="Findings")
+func dataSourceFindings() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceFindingsRead,
+
+		SchemaFunc: func() map[string]*schema.Schema {
+			return map[string]*schema.Schema{
+				"detector_id": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"finding_criteria": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem:     findingCriteriaSchema(),
+				},
+				"findings": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							names.AttrAccountID: {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"archived": {
+								Type:     schema.TypeBool,
+								Computed: true,
+							},
+							names.AttrARN: {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"count": {
+								Type:     schema.TypeInt,
+								Computed: true,
+							},
+							names.AttrCreatedAt: {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							names.AttrDescription: {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							names.AttrID: {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							names.AttrRegion: {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							names.AttrResourceType: {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"severity": {
+								Type:     schema.TypeFloat,
+								Computed: true,
+							},
+							"title": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							names.AttrType: {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"updated_at": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+				"max_results": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      findingsDefaultMaxResults,
+					ValidateFunc: validation.IntBetween(1, findingsMaxMaxResults),
+				},
+				"sort_criteria": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"attribute_name": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"order_by": {
+								Type:             schema.TypeString,
+								Optional:         true,
+								Default:          string(awstypes.OrderByDesc),
+								ValidateDiagFunc: enum.Validate[awstypes.OrderBy](),
+							},
+						},
+					},
+				},
+				"truncated": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+			}
+		},
+	}
+}
+
+func dataSourceFindingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GuardDutyClient(ctx)
+
+	detectorID := d.Get("detector_id").(string)
+	input := &guardduty.ListFindingsInput{
+		DetectorId: aws.String(detectorID),
+	}
+
+	if v, ok := d.GetOk("finding_criteria"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		findingCriteria, err := expandFindingCriteria(v.([]interface{}))
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input.FindingCriteria = findingCriteria
+	}
+
+	if v, ok := d.GetOk("sort_criteria"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SortCriteria = expandSortCriteria(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	findings, truncated, err := findFindings(ctx, conn, input, d.Get("max_results").(int))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading GuardDuty Detector (%s) Findings: %s", detectorID, err)
+	}
+
+	d.SetId(detectorID)
+	if err := d.Set("findings", flattenFindings(findings)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting findings: %s", err)
+	}
+	d.Set("truncated", truncated)
+
+	return diags
+}
+
+// findFindings returns up to maxResults findings and whether more findings matched.
+func findFindings(ctx context.Context, conn *guardduty.Client, input *guardduty.ListFindingsInput, maxResults int) ([]awstypes.Finding, bool, error) {
+	var findingIDs []string
+	var truncated bool
+
+	input.MaxResults = aws.Int32(int32(min(maxResults, findingsPageSize)))
+
+	pages := guardduty.NewListFindingsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, false, err
+		}
+
+		findingIDs = append(findingIDs, page.FindingIds...)
+
+		if len(findingIDs) >= maxResults {
+			truncated = len(findingIDs) > maxResults || pages.HasMorePages()
+			findingIDs = findingIDs[:maxResults]
+			break
+		}
+	}
+
+	var output []awstypes.Finding
+
+	for len(findingIDs) > 0 {
+		n := min(len(findingIDs), findingsPageSize)
+		getInput := &guardduty.GetFindingsInput{
+			DetectorId:   input.DetectorId,
+			FindingIds:   findingIDs[:n],
+			SortCriteria: input.SortCriteria,
+		}
+
+		page, err := conn.GetFindings(ctx, getInput)
+
+		if err != nil {
+			return nil, false, err
+		}
+
+		output = append(output, page.Findings...)
+		findingIDs = findingIDs[n:]
+	}
+
+	return output, truncated, nil
+}
+
+func expandSortCriteria(tfMap map[string]interface{}) *awstypes.SortCriteria {
+	apiObject := &awstypes.SortCriteria{}
+
+	if v, ok := tfMap["attribute_name"].(string); ok && v != "" {
+		apiObject.AttributeName = aws.String(v)
+	}
+
+	if v, ok := tfMap["order_by"].(string); ok && v != "" {
+		apiObject.OrderBy = awstypes.OrderBy(v)
+	}
+
+	return apiObject
+}
+
+func flattenFindings(apiObjects []awstypes.Finding) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			names.AttrAccountID:   aws.ToString(apiObject.AccountId),
+			names.AttrARN:         aws.ToString(apiObject.Arn),
+			names.AttrCreatedAt:   aws.ToString(apiObject.CreatedAt),
+			names.AttrDescription: aws.ToString(apiObject.Description),
+			names.AttrID:          aws.ToString(apiObject.Id),
+			names.AttrRegion:      aws.ToString(apiObject.Region),
+			"severity":            aws.ToFloat64(apiObject.Severity),
+			"title":               aws.ToString(apiObject.Title),
+			names.AttrType:        aws.ToString(apiObject.Type),
+			"updated_at":          aws.ToString(apiObject.UpdatedAt),
+		}
+
+		if v := apiObject.Resource; v != nil {
+			tfMap[names.AttrResourceType] = aws.ToString(v.ResourceType)
+		}
+
+		if v := apiObject.Service; v != nil {
+			tfMap["archived"] = aws.ToBool(v.Archived)
+			tfMap["count"] = int(aws.ToInt32(v.Count))
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
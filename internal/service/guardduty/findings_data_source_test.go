@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package guardduty_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccFindingsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_guardduty_findings.test"
+	detectorDataSourceName := "data.aws_guardduty_detector.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckDetectorExists(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.GuardDutyServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFindingsDataSourceConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "detector_id", detectorDataSourceName, names.AttrID),
+					resource.TestCheckResourceAttrSet(dataSourceName, "findings.#"),
+					resource.TestCheckResourceAttr(dataSourceName, "max_results", "100"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "truncated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFindingsDataSource_findingCriteria(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_guardduty_findings.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckDetectorExists(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.GuardDutyServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFindingsDataSourceConfig_findingCriteria(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "findings.#"),
+					resource.TestCheckResourceAttr(dataSourceName, "max_results", "10"),
+					resource.TestCheckResourceAttr(dataSourceName, "sort_criteria.0.order_by", "DESC"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFindingsDataSourceConfig_basic() string {
+	return `
+data "aws_guardduty_detector" "test" {}
+
+data "aws_guardduty_findings" "test" {
+  detector_id = data.aws_guardduty_detector.test.id
+}
+`
+}
+
+func testAccFindingsDataSourceConfig_findingCriteria() string {
+	return `
+data "aws_guardduty_detector" "test" {}
+
+data "aws_guardduty_findings" "test" {
+  detector_id = data.aws_guardduty_detector.test.id
+  max_results = 10
+
+  finding_criteria {
+    criterion {
+      field        = "severity"
+      greater_than = "6"
+    }
+
+    criterion {
+      field  = "service.archived"
+      equals = ["false"]
+    }
+  }
+
+  sort_criteria {
+    attribute_name = "severity"
+  }
+}
+`
+}
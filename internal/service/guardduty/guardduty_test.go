@@ -42,6 +42,10 @@ func TestAccGuardDuty_serial(t *testing.T) {
 		"FindingIDs": {
 			"datasource_basic": testAccFindingIDsDataSource_basic,
 		},
+		"Findings": {
+			"datasource_basic":           testAccFindingsDataSource_basic,
+			"datasource_findingCriteria": testAccFindingsDataSource_findingCriteria,
+		},
 		"InviteAccepter": {
 			acctest.CtBasic: testAccInviteAccepter_basic,
 		},
@@ -41,6 +41,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceDetector,
 			TypeName: "aws_guardduty_detector",
 		},
+		{
+			Factory:  dataSourceFindings,
+			TypeName: "aws_guardduty_findings",
+			Name:     "Findings",
+		},
 	}
 }
 
@@ -16,13 +16,13 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_guardduty_detector", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_guardduty_detector", &resource.Sweeper{
 		Name:         "aws_guardduty_detector",
 		F:            sweepDetectors,
 		Dependencies: []string{"aws_guardduty_publishing_destination"},
 	})
 
-	resource.AddTestSweepers("aws_guardduty_publishing_destination", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_guardduty_publishing_destination", &resource.Sweeper{
 		Name: "aws_guardduty_publishing_destination",
 		F:    sweepPublishingDestinations,
 	})
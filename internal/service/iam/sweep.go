@@ -23,13 +23,14 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/policy"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/sdk"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_iam_group", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_iam_group", &resource.Sweeper{
 		Name: "aws_iam_group",
 		F:    sweepGroups,
 		Dependencies: []string{
@@ -43,7 +44,7 @@ func RegisterSweepers() {
 
 	awsv2.Register("aws_iam_openid_connect_provider", sweepOpenIDConnectProvider)
 
-	resource.AddTestSweepers("aws_iam_policy", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_iam_policy", &resource.Sweeper{
 		Name: "aws_iam_policy",
 		F:    sweepPolicies,
 		Dependencies: []string{
@@ -55,7 +56,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_iam_role", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_iam_role", &resource.Sweeper{
 		Name: "aws_iam_role",
 		Dependencies: []string{
 			"aws_batch_compute_environment",
@@ -92,14 +93,14 @@ func RegisterSweepers() {
 
 	awsv2.Register("aws_iam_signing_certificate", sweepSigningCertificates)
 
-	resource.AddTestSweepers("aws_iam_server_certificate", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_iam_server_certificate", &resource.Sweeper{
 		Name: "aws_iam_server_certificate",
 		F:    sweepServerCertificates,
 	})
 
 	awsv2.Register("aws_iam_service_linked_role", sweepServiceLinkedRoles)
 
-	resource.AddTestSweepers("aws_iam_user", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_iam_user", &resource.Sweeper{
 		Name: "aws_iam_user",
 		F:    sweepUsers,
 		Dependencies: []string{
@@ -372,10 +373,10 @@ func sweepPolicies(region string) error {
 			return fmt.Errorf("retrieving IAM Policies: %w", err)
 		}
 
-		for _, policy := range page.Policies {
-			arn := aws.ToString(policy.Arn)
+		for _, v := range page.Policies {
+			arn := aws.ToString(v.Arn)
 
-			if n := aws.ToInt32(policy.AttachmentCount); n > 0 {
+			if n := aws.ToInt32(v.AttachmentCount); n > 0 {
 				log.Printf("[INFO] Skipping IAM Policy %s: AttachmentCount=%d", arn, n)
 				continue
 			}
@@ -384,7 +385,23 @@ func sweepPolicies(region string) error {
 			d := r.Data(nil)
 			d.SetId(arn)
 
-			sweepResources = append(sweepResources, newPolicySweeper(r, d, client))
+			description := policy.Description{
+				ARN:          arn,
+				CreationTime: aws.ToTime(v.CreateDate),
+			}
+
+			if sweep.PolicyRequiresTags() {
+				// ListPolicies doesn't return tags.
+				tags, err := policyTags(ctx, conn, arn)
+
+				if err != nil {
+					log.Printf("[WARN] Listing IAM Policy (%s) tags: %s", arn, err)
+				} else {
+					description.Tags = KeyValueTags(ctx, tags).Map()
+				}
+			}
+
+			sweepResources = append(sweepResources, sweep.Described(newPolicySweeper(r, d, client), description))
 		}
 	}
 
@@ -428,7 +445,8 @@ func sweepRoles(region string) error {
 	}
 	conn := client.IAMClient(ctx)
 
-	roles := make([]string, 0)
+	var sweepResources []sweep.Sweepable
+
 	pages := iam.NewListRolesPaginator(conn, &iam.ListRolesInput{})
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
@@ -443,39 +461,63 @@ func sweepRoles(region string) error {
 
 		for _, role := range page.Roles {
 			roleName := aws.ToString(role.RoleName)
-			if roleNameFilter(roleName) {
-				roles = append(roles, roleName)
-			} else {
+			if !roleNameFilter(roleName) {
 				log.Printf("[INFO] Skipping IAM Role (%s): no match on allow-list", roleName)
+				continue
 			}
+
+			description := policy.Description{
+				ARN:          aws.ToString(role.Arn),
+				CreationTime: aws.ToTime(role.CreateDate),
+			}
+
+			if sweep.PolicyRequiresTags() {
+				// ListRoles doesn't return tags.
+				tags, err := roleTags(ctx, conn, roleName)
+
+				if err != nil {
+					log.Printf("[WARN] Listing IAM Role (%s) tags: %s", roleName, err)
+				} else {
+					description.Tags = KeyValueTags(ctx, tags).Map()
+				}
+			}
+
+			sweepResources = append(sweepResources, sweep.Described(roleSweeper{conn: conn, roleName: roleName}, description))
 		}
 	}
 
-	if len(roles) == 0 {
-		log.Print("[DEBUG] No IAM Roles to sweep")
-		return nil
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+	if err != nil {
+		return fmt.Errorf("sweeping IAM Roles (%s): %w", region, err)
 	}
 
-	var sweeperErrs *multierror.Error
+	return nil
+}
 
-	for _, roleName := range roles {
-		log.Printf("[DEBUG] Deleting IAM Role (%s)", roleName)
+type roleSweeper struct {
+	conn     *iam.Client
+	roleName string
+}
 
-		err := deleteRole(ctx, conn, roleName, true, true, true)
+func (rs roleSweeper) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+	log.Printf("[DEBUG] Deleting IAM Role (%s)", rs.roleName)
 
-		if tfawserr.ErrCodeContains(err, "AccessDenied") {
-			log.Printf("[WARN] Skipping IAM Role (%s): %s", roleName, err)
-			continue
-		}
-		if err != nil {
-			sweeperErr := fmt.Errorf("error deleting IAM Role (%s): %w", roleName, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
-		}
+	err := deleteRole(ctx, rs.conn, rs.roleName, true, true, true)
+
+	if tfawserr.ErrCodeContains(err, "AccessDenied") {
+		log.Printf("[WARN] Skipping IAM Role (%s): %s", rs.roleName, err)
+		return nil
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	if err != nil {
+		return fmt.Errorf("deleting IAM Role (%s): %w", rs.roleName, err)
+	}
+
+	return nil
+}
+
+func (rs roleSweeper) String() string {
+	return rs.roleName
 }
 
 func sweepSAMLProvider(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
@@ -18,42 +18,42 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_imagebuilder_component", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_imagebuilder_component", &resource.Sweeper{
 		Name: "aws_imagebuilder_component",
 		F:    sweepComponents,
 	})
 
-	resource.AddTestSweepers("aws_imagebuilder_distribution_configuration", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_imagebuilder_distribution_configuration", &resource.Sweeper{
 		Name: "aws_imagebuilder_distribution_configuration",
 		F:    sweepDistributionConfigurations,
 	})
 
-	resource.AddTestSweepers("aws_imagebuilder_image_pipeline", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_imagebuilder_image_pipeline", &resource.Sweeper{
 		Name: "aws_imagebuilder_image_pipeline",
 		F:    sweepImagePipelines,
 	})
 
-	resource.AddTestSweepers("aws_imagebuilder_image_recipe", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_imagebuilder_image_recipe", &resource.Sweeper{
 		Name: "aws_imagebuilder_image_recipe",
 		F:    sweepImageRecipes,
 	})
 
-	resource.AddTestSweepers("aws_imagebuilder_container_recipe", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_imagebuilder_container_recipe", &resource.Sweeper{
 		Name: "aws_imagebuilder_container_recipe",
 		F:    sweepContainerRecipes,
 	})
 
-	resource.AddTestSweepers("aws_imagebuilder_image", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_imagebuilder_image", &resource.Sweeper{
 		Name: "aws_imagebuilder_image",
 		F:    sweepImages,
 	})
 
-	resource.AddTestSweepers("aws_imagebuilder_infrastructure_configuration", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_imagebuilder_infrastructure_configuration", &resource.Sweeper{
 		Name: "aws_imagebuilder_infrastructure_configuration",
 		F:    sweepInfrastructureConfigurations,
 	})
 
-	resource.AddTestSweepers("aws_imagebuilder_lifecycle_policy", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_imagebuilder_lifecycle_policy", &resource.Sweeper{
 		Name: "aws_imagebuilder_lifecycle_policy",
 		F:    sweepLifecyclePolicies,
 	})
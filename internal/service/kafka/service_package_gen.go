@@ -65,6 +65,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			},
+			Stateful: true,
 		},
 		{
 			Factory:  resourceClusterPolicy,
@@ -16,7 +16,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_msk_cluster", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_msk_cluster", &resource.Sweeper{
 		Name: "aws_msk_cluster",
 		F:    sweepClusters,
 		Dependencies: []string{
@@ -24,7 +24,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_msk_configuration", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_msk_configuration", &resource.Sweeper{
 		Name: "aws_msk_configuration",
 		F:    sweepConfigurations,
 		Dependencies: []string{
@@ -16,7 +16,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_kendra_index", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_kendra_index", &resource.Sweeper{
 		Name: "aws_kendra_index",
 		F:    sweepIndex,
 	})
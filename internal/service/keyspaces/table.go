@@ -31,6 +31,7 @@ import (
 
 // @SDKResource("aws_keyspaces_table", name="Table")
 // @Tags(identifierAttribute="arn")
+// @Stateful
 func resourceTable() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceTableCreate,
@@ -48,6 +48,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrName,
 			},
+			Stateful: true,
 		},
 		{
 			Factory:  resourceStreamConsumer,
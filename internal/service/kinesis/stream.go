@@ -31,6 +31,7 @@ import (
 
 // @SDKResource("aws_kinesis_stream", name="Stream")
 // @Tags(identifierAttribute="name")
+// @Stateful
 func resourceStream() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceStreamCreate,
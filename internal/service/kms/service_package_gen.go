@@ -99,6 +99,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			},
+			Stateful: true,
 		},
 		{
 			Factory:  resourceKeyPolicy,
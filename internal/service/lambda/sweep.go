@@ -13,16 +13,17 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/policy"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_lambda_function", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_lambda_function", &resource.Sweeper{
 		Name: "aws_lambda_function",
 		F:    sweepFunctions,
 	})
 
-	resource.AddTestSweepers("aws_lambda_layer", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_lambda_layer", &resource.Sweeper{
 		Name: "aws_lambda_layer",
 		F:    sweepLayerVersions,
 		Dependencies: []string{
@@ -60,7 +61,23 @@ func sweepFunctions(region string) error {
 			d.SetId(aws.ToString(v.FunctionName))
 			d.Set("function_name", v.FunctionName)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			arn := aws.ToString(v.FunctionArn)
+			// ListFunctions returns neither tags nor the creation time.
+			description := policy.Description{
+				ARN: arn,
+			}
+
+			if sweep.PolicyRequiresTags() {
+				tags, err := listTags(ctx, conn, arn)
+
+				if err != nil {
+					log.Printf("[WARN] Listing Lambda Function (%s) tags: %s", d.Id(), err)
+				} else {
+					description.Tags = tags.Map()
+				}
+			}
+
+			sweepResources = append(sweepResources, sweep.Described(sweep.NewSweepResource(r, d, client), description))
 		}
 	}
 
@@ -15,32 +15,32 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_location_geofence_collection", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_location_geofence_collection", &resource.Sweeper{
 		Name: "aws_location_geofence_collection",
 		F:    sweepGeofenceCollections,
 	})
 
-	resource.AddTestSweepers("aws_location_map", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_location_map", &resource.Sweeper{
 		Name: "aws_location_map",
 		F:    sweepMaps,
 	})
 
-	resource.AddTestSweepers("aws_location_place_index", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_location_place_index", &resource.Sweeper{
 		Name: "aws_location_place_index",
 		F:    sweepPlaceIndexes,
 	})
 
-	resource.AddTestSweepers("aws_location_route_calculator", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_location_route_calculator", &resource.Sweeper{
 		Name: "aws_location_route_calculator",
 		F:    sweepRouteCalculators,
 	})
 
-	resource.AddTestSweepers("aws_location_tracker", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_location_tracker", &resource.Sweeper{
 		Name: "aws_location_tracker",
 		F:    sweepTrackers,
 	})
 
-	resource.AddTestSweepers("aws_location_tracker_association", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_location_tracker_association", &resource.Sweeper{
 		Name: "aws_location_tracker_association",
 		F:    sweepTrackerAssociations,
 	})
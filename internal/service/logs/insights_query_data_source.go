@@ -0,0 +1,321 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_cloudwatch_log_insights_query", name="Insights Query")
+func newInsightsQueryDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &insightsQueryDataSource{}, nil
+}
+
+const (
+	dsNameInsightsQuery = "Insights Query Data Source"
+
+	insightsQueryDefaultMaxRows = 1000
+	insightsQueryDefaultTimeout = 15 * time.Minute
+)
+
+type insightsQueryDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*insightsQueryDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_cloudwatch_log_insights_query"
+}
+
+func (d *insightsQueryDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"end_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Required:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"limit": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 10000),
+				},
+			},
+			"log_group_identifiers": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 50),
+					listvalidator.ExactlyOneOf(path.MatchRoot("log_group_names")),
+				},
+			},
+			"log_group_names": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 50),
+				},
+			},
+			"max_rows": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"query_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"query_string": schema.StringAttribute{
+				Required: true,
+			},
+			"results": schema.ListAttribute{
+				ElementType: types.MapType{ElemType: types.StringType},
+				Computed:    true,
+			},
+			names.AttrStartTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Required:   true,
+			},
+			"statistics": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[queryStatisticsModel](ctx),
+				Computed:   true,
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.QueryStatus](),
+				Computed:   true,
+			},
+			names.AttrTimeout: schema.StringAttribute{
+				CustomType: fwtypes.DurationType,
+				Optional:   true,
+			},
+		},
+	}
+}
+
+func (d *insightsQueryDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data insightsQueryDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().LogsClient(ctx)
+
+	startTime, diags := data.StartTime.ValueRFC3339Time()
+	response.Diagnostics.Append(diags...)
+	endTime, diags := data.EndTime.ValueRFC3339Time()
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !endTime.After(startTime) {
+		response.Diagnostics.AddAttributeError(path.Root("end_time"), "Invalid Attribute Value", "end_time must be later than start_time")
+		return
+	}
+
+	input := &cloudwatchlogs.StartQueryInput{
+		EndTime:             aws.Int64(endTime.Unix()),
+		LogGroupIdentifiers: fwflex.ExpandFrameworkStringValueList(ctx, data.LogGroupIdentifiers),
+		LogGroupNames:       fwflex.ExpandFrameworkStringValueList(ctx, data.LogGroupNames),
+		QueryString:         fwflex.StringFromFramework(ctx, data.QueryString),
+		StartTime:           aws.Int64(startTime.Unix()),
+	}
+
+	if !data.Limit.IsNull() {
+		input.Limit = aws.Int32(int32(data.Limit.ValueInt64()))
+	}
+
+	output, err := conn.StartQuery(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.Logs, create.ErrActionReading, dsNameInsightsQuery, data.QueryString.ValueString(), err), err.Error())
+
+		return
+	}
+
+	queryID := aws.ToString(output.QueryId)
+
+	timeout := insightsQueryDefaultTimeout
+	if !data.Timeout.IsNull() {
+		timeout = data.Timeout.ValueDuration()
+	}
+
+	results, err := waitQueryCompleted(ctx, conn, queryID, timeout)
+
+	if err != nil {
+		// Don't leave the query running (and consuming concurrency) after we've given up on it.
+		stopQuery(ctx, conn, queryID)
+
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.Logs, create.ErrActionWaitingForCreation, dsNameInsightsQuery, queryID, err), err.Error())
+
+		return
+	}
+
+	maxRows := int64(insightsQueryDefaultMaxRows)
+	if !data.MaxRows.IsNull() {
+		maxRows = data.MaxRows.ValueInt64()
+	}
+
+	if n := int64(len(results.Results)); n > maxRows {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Logs, create.ErrActionReading, dsNameInsightsQuery, queryID, nil),
+			fmt.Sprintf("query returned %d rows, which exceeds max_rows (%d); narrow the query or time range, or raise max_rows", n, maxRows),
+		)
+
+		return
+	}
+
+	data.ID = fwflex.StringValueToFramework(ctx, queryID)
+	data.QueryID = fwflex.StringValueToFramework(ctx, queryID)
+	data.Status = fwtypes.StringEnumValue(results.Status)
+
+	rows, diags := flattenQueryResults(ctx, results.Results)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.Results = rows
+
+	var statistics []awstypes.QueryStatistics
+	if results.Statistics != nil {
+		statistics = append(statistics, *results.Statistics)
+	}
+	response.Diagnostics.Append(fwflex.Flatten(ctx, statistics, &data.Statistics)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findQueryResultsByID(ctx context.Context, conn *cloudwatchlogs.Client, id string) (*cloudwatchlogs.GetQueryResultsOutput, error) {
+	input := &cloudwatchlogs.GetQueryResultsInput{
+		QueryId: aws.String(id),
+	}
+
+	output, err := conn.GetQueryResults(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusQuery(ctx context.Context, conn *cloudwatchlogs.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findQueryResultsByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitQueryCompleted(ctx context.Context, conn *cloudwatchlogs.Client, id string, timeout time.Duration) (*cloudwatchlogs.GetQueryResultsOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.QueryStatusScheduled, awstypes.QueryStatusRunning),
+		Target:     enum.Slice(awstypes.QueryStatusComplete),
+		Refresh:    statusQuery(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 1 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*cloudwatchlogs.GetQueryResultsOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func stopQuery(ctx context.Context, conn *cloudwatchlogs.Client, id string) {
+	// Best effort, the query may already have finished.
+	_, _ = conn.StopQuery(ctx, &cloudwatchlogs.StopQueryInput{
+		QueryId: aws.String(id),
+	})
+}
+
+func flattenQueryResults(ctx context.Context, apiObjects [][]awstypes.ResultField) (types.List, diag.Diagnostics) {
+	elemType := types.MapType{ElemType: types.StringType}
+	rows := make([]map[string]string, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		row := make(map[string]string, len(apiObject))
+
+		for _, field := range apiObject {
+			row[aws.ToString(field.Field)] = aws.ToString(field.Value)
+		}
+
+		rows = append(rows, row)
+	}
+
+	return types.ListValueFrom(ctx, elemType, rows)
+}
+
+type insightsQueryDataSourceModel struct {
+	EndTime             timetypes.RFC3339                                     `tfsdk:"end_time"`
+	ID                  types.String                                          `tfsdk:"id"`
+	Limit               types.Int64                                           `tfsdk:"limit"`
+	LogGroupIdentifiers fwtypes.ListValueOf[types.String]                     `tfsdk:"log_group_identifiers"`
+	LogGroupNames       fwtypes.ListValueOf[types.String]                     `tfsdk:"log_group_names"`
+	MaxRows             types.Int64                                           `tfsdk:"max_rows"`
+	QueryID             types.String                                          `tfsdk:"query_id"`
+	QueryString         types.String                                          `tfsdk:"query_string"`
+	Results             types.List                                            `tfsdk:"results"`
+	StartTime           timetypes.RFC3339                                     `tfsdk:"start_time"`
+	Statistics          fwtypes.ListNestedObjectValueOf[queryStatisticsModel] `tfsdk:"statistics"`
+	Status              fwtypes.StringEnum[awstypes.QueryStatus]              `tfsdk:"status"`
+	Timeout             fwtypes.Duration                                      `tfsdk:"timeout"`
+}
+
+type queryStatisticsModel struct {
+	BytesScanned   types.Float64 `tfsdk:"bytes_scanned"`
+	RecordsMatched types.Float64 `tfsdk:"records_matched"`
+	RecordsScanned types.Float64 `tfsdk:"records_scanned"`
+}
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLogsInsightsQueryDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudwatch_log_insights_query.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LogsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInsightsQueryDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "query_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "statistics.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrStatus, "Complete"),
+				),
+			},
+		},
+	})
+}
+
+func testAccInsightsQueryDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+data "aws_cloudwatch_log_insights_query" "test" {
+  log_group_names = [aws_cloudwatch_log_group.test.name]
+  query_string    = "fields @timestamp, @message | sort @timestamp desc | limit 20"
+  start_time      = timeadd(plantimestamp(), "-1h")
+  end_time        = plantimestamp()
+  max_rows        = 20
+}
+`, rName)
+}
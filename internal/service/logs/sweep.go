@@ -6,16 +6,18 @@ package logs
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/policy"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_cloudwatch_log_group", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_cloudwatch_log_group", &resource.Sweeper{
 		Name: "aws_cloudwatch_log_group",
 		F:    sweepGroups,
 		Dependencies: []string{
@@ -42,12 +44,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_query_definition", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_cloudwatch_query_definition", &resource.Sweeper{
 		Name: "aws_cloudwatch_query_definition",
 		F:    sweeplogQueryDefinitions,
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_log_resource_policy", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_cloudwatch_log_resource_policy", &resource.Sweeper{
 		Name: "aws_cloudwatch_log_resource_policy",
 		F:    sweepResourcePolicies,
 	})
@@ -81,7 +83,24 @@ func sweepGroups(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.ToString(v.LogGroupName))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			arn := TrimLogGroupARNWildcardSuffix(aws.ToString(v.Arn))
+			description := policy.Description{
+				ARN:          arn,
+				CreationTime: time.UnixMilli(aws.ToInt64(v.CreationTime)),
+			}
+
+			if sweep.PolicyRequiresTags() {
+				// DescribeLogGroups doesn't return tags.
+				tags, err := listTags(ctx, conn, arn)
+
+				if err != nil {
+					log.Printf("[WARN] Listing CloudWatch Logs Log Group (%s) tags: %s", d.Id(), err)
+				} else {
+					description.Tags = tags.Map()
+				}
+			}
+
+			sweepResources = append(sweepResources, sweep.Described(sweep.NewSweepResource(r, d, client), description))
 		}
 	}
 
@@ -15,7 +15,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_media_package_channel", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_media_package_channel", &resource.Sweeper{
 		Name: "aws_media_package_channel",
 		F:    sweepChannels,
 	})
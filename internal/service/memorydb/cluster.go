@@ -30,6 +30,7 @@ import (
 
 // @SDKResource("aws_memorydb_cluster", name="Cluster")
 // @Tags(identifierAttribute="arn")
+// @Stateful
 func resourceCluster() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceClusterCreate,
@@ -0,0 +1,381 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package meta
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/go-cty/cty"
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfcloudcontrol "github.com/hashicorp/terraform-provider-aws/internal/service/cloudcontrol"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="ARN Lookup")
+func newARNLookupDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	d := &arnLookupDataSource{}
+
+	return d, nil
+}
+
+type arnLookupDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*arnLookupDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_arn_lookup"
+}
+
+func (d *arnLookupDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			names.AttrAttributes: schema.DynamicAttribute{
+				Computed: true,
+			},
+			"cloudcontrol_identifier": schema.StringAttribute{
+				Optional: true,
+			},
+			"cloudcontrol_type_name": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`[0-9A-Za-z]{2,64}::[0-9A-Za-z]{2,64}::[0-9A-Za-z]{2,64}`), "must be three alphanumeric sections separated by double colons (::)"),
+				},
+			},
+			"data_source": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrID: schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *arnLookupDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data arnLookupDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	arn := data.ARN.ValueARN()
+	meta := d.Meta()
+
+	typeName, value, err := readDataSourceByARN(ctx, meta, arn)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("looking up ARN (%s)", arn), err.Error())
+
+		return
+	}
+
+	if value == nil {
+		// No registered data source can look up the ARN, fall back to Cloud Control API.
+		if data.CloudControlTypeName.IsNull() {
+			response.Diagnostics.AddError(fmt.Sprintf("looking up ARN (%s)", arn), "no data source can look up the ARN; set cloudcontrol_type_name to look it up using the Cloud Control API")
+
+			return
+		}
+
+		cloudControlTypeName := data.CloudControlTypeName.ValueString()
+		identifier := arn.String()
+		if !data.CloudControlIdentifier.IsNull() {
+			identifier = data.CloudControlIdentifier.ValueString()
+		}
+
+		resourceDescription, err := tfcloudcontrol.FindResource(ctx, meta.CloudControlClient(ctx), identifier, cloudControlTypeName, "", "")
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading Cloud Control API (%s) Resource (%s)", cloudControlTypeName, identifier), err.Error())
+
+			return
+		}
+
+		value, err = fwflex.ValueFromJSON(ctx, aws.ToString(resourceDescription.Properties))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading Cloud Control API (%s) Resource (%s) properties", cloudControlTypeName, identifier), err.Error())
+
+			return
+		}
+	}
+
+	data.Attributes = types.DynamicValue(value)
+	data.DataSource = fwflex.StringValueToFramework(ctx, typeName)
+	data.ID = fwflex.StringValueToFramework(ctx, arn.String())
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type arnLookupDataSourceModel struct {
+	ARN                    fwtypes.ARN   `tfsdk:"arn"`
+	Attributes             types.Dynamic `tfsdk:"attributes"`
+	CloudControlIdentifier types.String  `tfsdk:"cloudcontrol_identifier"`
+	CloudControlTypeName   types.String  `tfsdk:"cloudcontrol_type_name"`
+	DataSource             types.String  `tfsdk:"data_source"`
+	ID                     types.String  `tfsdk:"id"`
+}
+
+// arnLookupCandidate is a registered data source that takes an `arn` argument.
+type arnLookupCandidate struct {
+	typeName string
+	read     func(arn string) (attr.Value, error)
+}
+
+// readDataSourceByARN reads the registered data source that can look up the specified ARN.
+// The data source is chosen by matching the ARN's service to a service package and the ARN's resource type to
+// the type name of one of the service package's data sources that takes an `arn` argument.
+// A nil value is returned if no data source can look up the ARN.
+func readDataSourceByARN(ctx context.Context, meta *conns.AWSClient, arn arn.ARN) (string, attr.Value, error) {
+	servicePackageName, err := names.ProviderPackageForAlias(arn.Service)
+
+	if err != nil {
+		return "", nil, nil
+	}
+
+	sp, ok := meta.ServicePackages[servicePackageName]
+
+	if !ok {
+		return "", nil, nil
+	}
+
+	dataSources, err := arnDataSources(ctx, meta, sp)
+
+	if err != nil {
+		return "", nil, err
+	}
+
+	if resourceType := arnResourceType(arn.Resource); resourceType != "" {
+		var matches []arnLookupCandidate
+
+		for _, v := range dataSources {
+			if typeNameMatchesResourceType(v.typeName, resourceType) {
+				matches = append(matches, v)
+			}
+		}
+
+		dataSources = matches
+	}
+
+	switch n := len(dataSources); n {
+	case 0:
+		return "", nil, nil
+	case 1:
+		v := dataSources[0]
+		value, err := v.read(arn.String())
+
+		if err != nil {
+			return "", nil, fmt.Errorf("reading %s: %w", v.typeName, err)
+		}
+
+		return v.typeName, value, nil
+	default:
+		typeNames := make([]string, 0, n)
+		for _, v := range dataSources {
+			typeNames = append(typeNames, v.typeName)
+		}
+
+		return "", nil, fmt.Errorf("multiple data sources can look up the ARN: %s", strings.Join(typeNames, ", "))
+	}
+}
+
+// arnDataSources returns the service package's data sources that take an `arn` argument.
+func arnDataSources(ctx context.Context, meta *conns.AWSClient, sp conns.ServicePackage) ([]arnLookupCandidate, error) {
+	var dataSources []arnLookupCandidate
+
+	for _, v := range sp.FrameworkDataSources(ctx) {
+		inner, err := v.Factory(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		metadataResponse := datasource.MetadataResponse{}
+		inner.Metadata(ctx, datasource.MetadataRequest{}, &metadataResponse)
+
+		schemaResponse := datasource.SchemaResponse{}
+		inner.Schema(ctx, datasource.SchemaRequest{}, &schemaResponse)
+
+		if a, ok := schemaResponse.Schema.Attributes[names.AttrARN]; !ok || !(a.IsOptional() || a.IsRequired()) || !a.GetType().TerraformType(ctx).Is(tftypes.String) {
+			continue
+		}
+
+		dataSourceCtx := conns.NewDataSourceContext(ctx, sp.ServicePackageName(), v.Name)
+		dataSources = append(dataSources, arnLookupCandidate{
+			typeName: metadataResponse.TypeName,
+			read: func(arn string) (attr.Value, error) {
+				return readFrameworkDataSource(dataSourceCtx, meta, inner, schemaResponse.Schema, arn)
+			},
+		})
+	}
+
+	for _, v := range sp.SDKDataSources(ctx) {
+		r := v.Factory()
+
+		if s, ok := r.Schema[names.AttrARN]; !ok || !(s.Optional || s.Required) || s.Type != sdkschema.TypeString {
+			continue
+		}
+
+		dataSourceCtx := conns.NewDataSourceContext(ctx, sp.ServicePackageName(), v.Name)
+		dataSources = append(dataSources, arnLookupCandidate{
+			typeName: v.TypeName,
+			read: func(arn string) (attr.Value, error) {
+				return readSDKDataSource(dataSourceCtx, meta, r, arn)
+			},
+		})
+	}
+
+	return dataSources, nil
+}
+
+func readFrameworkDataSource(ctx context.Context, meta *conns.AWSClient, inner datasource.DataSourceWithConfigure, s schema.Schema, arn string) (attr.Value, error) {
+	configureResponse := datasource.ConfigureResponse{}
+	inner.Configure(ctx, datasource.ConfigureRequest{ProviderData: meta}, &configureResponse)
+
+	if err := fwdiag.DiagnosticsError(configureResponse.Diagnostics); err != nil {
+		return nil, err
+	}
+
+	// Only the `arn` argument is configured.
+	objectType, ok := s.Type().TerraformType(ctx).(tftypes.Object)
+	if !ok {
+		return nil, errors.New("unexpected schema type")
+	}
+
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, typ := range objectType.AttributeTypes {
+		if name == names.AttrARN {
+			values[name] = tftypes.NewValue(typ, arn)
+		} else {
+			values[name] = tftypes.NewValue(typ, nil)
+		}
+	}
+	config := tftypes.NewValue(objectType, values)
+
+	request := datasource.ReadRequest{
+		Config: tfsdk.Config{
+			Raw:    config,
+			Schema: s,
+		},
+	}
+	response := datasource.ReadResponse{
+		State: tfsdk.State{
+			Raw:    config.Copy(),
+			Schema: s,
+		},
+	}
+	inner.Read(ctx, request, &response)
+
+	if err := fwdiag.DiagnosticsError(response.Diagnostics); err != nil {
+		return nil, err
+	}
+
+	return fwflex.ValueFromTerraform(ctx, response.State.Raw)
+}
+
+func readSDKDataSource(ctx context.Context, meta *conns.AWSClient, r *sdkschema.Resource, arn string) (attr.Value, error) {
+	d := r.Data(nil)
+
+	if err := d.Set(names.AttrARN, arn); err != nil {
+		return nil, err
+	}
+
+	read := r.ReadWithoutTimeout
+	if read == nil {
+		read = r.ReadContext
+	}
+	if read == nil {
+		return nil, errors.New("no Read function")
+	}
+
+	if err := sdkdiag.DiagnosticsError(read(ctx, d, meta)); err != nil {
+		return nil, err
+	}
+
+	state := d.State()
+	if state == nil {
+		return nil, errors.New("no result")
+	}
+
+	v, err := state.AttrsAsObjectValue(r.CoreConfigSchema().ImpliedType())
+
+	if err != nil {
+		return nil, err
+	}
+
+	return attrValueFromCty(ctx, v)
+}
+
+// attrValueFromCty converts a cty value to a framework value by round-tripping via JSON.
+func attrValueFromCty(ctx context.Context, v cty.Value) (attr.Value, error) {
+	typeJSON, err := ctyjson.MarshalType(v.Type())
+
+	if err != nil {
+		return nil, err
+	}
+
+	valueJSON, err := ctyjson.Marshal(v, v.Type())
+
+	if err != nil {
+		return nil, err
+	}
+
+	typ, err := tftypes.ParseJSONType(typeJSON) //nolint:staticcheck // No alternative for converting from cty.
+	if err != nil {
+		return nil, err
+	}
+
+	tfValue, err := tftypes.ValueFromJSONWithOpts(valueJSON, typ, tftypes.ValueFromJSONOpts{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return fwflex.ValueFromTerraform(ctx, tfValue)
+}
+
+// arnResourceType returns the resource type from an ARN's resource part, e.g. "role" from "role/path/name".
+// The empty string is returned if the resource part has no resource type.
+func arnResourceType(resource string) string {
+	if i := strings.IndexAny(resource, "/:"); i > 0 {
+		return resource[:i]
+	}
+
+	return ""
+}
+
+// typeNameMatchesResourceType returns whether a Terraform type name matches an ARN resource type,
+// e.g. "aws_iam_saml_provider" matches "saml-provider".
+func typeNameMatchesResourceType(typeName, resourceType string) bool {
+	normalize := func(s string) string {
+		return strings.ToLower(strings.NewReplacer("-", "", "_", "").Replace(s))
+	}
+
+	return strings.HasSuffix(normalize(typeName), normalize(resourceType))
+}
@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package meta_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfmeta "github.com/hashicorp/terraform-provider-aws/internal/service/meta"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestARNResourceType(t *testing.T) {
+	t.Parallel()
+
+	for resource, want := range map[string]string{
+		"role/service-role/my-role":                    "role",
+		"function:my-function":                         "function",
+		"podidentityassociation/my-cluster/a-12345678": "podidentityassociation",
+		"my-topic":       "",
+		"/leading-slash": "",
+	} {
+		if got := tfmeta.ARNResourceType(resource); got != want {
+			t.Errorf("ARNResourceType(%q) = %q, want %q", resource, got, want)
+		}
+	}
+}
+
+func TestTypeNameMatchesResourceType(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		typeName     string
+		resourceType string
+		expected     bool
+	}{
+		{"aws_iam_policy", "policy", true},
+		{"aws_iam_saml_provider", "saml-provider", true},
+		{"aws_lb_target_group", "targetgroup", true},
+		{"aws_iam_policy", "role", false},
+		{"aws_iam_session_context", "assumed-role", false},
+	}
+
+	for _, testCase := range testCases {
+		if got, want := tfmeta.TypeNameMatchesResourceType(testCase.typeName, testCase.resourceType), testCase.expected; got != want {
+			t.Errorf("TypeNameMatchesResourceType(%q, %q) = %t, want %t", testCase.typeName, testCase.resourceType, got, want)
+		}
+	}
+}
+
+func TestAccMetaARNLookupDataSource_dataSource(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_arn_lookup.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccARNLookupDataSourceConfig_iamPolicy(),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(dataSourceName, tfjsonpath.New("data_source"), knownvalue.StringExact("aws_iam_policy")),
+					statecheck.ExpectKnownValue(dataSourceName, tfjsonpath.New(names.AttrAttributes).AtMapKey(names.AttrName), knownvalue.StringExact("ReadOnlyAccess")),
+					statecheck.ExpectKnownValue(dataSourceName, tfjsonpath.New(names.AttrAttributes).AtMapKey(names.AttrPath), knownvalue.StringExact("/")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccMetaARNLookupDataSource_cloudControl(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_arn_lookup.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccARNLookupDataSourceConfig_snsTopic(rName, false),
+				ExpectError: regexache.MustCompile(`no data source can look up the ARN`),
+			},
+			{
+				Config: testAccARNLookupDataSourceConfig_snsTopic(rName, true),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(dataSourceName, tfjsonpath.New("data_source"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(dataSourceName, tfjsonpath.New(names.AttrAttributes).AtMapKey("TopicName"), knownvalue.StringExact(rName)),
+				},
+			},
+		},
+	})
+}
+
+func testAccARNLookupDataSourceConfig_iamPolicy() string {
+	return `
+data "aws_partition" "current" {}
+
+data "aws_arn_lookup" "test" {
+  arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/ReadOnlyAccess"
+}
+`
+}
+
+func testAccARNLookupDataSourceConfig_snsTopic(rName string, cloudControl bool) string {
+	var typeName string
+	if cloudControl {
+		typeName = `cloudcontrol_type_name = "AWS::SNS::Topic"`
+	}
+
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+data "aws_arn_lookup" "test" {
+  arn = aws_sns_topic.test.arn
+
+  %[2]s
+}
+`, rName, typeName)
+}
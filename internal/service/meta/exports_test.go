@@ -5,6 +5,8 @@ package meta
 
 // Exports for use in tests only.
 var (
-	FindRegionByEC2Endpoint = findRegionByEC2Endpoint
-	FindRegionByName        = findRegionByName
+	ARNResourceType             = arnResourceType
+	FindRegionByEC2Endpoint     = findRegionByEC2Endpoint
+	FindRegionByName            = findRegionByName
+	TypeNameMatchesResourceType = typeNameMatchesResourceType
 )
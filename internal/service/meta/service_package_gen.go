@@ -17,6 +17,10 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			Factory: newARNDataSource,
 			Name:    "ARN",
 		},
+		{
+			Factory: newARNLookupDataSource,
+			Name:    "ARN Lookup",
+		},
 		{
 			Factory: newBillingServiceAccountDataSource,
 			Name:    "Billing Service Account",
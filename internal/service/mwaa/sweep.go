@@ -15,7 +15,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_mwaa_environment", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_mwaa_environment", &resource.Sweeper{
 		Name: "aws_mwaa_environment",
 		F:    sweepEnvironment,
 	})
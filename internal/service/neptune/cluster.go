@@ -48,6 +48,7 @@ const (
 
 // @SDKResource("aws_neptune_cluster", name="Cluster")
 // @Tags(identifierAttribute="arn")
+// @Stateful
 func resourceCluster() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceClusterCreate,
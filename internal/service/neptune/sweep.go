@@ -18,7 +18,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_neptune_cluster", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_neptune_cluster", &resource.Sweeper{
 		Name: "aws_neptune_cluster",
 		F:    sweepClusters,
 		Dependencies: []string{
@@ -26,12 +26,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_neptune_cluster_instance", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_neptune_cluster_instance", &resource.Sweeper{
 		Name: "aws_neptune_cluster_instance",
 		F:    sweepClusterInstances,
 	})
 
-	resource.AddTestSweepers("aws_neptune_cluster_parameter_group", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_neptune_cluster_parameter_group", &resource.Sweeper{
 		Name: "aws_neptune_cluster_parameter_group",
 		F:    sweepClusterParameterGroups,
 		Dependencies: []string{
@@ -39,7 +39,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_neptune_cluster_snapshot", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_neptune_cluster_snapshot", &resource.Sweeper{
 		Name: "aws_neptune_cluster_snapshot",
 		F:    sweepClusterSnapshots,
 		Dependencies: []string{
@@ -47,12 +47,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_neptune_event_subscription", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_neptune_event_subscription", &resource.Sweeper{
 		Name: "aws_neptune_event_subscription",
 		F:    sweepEventSubscriptions,
 	})
 
-	resource.AddTestSweepers("aws_neptune_global_cluster", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_neptune_global_cluster", &resource.Sweeper{
 		Name: "aws_neptune_global_cluster",
 		F:    sweepGlobalClusters,
 		Dependencies: []string{
@@ -60,7 +60,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_neptune_parameter_group", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_neptune_parameter_group", &resource.Sweeper{
 		Name: "aws_neptune_parameter_group",
 		F:    sweepParameterGroups,
 		Dependencies: []string{
@@ -68,7 +68,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_neptune_subnet_group", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_neptune_subnet_group", &resource.Sweeper{
 		Name: "aws_neptune_subnet_group",
 		F:    sweepSubnetGroups,
 		Dependencies: []string{
@@ -35,6 +35,7 @@ import (
 
 // @SDKResource("aws_opensearch_domain", name="Domain")
 // @Tags(identifierAttribute="id")
+// @Stateful
 func resourceDomain() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceDomainCreate,
@@ -18,7 +18,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_opensearch_domain", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_opensearch_domain", &resource.Sweeper{
 		Name: "aws_opensearch_domain",
 		F:    sweepDomains,
 		Dependencies: []string{
@@ -27,12 +27,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_opensearch_inbound_connection_accepter", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_opensearch_inbound_connection_accepter", &resource.Sweeper{
 		Name: "aws_opensearch_inbound_connection_accepter",
 		F:    sweepInboundConnections,
 	})
 
-	resource.AddTestSweepers("aws_opensearch_outbound_connection", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_opensearch_outbound_connection", &resource.Sweeper{
 		Name: "aws_opensearch_outbound_connection",
 		F:    sweepOutboundConnections,
 	})
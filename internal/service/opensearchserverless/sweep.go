@@ -19,23 +19,23 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_opensearchserverless_access_policy", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_opensearchserverless_access_policy", &resource.Sweeper{
 		Name: "aws_opensearchserverless_access_policy",
 		F:    sweepAccessPolicies,
 	})
-	resource.AddTestSweepers("aws_opensearchserverless_collection", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_opensearchserverless_collection", &resource.Sweeper{
 		Name: "aws_opensearchserverless_collection",
 		F:    sweepCollections,
 	})
-	resource.AddTestSweepers("aws_opensearchserverless_security_config", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_opensearchserverless_security_config", &resource.Sweeper{
 		Name: "aws_opensearchserverless_security_config",
 		F:    sweepSecurityConfigs,
 	})
-	resource.AddTestSweepers("aws_opensearchserverless_security_policy", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_opensearchserverless_security_policy", &resource.Sweeper{
 		Name: "aws_opensearchserverless_security_policy",
 		F:    sweepSecurityPolicies,
 	})
-	resource.AddTestSweepers("aws_opensearchserverless_vpc_endpoint", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_opensearchserverless_vpc_endpoint", &resource.Sweeper{
 		Name: "aws_opensearchserverless_vpc_endpoint",
 		F:    sweepVPCEndpoints,
 	})
@@ -0,0 +1,509 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package organizations
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+const (
+	policyTypeAIServicesOptOutPolicy = "AISERVICES_OPT_OUT_POLICY"
+	policyTypeBackupPolicy           = "BACKUP_POLICY"
+	policyTypeResourceControlPolicy  = "RESOURCE_CONTROL_POLICY"
+	policyTypeServiceControlPolicy   = "SERVICE_CONTROL_POLICY"
+	policyTypeTagPolicy              = "TAG_POLICY"
+)
+
+// policyDocumentSizeLimits are the maximum sizes, in characters, of each type of policy.
+// See https://docs.aws.amazon.com/organizations/latest/userguide/orgs_reference_limits.html#min-max-values.
+var policyDocumentSizeLimits = map[string]int{
+	policyTypeAIServicesOptOutPolicy: 2500,
+	policyTypeBackupPolicy:           10000,
+	policyTypeResourceControlPolicy:  5120,
+	policyTypeServiceControlPolicy:   5120,
+	policyTypeTagPolicy:              10000,
+}
+
+func policyDocumentTypes() []string {
+	return []string{
+		policyTypeAIServicesOptOutPolicy,
+		policyTypeBackupPolicy,
+		policyTypeResourceControlPolicy,
+		policyTypeServiceControlPolicy,
+		policyTypeTagPolicy,
+	}
+}
+
+// isStatementPolicyType returns whether policies of the specified type are written in the IAM policy language.
+func isStatementPolicyType(policyType string) bool {
+	return policyType == policyTypeServiceControlPolicy || policyType == policyTypeResourceControlPolicy
+}
+
+type policyDocument struct {
+	Version   string                     `json:",omitempty"`
+	Statement []*policyDocumentStatement `json:",omitempty"`
+}
+
+type policyDocumentStatement struct {
+	Sid         string                     `json:",omitempty"`
+	Effect      string                     `json:",omitempty"`
+	Principal   any                        `json:",omitempty"`
+	Actions     policyDocumentStringOrList `json:"Action,omitempty"`
+	NotActions  policyDocumentStringOrList `json:"NotAction,omitempty"`
+	Resources   policyDocumentStringOrList `json:"Resource,omitempty"`
+	NotResource policyDocumentStringOrList `json:"NotResource,omitempty"`
+	Condition   policyDocumentConditions   `json:",omitempty"`
+}
+
+// policyDocumentStringOrList marshals to a single string when it has exactly one element.
+type policyDocumentStringOrList []string
+
+func (v policyDocumentStringOrList) MarshalJSON() ([]byte, error) {
+	if len(v) == 1 {
+		return json.Marshal(v[0])
+	}
+
+	return json.Marshal([]string(v))
+}
+
+// policyDocumentConditions maps operator to condition key to values.
+type policyDocumentConditions map[string]map[string]policyDocumentStringOrList
+
+// minifyPolicyDocument returns the policy document with all insignificant whitespace removed.
+func minifyPolicyDocument(document string) (string, error) {
+	var buf bytes.Buffer
+
+	if err := json.Compact(&buf, []byte(document)); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
+// mergePolicyDocumentStatements merges statements that differ only in their actions.
+// The merged statement keeps the Sid of the first statement merged into it.
+func mergePolicyDocumentStatements(statements []*policyDocumentStatement) []*policyDocumentStatement {
+	var output []*policyDocumentStatement
+
+	for _, statement := range statements {
+		merged := false
+
+		if len(statement.Actions) > 0 {
+			for _, v := range output {
+				if len(v.Actions) > 0 && statement.Effect == v.Effect &&
+					slices.Equal(sortedCopy(statement.Resources), sortedCopy(v.Resources)) &&
+					slices.Equal(sortedCopy(statement.NotResource), sortedCopy(v.NotResource)) &&
+					reflect.DeepEqual(statement.Principal, v.Principal) &&
+					reflect.DeepEqual(statement.Condition, v.Condition) {
+					v.Actions = append(v.Actions, statement.Actions...)
+					merged = true
+
+					break
+				}
+			}
+		}
+
+		if !merged {
+			output = append(output, statement)
+		}
+	}
+
+	for _, v := range output {
+		v.Actions = dedupeActions(v.Actions)
+	}
+
+	return output
+}
+
+// dedupeActions removes duplicate actions and actions matched by a wildcard action in the same list.
+// Action names are case-insensitive. The result is safe for any effect, as it matches exactly the same actions.
+func dedupeActions(actions []string) []string {
+	var output []string
+
+	for i, action := range actions {
+		covered := false
+
+		for j, other := range actions {
+			if i == j {
+				continue
+			}
+
+			if strings.EqualFold(action, other) {
+				// Keep the first of equal actions.
+				if j < i {
+					covered = true
+					break
+				}
+
+				continue
+			}
+
+			if strings.ContainsAny(other, "*?") && wildcardMatchFold(other, action) {
+				covered = true
+				break
+			}
+		}
+
+		if !covered {
+			output = append(output, action)
+		}
+	}
+
+	return output
+}
+
+// compressActions replaces groups of actions from the same service that share a name prefix with a single
+// wildcard action, e.g. `s3:GetObject` and `s3:GetObjectTagging` become `s3:GetObject*`.
+// A wildcard can match actions that were not listed, so compression must never be applied to Deny statements,
+// whose effect it would broaden. Applied to Allow statements it allows more actions, which must be opted in to.
+func compressActions(actions []string, minPrefixLength int) []string {
+	type group struct {
+		service string
+		names   []string
+	}
+
+	var (
+		groups []*group
+		output []string
+	)
+
+	for _, action := range actions {
+		service, name, ok := strings.Cut(action, ":")
+
+		if !ok || strings.ContainsAny(name, "*?") {
+			output = append(output, action)
+			continue
+		}
+
+		idx := slices.IndexFunc(groups, func(g *group) bool {
+			return strings.EqualFold(g.service, service)
+		})
+
+		if idx == -1 {
+			groups = append(groups, &group{service: service})
+			idx = len(groups) - 1
+		}
+
+		groups[idx].names = append(groups[idx].names, name)
+	}
+
+	for _, g := range groups {
+		names := g.names
+		slices.SortFunc(names, func(a, b string) int {
+			return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+		})
+
+		// Greedily extend runs of (sorted) names sharing a sufficiently long common prefix.
+		for i := 0; i < len(names); {
+			prefix := names[i]
+			j := i + 1
+
+			for ; j < len(names); j++ {
+				p := commonPrefixFold(prefix, names[j])
+
+				if len(p) < minPrefixLength {
+					break
+				}
+
+				prefix = p
+			}
+
+			if j-i > 1 {
+				output = append(output, g.service+":"+prefix+"*")
+			} else {
+				output = append(output, g.service+":"+names[i])
+			}
+
+			i = j
+		}
+	}
+
+	return output
+}
+
+func commonPrefixFold(a, b string) string {
+	n := min(len(a), len(b))
+
+	for i := 0; i < n; i++ {
+		if !strings.EqualFold(a[i:i+1], b[i:i+1]) {
+			return a[:i]
+		}
+	}
+
+	return a[:n]
+}
+
+// wildcardMatchFold reports whether s matches pattern, in which `*` matches any sequence of characters
+// and `?` matches any single character. Matching is case-insensitive.
+func wildcardMatchFold(pattern, s string) bool {
+	pattern, s = strings.ToLower(pattern), strings.ToLower(s)
+
+	for len(pattern) > 0 {
+		switch pattern[0] {
+		case '*':
+			for pattern != "" && pattern[0] == '*' {
+				pattern = pattern[1:]
+			}
+
+			if pattern == "" {
+				return true
+			}
+
+			for i := 0; i <= len(s); i++ {
+				if wildcardMatchFold(pattern, s[i:]) {
+					return true
+				}
+			}
+
+			return false
+		case '?':
+			if s == "" {
+				return false
+			}
+		default:
+			if s == "" || pattern[0] != s[0] {
+				return false
+			}
+		}
+
+		pattern, s = pattern[1:], s[1:]
+	}
+
+	return s == ""
+}
+
+func sortedCopy(s []string) []string {
+	s = slices.Clone(s)
+	slices.Sort(s)
+
+	return s
+}
+
+// policyDocumentLockoutActions are the actions which, if denied to every principal in a member account,
+// prevent the management account from administering that account through its cross-account access role.
+var policyDocumentLockoutActions = []string{
+	"iam:AttachRolePolicy",
+	"iam:PutRolePolicy",
+	"iam:UpdateAssumeRolePolicy",
+	"sts:AssumeRole",
+}
+
+// checkPolicyDocumentLockout returns a warning for each Deny statement that denies administrative access to
+// every principal, including the role the management account uses to access member accounts.
+func checkPolicyDocumentLockout(statements []*policyDocumentStatement, roleName string) []string {
+	var warnings []string
+
+	for i, statement := range statements {
+		if statement.Effect != "Deny" || len(statement.NotResource) > 0 {
+			continue
+		}
+
+		if len(statement.Resources) > 0 && !slices.Contains(statement.Resources, "*") {
+			continue
+		}
+
+		// A condition on the principal (or any other condition) may exempt the management account's role.
+		if len(statement.Condition) > 0 && conditionsReference(statement.Condition, roleName) {
+			continue
+		}
+
+		var denied []string
+		for _, action := range policyDocumentLockoutActions {
+			if statementMatchesAction(statement, action) {
+				denied = append(denied, action)
+			}
+		}
+
+		if len(denied) == 0 {
+			continue
+		}
+
+		name := statement.Sid
+		if name == "" {
+			name = fmt.Sprintf("#%d", i+1)
+		}
+
+		warnings = append(warnings, fmt.Sprintf("statement %s denies %s to all principals, including the %q role that the management account uses to administer member accounts. "+
+			"Add a condition such as {\"ArnNotLike\": {\"aws:PrincipalArn\": \"arn:aws:iam::*:role/%[3]s\"}} to exempt it.", name, strings.Join(denied, ", "), roleName))
+	}
+
+	return warnings
+}
+
+func statementMatchesAction(statement *policyDocumentStatement, action string) bool {
+	if len(statement.NotActions) > 0 {
+		return !slices.ContainsFunc(statement.NotActions, func(v string) bool {
+			return wildcardMatchFold(v, action)
+		})
+	}
+
+	return slices.ContainsFunc(statement.Actions, func(v string) bool {
+		return wildcardMatchFold(v, action)
+	})
+}
+
+func conditionsReference(conditions policyDocumentConditions, roleName string) bool {
+	for _, keys := range conditions {
+		for _, values := range keys {
+			for _, v := range values {
+				if strings.Contains(strings.ToLower(v), strings.ToLower(roleName)) {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+var (
+	tagPolicyOperators = []string{"@@assign", "@@append", "@@remove"}
+	tagPolicyTagFields = []string{"enforced_for", "report_required_tag_for", "tag_key", "tag_value"}
+)
+
+const tagPolicyChildOperators = "@@operators_allowed_for_child_policies"
+
+// validateTagPolicyDocument validates the structure of a tag policy.
+// See https://docs.aws.amazon.com/organizations/latest/userguide/orgs_manage_policies_tag-policies-syntax-reference.html.
+func validateTagPolicyDocument(document string) []error {
+	var errs []error
+
+	var root map[string]any
+	if err := json.Unmarshal([]byte(document), &root); err != nil {
+		return []error{fmt.Errorf("invalid JSON: %w", err)}
+	}
+
+	for k := range root {
+		if k != "tags" {
+			errs = append(errs, fmt.Errorf("unsupported top-level key %q, expected \"tags\"", k))
+		}
+	}
+
+	tags, ok := root["tags"].(map[string]any)
+	if !ok {
+		return append(errs, errors.New(`"tags" must be an object`))
+	}
+
+	for tag, v := range tags {
+		if tag == tagPolicyChildOperators {
+			errs = append(errs, validateTagPolicyChildOperators(tag, v)...)
+			continue
+		}
+
+		fields, ok := v.(map[string]any)
+		if !ok {
+			errs = append(errs, fmt.Errorf("tags.%s: must be an object", tag))
+			continue
+		}
+
+		for field, v := range fields {
+			path := fmt.Sprintf("tags.%s.%s", tag, field)
+
+			if field == tagPolicyChildOperators {
+				errs = append(errs, validateTagPolicyChildOperators(path, v)...)
+				continue
+			}
+
+			if !slices.Contains(tagPolicyTagFields, field) {
+				errs = append(errs, fmt.Errorf("%s: unsupported field, expected one of %s", path, strings.Join(tagPolicyTagFields, ", ")))
+				continue
+			}
+
+			operators, ok := v.(map[string]any)
+			if !ok {
+				errs = append(errs, fmt.Errorf("%s: must be an object", path))
+				continue
+			}
+
+			for operator, v := range operators {
+				path := path + "." + operator
+
+				if operator == tagPolicyChildOperators {
+					errs = append(errs, validateTagPolicyChildOperators(path, v)...)
+					continue
+				}
+
+				if !slices.Contains(tagPolicyOperators, operator) {
+					errs = append(errs, fmt.Errorf("%s: unsupported operator, expected one of %s", path, strings.Join(tagPolicyOperators, ", ")))
+					continue
+				}
+
+				if field == "tag_key" {
+					if operator != "@@assign" {
+						errs = append(errs, fmt.Errorf("%s: tag_key only supports @@assign", path))
+						continue
+					}
+
+					key, ok := v.(string)
+					if !ok {
+						errs = append(errs, fmt.Errorf("%s: must be a string", path))
+						continue
+					}
+
+					if !strings.EqualFold(key, tag) {
+						errs = append(errs, fmt.Errorf("%s: %q must match the tag policy key %q, ignoring case", path, key, tag))
+					}
+
+					continue
+				}
+
+				values, ok := v.([]any)
+				if !ok {
+					errs = append(errs, fmt.Errorf("%s: must be a list of strings", path))
+					continue
+				}
+
+				for i, v := range values {
+					s, ok := v.(string)
+					if !ok {
+						errs = append(errs, fmt.Errorf("%s[%d]: must be a string", path, i))
+						continue
+					}
+
+					if (field == "enforced_for" || field == "report_required_tag_for") && !strings.Contains(s, ":") {
+						errs = append(errs, fmt.Errorf("%s[%d]: %q must be of the form service:resource_type", path, i, s))
+					}
+				}
+			}
+		}
+	}
+
+	return errs
+}
+
+func validateTagPolicyChildOperators(path string, v any) []error {
+	values, ok := v.(map[string]any)
+	if !ok {
+		return []error{fmt.Errorf("%s: must be an object", path)}
+	}
+
+	var errs []error
+
+	for k, v := range values {
+		if k != "@@assign" {
+			errs = append(errs, fmt.Errorf("%s.%s: unsupported operator, expected @@assign", path, k))
+			continue
+		}
+
+		operators, ok := v.([]any)
+		if !ok {
+			errs = append(errs, fmt.Errorf("%s.%s: must be a list of strings", path, k))
+			continue
+		}
+
+		for i, v := range operators {
+			if s, ok := v.(string); !ok || (s != "@@none" && s != "@@all" && !slices.Contains(tagPolicyOperators, s)) {
+				errs = append(errs, fmt.Errorf("%s.%s[%d]: must be one of @@all, @@none, %s", path, k, i, strings.Join(tagPolicyOperators, ", ")))
+			}
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,374 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package organizations
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_organizations_policy_document", name="Policy Document")
+func newPolicyDocumentDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &policyDocumentDataSource{}, nil
+}
+
+const (
+	policyDocumentDefaultManagementRoleName = "OrganizationAccountAccessRole"
+	policyDocumentDefaultMinPrefixLength    = 4
+)
+
+type policyDocumentDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*policyDocumentDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_organizations_policy_document"
+}
+
+func (d *policyDocumentDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"compress_allow_actions": schema.BoolAttribute{
+				Optional: true,
+			},
+			names.AttrContent: schema.StringAttribute{
+				CustomType: jsontypes.NormalizedType{},
+				Optional:   true,
+			},
+			names.AttrJSON: schema.StringAttribute{
+				Computed: true,
+			},
+			"management_role_name": schema.StringAttribute{
+				Optional: true,
+			},
+			"merge_statements": schema.BoolAttribute{
+				Optional: true,
+			},
+			"min_compress_prefix_length": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"minified_json": schema.StringAttribute{
+				Computed: true,
+			},
+			"size": schema.Int64Attribute{
+				Computed: true,
+			},
+			"size_limit": schema.Int64Attribute{
+				Computed: true,
+			},
+			names.AttrType: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(policyDocumentTypes()...),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"statement": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[policyDocumentStatementModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"actions": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"effect": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("Allow", "Deny"),
+							},
+						},
+						"not_actions": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+							Validators: []validator.Set{
+								setvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("actions")),
+							},
+						},
+						"not_resources": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						names.AttrResources: schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"sid": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						names.AttrCondition: schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[policyDocumentConditionModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"test": schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrValues: schema.ListAttribute{
+										CustomType:  fwtypes.ListOfStringType,
+										ElementType: types.StringType,
+										Required:    true,
+										Validators: []validator.List{
+											listvalidator.SizeAtLeast(1),
+										},
+									},
+									"variable": schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *policyDocumentDataSource) ConfigValidators(context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot(names.AttrContent),
+			path.MatchRoot("statement"),
+		),
+	}
+}
+
+func (d *policyDocumentDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data policyDocumentDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	policyType := policyTypeServiceControlPolicy
+	if !data.Type.IsNull() {
+		policyType = data.Type.ValueString()
+	}
+
+	var document string
+
+	if isStatementPolicyType(policyType) {
+		if data.Statements.IsNull() {
+			response.Diagnostics.AddAttributeError(path.Root("statement"), "Missing Attribute Configuration", fmt.Sprintf("%s policies must be built from statement blocks", policyType))
+
+			return
+		}
+
+		statements, err := data.expandStatements(ctx, policyType)
+
+		if err != nil {
+			response.Diagnostics.AddError("building Organizations policy document", err.Error())
+
+			return
+		}
+
+		if data.MergeStatements.ValueBool() {
+			statements = mergePolicyDocumentStatements(statements)
+		}
+
+		// Prefix compression broadens a statement's actions, so it's never applied to Deny statements.
+		if data.CompressAllowActions.ValueBool() {
+			minPrefixLength := policyDocumentDefaultMinPrefixLength
+			if !data.MinCompressPrefixLength.IsNull() {
+				minPrefixLength = int(data.MinCompressPrefixLength.ValueInt64())
+			}
+
+			for _, v := range statements {
+				if v.Effect == "Allow" {
+					v.Actions = compressActions(dedupeActions(v.Actions), minPrefixLength)
+				}
+			}
+		}
+
+		roleName := policyDocumentDefaultManagementRoleName
+		if !data.ManagementRoleName.IsNull() {
+			roleName = data.ManagementRoleName.ValueString()
+		}
+
+		for _, warning := range checkPolicyDocumentLockout(statements, roleName) {
+			response.Diagnostics.AddAttributeWarning(path.Root("statement"), "Policy may lock out the management account", warning)
+		}
+
+		bytes, err := json.MarshalIndent(&policyDocument{
+			Version:   "2012-10-17",
+			Statement: statements,
+		}, "", "  ")
+
+		if err != nil {
+			response.Diagnostics.AddError("marshalling Organizations policy document", err.Error())
+
+			return
+		}
+
+		document = string(bytes)
+	} else {
+		if data.Content.IsNull() {
+			response.Diagnostics.AddAttributeError(path.Root(names.AttrContent), "Missing Attribute Configuration", fmt.Sprintf("%s policies must be specified with content", policyType))
+
+			return
+		}
+
+		document = data.Content.ValueString()
+
+		if policyType == policyTypeTagPolicy {
+			for _, err := range validateTagPolicyDocument(document) {
+				response.Diagnostics.AddAttributeError(path.Root(names.AttrContent), "Invalid Tag Policy", err.Error())
+			}
+
+			if response.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	minified, err := minifyPolicyDocument(document)
+
+	if err != nil {
+		response.Diagnostics.AddError("minifying Organizations policy document", err.Error())
+
+		return
+	}
+
+	size, sizeLimit := len(minified), policyDocumentSizeLimits[policyType]
+
+	if size > sizeLimit {
+		response.Diagnostics.AddError("Organizations policy document too large",
+			fmt.Sprintf("the minified %s is %d characters, which exceeds the limit of %d characters", policyType, size, sizeLimit))
+
+		return
+	}
+
+	data.JSON = fwflex.StringValueToFramework(ctx, document)
+	data.MinifiedJSON = fwflex.StringValueToFramework(ctx, minified)
+	data.Size = types.Int64Value(int64(size))
+	data.SizeLimit = types.Int64Value(int64(sizeLimit))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type policyDocumentDataSourceModel struct {
+	CompressAllowActions    types.Bool                                                    `tfsdk:"compress_allow_actions"`
+	Content                 jsontypes.Normalized                                          `tfsdk:"content"`
+	JSON                    types.String                                                  `tfsdk:"json"`
+	ManagementRoleName      types.String                                                  `tfsdk:"management_role_name"`
+	MergeStatements         types.Bool                                                    `tfsdk:"merge_statements"`
+	MinCompressPrefixLength types.Int64                                                   `tfsdk:"min_compress_prefix_length"`
+	MinifiedJSON            types.String                                                  `tfsdk:"minified_json"`
+	Size                    types.Int64                                                   `tfsdk:"size"`
+	SizeLimit               types.Int64                                                   `tfsdk:"size_limit"`
+	Statements              fwtypes.ListNestedObjectValueOf[policyDocumentStatementModel] `tfsdk:"statement"`
+	Type                    types.String                                                  `tfsdk:"type"`
+}
+
+func (m *policyDocumentDataSourceModel) expandStatements(ctx context.Context, policyType string) ([]*policyDocumentStatement, error) {
+	models, diags := m.Statements.ToSlice(ctx)
+	if diags.HasError() {
+		return nil, fmt.Errorf("reading statements: %v", diags)
+	}
+
+	statements := make([]*policyDocumentStatement, 0, len(models))
+
+	for i, v := range models {
+		statement := &policyDocumentStatement{
+			Actions:     policyDocumentStringOrList(fwflex.ExpandFrameworkStringValueSet(ctx, v.Actions)),
+			Effect:      "Allow",
+			NotActions:  policyDocumentStringOrList(fwflex.ExpandFrameworkStringValueSet(ctx, v.NotActions)),
+			NotResource: policyDocumentStringOrList(fwflex.ExpandFrameworkStringValueSet(ctx, v.NotResources)),
+			Resources:   policyDocumentStringOrList(fwflex.ExpandFrameworkStringValueSet(ctx, v.Resources)),
+			Sid:         v.Sid.ValueString(),
+		}
+
+		if !v.Effect.IsNull() {
+			statement.Effect = v.Effect.ValueString()
+		}
+
+		if len(statement.Actions) == 0 && len(statement.NotActions) == 0 {
+			return nil, fmt.Errorf("statement #%d: one of actions or not_actions must be specified", i+1)
+		}
+
+		switch policyType {
+		case policyTypeResourceControlPolicy:
+			// RCPs apply to every principal and only support Deny statements.
+			if statement.Effect != "Deny" {
+				return nil, fmt.Errorf("statement #%d: %s statements must have effect Deny", i+1, policyType)
+			}
+
+			statement.Principal = "*"
+
+			if len(statement.Resources) == 0 && len(statement.NotResource) == 0 {
+				statement.Resources = []string{"*"}
+			}
+		case policyTypeServiceControlPolicy:
+			// In an SCP, Allow statements may only use a resource of "*".
+			if len(statement.Resources) == 0 && len(statement.NotResource) == 0 {
+				statement.Resources = []string{"*"}
+			}
+		}
+
+		conditions, d := v.Conditions.ToSlice(ctx)
+		if d.HasError() {
+			return nil, fmt.Errorf("reading statement #%d conditions: %v", i+1, d)
+		}
+
+		if len(conditions) > 0 {
+			statement.Condition = make(policyDocumentConditions)
+		}
+
+		for _, v := range conditions {
+			test, variable := v.Test.ValueString(), v.Variable.ValueString()
+
+			if _, ok := statement.Condition[test]; !ok {
+				statement.Condition[test] = make(map[string]policyDocumentStringOrList)
+			}
+
+			statement.Condition[test][variable] = append(statement.Condition[test][variable], fwflex.ExpandFrameworkStringValueList(ctx, v.Values)...)
+		}
+
+		statements = append(statements, statement)
+	}
+
+	return statements, nil
+}
+
+type policyDocumentStatementModel struct {
+	Actions      fwtypes.SetValueOf[types.String]                              `tfsdk:"actions"`
+	Conditions   fwtypes.ListNestedObjectValueOf[policyDocumentConditionModel] `tfsdk:"condition"`
+	Effect       types.String                                                  `tfsdk:"effect"`
+	NotActions   fwtypes.SetValueOf[types.String]                              `tfsdk:"not_actions"`
+	NotResources fwtypes.SetValueOf[types.String]                              `tfsdk:"not_resources"`
+	Resources    fwtypes.SetValueOf[types.String]                              `tfsdk:"resources"`
+	Sid          types.String                                                  `tfsdk:"sid"`
+}
+
+type policyDocumentConditionModel struct {
+	Test     types.String                      `tfsdk:"test"`
+	Values   fwtypes.ListValueOf[types.String] `tfsdk:"values"`
+	Variable types.String                      `tfsdk:"variable"`
+}
@@ -0,0 +1,170 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package organizations_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccOrganizationsPolicyDocumentDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_organizations_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OrganizationsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyDocumentDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "minified_json", `{"Version":"2012-10-17","Statement":[{"Sid":"DenyS3","Effect":"Deny","Action":["s3:DeleteBucket","s3:DeleteBucketPolicy"],"Resource":"*"},{"Sid":"DenyEC2","Effect":"Deny","Action":"ec2:TerminateInstances","Resource":"*","Condition":{"StringNotEquals":{"aws:RequestedRegion":["eu-west-1","us-east-1"]}}}]}`),
+					resource.TestCheckResourceAttr(dataSourceName, "size", "304"),
+					resource.TestCheckResourceAttr(dataSourceName, "size_limit", "5120"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOrganizationsPolicyDocumentDataSource_mergeAndCompress(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_organizations_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OrganizationsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyDocumentDataSourceConfig_mergeAndCompress,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "minified_json", `{"Version":"2012-10-17","Statement":[{"Sid":"AllowS3","Effect":"Allow","Action":"s3:GetObject*","Resource":"*"},{"Sid":"DenyS3","Effect":"Deny","Action":["s3:DeleteBucket","s3:DeleteBucketPolicy","ec2:TerminateInstances"],"Resource":"*"}]}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOrganizationsPolicyDocumentDataSource_tooLarge(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OrganizationsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPolicyDocumentDataSourceConfig_tooLarge,
+				ExpectError: regexache.MustCompile(`exceeds the limit of 5120 characters`),
+			},
+		},
+	})
+}
+
+func TestAccOrganizationsPolicyDocumentDataSource_tagPolicy(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_organizations_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OrganizationsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPolicyDocumentDataSourceConfig_tagPolicy("Owner"),
+				ExpectError: regexache.MustCompile(`must match the tag policy key`),
+			},
+			{
+				Config: testAccPolicyDocumentDataSourceConfig_tagPolicy("CostCenter"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "minified_json", `{"tags":{"costcenter":{"tag_key":{"@@assign":"CostCenter"},"tag_value":{"@@assign":["100","200"]}}}}`),
+					resource.TestCheckResourceAttr(dataSourceName, "size_limit", "10000"),
+				),
+			},
+		},
+	})
+}
+
+const testAccPolicyDocumentDataSourceConfig_basic = `
+data "aws_organizations_policy_document" "test" {
+  statement {
+    sid       = "DenyS3"
+    effect    = "Deny"
+    actions   = ["s3:DeleteBucket", "s3:DeleteBucketPolicy"]
+    resources = ["*"]
+  }
+
+  statement {
+    sid     = "DenyEC2"
+    effect  = "Deny"
+    actions = ["ec2:TerminateInstances"]
+
+    condition {
+      test     = "StringNotEquals"
+      variable = "aws:RequestedRegion"
+      values   = ["eu-west-1", "us-east-1"]
+    }
+  }
+}
+`
+
+const testAccPolicyDocumentDataSourceConfig_mergeAndCompress = `
+data "aws_organizations_policy_document" "test" {
+  merge_statements       = true
+  compress_allow_actions = true
+
+  statement {
+    sid     = "AllowS3"
+    actions = ["s3:GetObject", "s3:GetObjectTagging"]
+  }
+
+  statement {
+    sid     = "DenyS3"
+    effect  = "Deny"
+    actions = ["s3:DeleteBucket", "s3:DeleteBucketPolicy"]
+  }
+
+  statement {
+    sid     = "DenyEC2"
+    effect  = "Deny"
+    actions = ["ec2:TerminateInstances", "s3:DeleteBucket"]
+  }
+}
+`
+
+const testAccPolicyDocumentDataSourceConfig_tooLarge = `
+data "aws_organizations_policy_document" "test" {
+  statement {
+    effect  = "Deny"
+    actions = [for i in range(300) : "s3:DeleteBucket${i}"]
+  }
+}
+`
+
+func testAccPolicyDocumentDataSourceConfig_tagPolicy(tagKey string) string {
+	return `
+data "aws_organizations_policy_document" "test" {
+  type = "TAG_POLICY"
+
+  content = jsonencode({
+    tags = {
+      costcenter = {
+        tag_key = {
+          "@@assign" = "` + tagKey + `"
+        }
+        tag_value = {
+          "@@assign" = ["100", "200"]
+        }
+      }
+    }
+  })
+}
+`
+}
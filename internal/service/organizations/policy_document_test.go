@@ -0,0 +1,303 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package organizations
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestDedupeActions(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    []string
+		expected []string
+	}{
+		"empty": {},
+		"no duplicates": {
+			input:    []string{"s3:GetObject", "s3:PutObject"},
+			expected: []string{"s3:GetObject", "s3:PutObject"},
+		},
+		"case-insensitive duplicates": {
+			input:    []string{"s3:GetObject", "S3:getobject", "s3:PutObject"},
+			expected: []string{"s3:GetObject", "s3:PutObject"},
+		},
+		"covered by wildcard": {
+			input:    []string{"s3:GetObject", "s3:Get*", "ec2:RunInstances"},
+			expected: []string{"s3:Get*", "ec2:RunInstances"},
+		},
+		"wildcard covered by wildcard": {
+			input:    []string{"s3:Get*", "s3:*"},
+			expected: []string{"s3:*"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := dedupeActions(testCase.input), testCase.expected; !slices.Equal(got, want) {
+				t.Errorf("dedupeActions(%v) = %v, want %v", testCase.input, got, want)
+			}
+		})
+	}
+}
+
+func TestCompressActions(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input           []string
+		minPrefixLength int
+		expected        []string
+	}{
+		"empty": {
+			minPrefixLength: 4,
+		},
+		"single": {
+			input:           []string{"s3:GetObject"},
+			minPrefixLength: 4,
+			expected:        []string{"s3:GetObject"},
+		},
+		"common prefix": {
+			input:           []string{"s3:GetObjectTagging", "s3:GetObject", "s3:GetObjectAcl"},
+			minPrefixLength: 4,
+			expected:        []string{"s3:GetObject*"},
+		},
+		"prefix too short": {
+			input:           []string{"s3:GetBucketAcl", "s3:GetObject"},
+			minPrefixLength: 4,
+			expected:        []string{"s3:GetBucketAcl", "s3:GetObject"},
+		},
+		"multiple services": {
+			input:           []string{"ec2:TerminateInstances", "s3:DeleteBucket", "ec2:TerminateClientVpnConnections", "s3:DeleteBucketPolicy"},
+			minPrefixLength: 6,
+			expected:        []string{"ec2:Terminate*", "s3:DeleteBucket*"},
+		},
+		"wildcards untouched": {
+			input:           []string{"iam:*", "s3:PutObject", "s3:PutObjectAcl"},
+			minPrefixLength: 4,
+			expected:        []string{"iam:*", "s3:PutObject*"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := compressActions(testCase.input, testCase.minPrefixLength), testCase.expected; !slices.Equal(got, want) {
+				t.Errorf("compressActions(%v) = %v, want %v", testCase.input, got, want)
+			}
+		})
+	}
+}
+
+func TestWildcardMatchFold(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		pattern  string
+		s        string
+		expected bool
+	}{
+		{"*", "sts:AssumeRole", true},
+		{"sts:*", "sts:AssumeRole", true},
+		{"STS:assume*", "sts:AssumeRole", true},
+		{"sts:AssumeRole?", "sts:AssumeRole", false},
+		{"sts:Assume?ole", "sts:AssumeRole", true},
+		{"iam:*Role*", "iam:AttachRolePolicy", true},
+		{"iam:Get*", "iam:AttachRolePolicy", false},
+		{"", "iam:AttachRolePolicy", false},
+	}
+
+	for _, testCase := range testCases {
+		if got, want := wildcardMatchFold(testCase.pattern, testCase.s), testCase.expected; got != want {
+			t.Errorf("wildcardMatchFold(%q, %q) = %t, want %t", testCase.pattern, testCase.s, got, want)
+		}
+	}
+}
+
+func TestMergePolicyDocumentStatements(t *testing.T) {
+	t.Parallel()
+
+	statements := []*policyDocumentStatement{
+		{Sid: "DenyS3", Effect: "Deny", Actions: []string{"s3:DeleteBucket"}, Resources: []string{"*"}},
+		{Sid: "DenyEC2", Effect: "Deny", Actions: []string{"ec2:TerminateInstances"}, Resources: []string{"*"}},
+		{Sid: "DenyConditional", Effect: "Deny", Actions: []string{"iam:*"}, Resources: []string{"*"}, Condition: policyDocumentConditions{
+			"StringNotEquals": {"aws:RequestedRegion": []string{"eu-west-1"}},
+		}},
+		{Effect: "Deny", NotActions: []string{"iam:*"}, Resources: []string{"*"}},
+		{Effect: "Deny", Actions: []string{"s3:DeleteBucket"}, Resources: []string{"*"}},
+	}
+
+	got := mergePolicyDocumentStatements(statements)
+
+	if got, want := len(got), 3; got != want {
+		t.Fatalf("len(mergePolicyDocumentStatements()) = %d, want %d", got, want)
+	}
+
+	if got, want := []string(got[0].Actions), []string{"s3:DeleteBucket", "ec2:TerminateInstances"}; !slices.Equal(got, want) {
+		t.Errorf("merged actions = %v, want %v", got, want)
+	}
+
+	if got, want := got[0].Sid, "DenyS3"; got != want {
+		t.Errorf("merged Sid = %q, want %q", got, want)
+	}
+}
+
+func TestCheckPolicyDocumentLockout(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		statements []*policyDocumentStatement
+		expected   int
+	}{
+		"allow": {
+			statements: []*policyDocumentStatement{
+				{Effect: "Allow", Actions: []string{"*"}, Resources: []string{"*"}},
+			},
+		},
+		"deny unrelated": {
+			statements: []*policyDocumentStatement{
+				{Effect: "Deny", Actions: []string{"s3:DeleteBucket"}, Resources: []string{"*"}},
+			},
+		},
+		"deny all": {
+			statements: []*policyDocumentStatement{
+				{Sid: "DenyAll", Effect: "Deny", Actions: []string{"*"}, Resources: []string{"*"}},
+			},
+			expected: 1,
+		},
+		"deny iam": {
+			statements: []*policyDocumentStatement{
+				{Effect: "Deny", Actions: []string{"iam:*"}, Resources: []string{"*"}},
+			},
+			expected: 1,
+		},
+		"not action": {
+			statements: []*policyDocumentStatement{
+				{Effect: "Deny", NotActions: []string{"s3:*"}, Resources: []string{"*"}},
+			},
+			expected: 1,
+		},
+		"specific resource": {
+			statements: []*policyDocumentStatement{
+				{Effect: "Deny", Actions: []string{"iam:*"}, Resources: []string{"arn:aws:iam::*:role/Protected"}},
+			},
+		},
+		"exempted role": {
+			statements: []*policyDocumentStatement{
+				{Effect: "Deny", Actions: []string{"*"}, Resources: []string{"*"}, Condition: policyDocumentConditions{
+					"ArnNotLike": {"aws:PrincipalArn": []string{"arn:aws:iam::*:role/OrganizationAccountAccessRole"}},
+				}},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := len(checkPolicyDocumentLockout(testCase.statements, "OrganizationAccountAccessRole")), testCase.expected; got != want {
+				t.Errorf("len(checkPolicyDocumentLockout()) = %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestValidateTagPolicyDocument(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		document    string
+		expectedErr string
+	}{
+		"valid": {
+			document: `{
+  "tags": {
+    "costcenter": {
+      "tag_key": {"@@assign": "CostCenter"},
+      "tag_value": {"@@assign": ["100", "200"]},
+      "enforced_for": {"@@assign": ["secretsmanager:*"]}
+    }
+  }
+}`,
+		},
+		"invalid JSON": {
+			document:    `{`,
+			expectedErr: "invalid JSON",
+		},
+		"unknown top-level key": {
+			document:    `{"tags": {}, "Statement": []}`,
+			expectedErr: `unsupported top-level key "Statement"`,
+		},
+		"unknown field": {
+			document:    `{"tags": {"costcenter": {"tag_keys": {"@@assign": "CostCenter"}}}}`,
+			expectedErr: "tags.costcenter.tag_keys: unsupported field",
+		},
+		"unknown operator": {
+			document:    `{"tags": {"costcenter": {"tag_value": {"@@replace": ["100"]}}}}`,
+			expectedErr: "tags.costcenter.tag_value.@@replace: unsupported operator",
+		},
+		"tag key mismatch": {
+			document:    `{"tags": {"costcenter": {"tag_key": {"@@assign": "Owner"}}}}`,
+			expectedErr: "must match the tag policy key",
+		},
+		"enforced_for format": {
+			document:    `{"tags": {"costcenter": {"enforced_for": {"@@assign": ["ec2"]}}}}`,
+			expectedErr: "must be of the form service:resource_type",
+		},
+		"child operators": {
+			document:    `{"tags": {"costcenter": {"tag_value": {"@@operators_allowed_for_child_policies": ["@@bogus"]}}}}`,
+			expectedErr: "must be an object",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			errs := validateTagPolicyDocument(testCase.document)
+
+			if testCase.expectedErr == "" {
+				if len(errs) > 0 {
+					t.Errorf("unexpected errors: %v", errs)
+				}
+
+				return
+			}
+
+			if !slices.ContainsFunc(errs, func(err error) bool {
+				return strings.Contains(err.Error(), testCase.expectedErr)
+			}) {
+				t.Errorf("expected error containing %q, got %v", testCase.expectedErr, errs)
+			}
+		})
+	}
+}
+
+func TestMinifyPolicyDocument(t *testing.T) {
+	t.Parallel()
+
+	got, err := minifyPolicyDocument(`{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Deny",
+      "Action": "s3:DeleteBucket",
+      "Resource": "*"
+    }
+  ]
+}`)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:DeleteBucket","Resource":"*"}]}`; got != want {
+		t.Errorf("minifyPolicyDocument() = %s, want %s", got, want)
+	}
+}
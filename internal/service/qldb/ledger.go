@@ -29,6 +29,7 @@ import (
 
 // @SDKResource("aws_qldb_ledger", name="Ledger")
 // @Tags(identifierAttribute="arn")
+// @Stateful
 func resourceLedger() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceLedgerCreate,
@@ -20,38 +20,38 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_quicksight_dashboard", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_quicksight_dashboard", &resource.Sweeper{
 		Name: "aws_quicksight_dashboard",
 		F:    sweepDashboards,
 	})
-	resource.AddTestSweepers("aws_quicksight_data_set", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_quicksight_data_set", &resource.Sweeper{
 		Name: "aws_quicksight_data_set",
 		F:    sweepDataSets,
 	})
-	resource.AddTestSweepers("aws_quicksight_data_source", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_quicksight_data_source", &resource.Sweeper{
 		Name: "aws_quicksight_data_source",
 		F:    sweepDataSources,
 	})
-	resource.AddTestSweepers("aws_quicksight_folder", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_quicksight_folder", &resource.Sweeper{
 		Name: "aws_quicksight_folder",
 		F:    sweepFolders,
 	})
-	resource.AddTestSweepers("aws_quicksight_group", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_quicksight_group", &resource.Sweeper{
 		Name: "aws_quicksight_group",
 		F:    sweepGroups,
 	})
-	resource.AddTestSweepers("aws_quicksight_template", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_quicksight_template", &resource.Sweeper{
 		Name: "aws_quicksight_template",
 		F:    sweepTemplates,
 	})
-	resource.AddTestSweepers("aws_quicksight_user", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_quicksight_user", &resource.Sweeper{
 		Name: "aws_quicksight_user",
 		F:    sweepUsers,
 		Dependencies: []string{
 			"aws_quicksight_group",
 		},
 	})
-	resource.AddTestSweepers("aws_quicksight_vpc_connection", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_quicksight_vpc_connection", &resource.Sweeper{
 		Name: "aws_quicksight_vpc_connection",
 		F:    sweepVPCConnections,
 	})
@@ -16,7 +16,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_redshift_cluster_snapshot", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_redshift_cluster_snapshot", &resource.Sweeper{
 		Name: "aws_redshift_cluster_snapshot",
 		F:    sweepClusterSnapshots,
 		Dependencies: []string{
@@ -24,42 +24,42 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_redshift_cluster", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_redshift_cluster", &resource.Sweeper{
 		Name: "aws_redshift_cluster",
 		F:    sweepClusters,
 	})
 
-	resource.AddTestSweepers("aws_redshift_hsm_client_certificate", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_redshift_hsm_client_certificate", &resource.Sweeper{
 		Name: "aws_redshift_hsm_client_certificate",
 		F:    sweepHSMClientCertificates,
 	})
 
-	resource.AddTestSweepers("aws_redshift_hsm_configuration", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_redshift_hsm_configuration", &resource.Sweeper{
 		Name: "aws_redshift_hsm_configuration",
 		F:    sweepHSMConfigurations,
 	})
 
-	resource.AddTestSweepers("aws_redshift_authentication_profile", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_redshift_authentication_profile", &resource.Sweeper{
 		Name: "aws_redshift_authentication_profile",
 		F:    sweepAuthenticationProfiles,
 	})
 
-	resource.AddTestSweepers("aws_redshift_event_subscription", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_redshift_event_subscription", &resource.Sweeper{
 		Name: "aws_redshift_event_subscription",
 		F:    sweepEventSubscriptions,
 	})
 
-	resource.AddTestSweepers("aws_redshift_scheduled_action", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_redshift_scheduled_action", &resource.Sweeper{
 		Name: "aws_redshift_scheduled_action",
 		F:    sweepScheduledActions,
 	})
 
-	resource.AddTestSweepers("aws_redshift_snapshot_schedule", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_redshift_snapshot_schedule", &resource.Sweeper{
 		Name: "aws_redshift_snapshot_schedule",
 		F:    sweepSnapshotSchedules,
 	})
 
-	resource.AddTestSweepers("aws_redshift_subnet_group", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_redshift_subnet_group", &resource.Sweeper{
 		Name: "aws_redshift_subnet_group",
 		F:    sweepSubnetGroups,
 		Dependencies: []string{
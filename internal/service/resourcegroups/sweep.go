@@ -15,7 +15,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_resourcegroups_group", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_resourcegroups_group", &resource.Sweeper{
 		Name: "aws_resourcegroups_group",
 		F:    sweepGroups,
 	})
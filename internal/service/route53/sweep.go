@@ -18,22 +18,22 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_route53_health_check", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_route53_health_check", &resource.Sweeper{
 		Name: "aws_route53_health_check",
 		F:    sweepHealthChecks,
 	})
 
-	resource.AddTestSweepers("aws_route53_key_signing_key", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_route53_key_signing_key", &resource.Sweeper{
 		Name: "aws_route53_key_signing_key",
 		F:    sweepKeySigningKeys,
 	})
 
-	resource.AddTestSweepers("aws_route53_query_log", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_route53_query_log", &resource.Sweeper{
 		Name: "aws_route53_query_log",
 		F:    sweepQueryLogs,
 	})
 
-	resource.AddTestSweepers("aws_route53_traffic_policy", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_route53_traffic_policy", &resource.Sweeper{
 		Name: "aws_route53_traffic_policy",
 		F:    sweepTrafficPolicies,
 		Dependencies: []string{
@@ -41,12 +41,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_route53_traffic_policy_instance", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_route53_traffic_policy_instance", &resource.Sweeper{
 		Name: "aws_route53_traffic_policy_instance",
 		F:    sweepTrafficPolicyInstances,
 	})
 
-	resource.AddTestSweepers("aws_route53_zone", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_route53_zone", &resource.Sweeper{
 		Name: "aws_route53_zone",
 		Dependencies: []string{
 			"aws_service_discovery_http_namespace",
@@ -18,12 +18,12 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_route53_resolver_dnssec_config", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_route53_resolver_dnssec_config", &resource.Sweeper{
 		Name: "aws_route53_resolver_dnssec_config",
 		F:    sweepDNSSECConfig,
 	})
 
-	resource.AddTestSweepers("aws_route53_resolver_endpoint", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_route53_resolver_endpoint", &resource.Sweeper{
 		Name: "aws_route53_resolver_endpoint",
 		F:    sweepEndpoints,
 		Dependencies: []string{
@@ -31,12 +31,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_route53_resolver_firewall_config", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_route53_resolver_firewall_config", &resource.Sweeper{
 		Name: "aws_route53_resolver_firewall_config",
 		F:    sweepFirewallConfigs,
 	})
 
-	resource.AddTestSweepers("aws_route53_resolver_firewall_domain_list", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_route53_resolver_firewall_domain_list", &resource.Sweeper{
 		Name: "aws_route53_resolver_firewall_domain_list",
 		F:    sweepFirewallDomainLists,
 		Dependencies: []string{
@@ -44,12 +44,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_route53_resolver_firewall_rule_group_association", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_route53_resolver_firewall_rule_group_association", &resource.Sweeper{
 		Name: "aws_route53_resolver_firewall_rule_group_association",
 		F:    sweepFirewallRuleGroupAssociations,
 	})
 
-	resource.AddTestSweepers("aws_route53_resolver_firewall_rule_group", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_route53_resolver_firewall_rule_group", &resource.Sweeper{
 		Name: "aws_route53_resolver_firewall_rule_group",
 		F:    sweepFirewallRuleGroups,
 		Dependencies: []string{
@@ -58,7 +58,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_route53_resolver_firewall_rule", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_route53_resolver_firewall_rule", &resource.Sweeper{
 		Name: "aws_route53_resolver_firewall_rule",
 		F:    sweepFirewallRules,
 		Dependencies: []string{
@@ -66,12 +66,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_route53_resolver_query_log_config_association", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_route53_resolver_query_log_config_association", &resource.Sweeper{
 		Name: "aws_route53_resolver_query_log_config_association",
 		F:    sweepQueryLogConfigAssociations,
 	})
 
-	resource.AddTestSweepers("aws_route53_resolver_query_log_config", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_route53_resolver_query_log_config", &resource.Sweeper{
 		Name: "aws_route53_resolver_query_log_config",
 		F:    sweepQueryLogsConfig,
 		Dependencies: []string{
@@ -79,12 +79,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_route53_resolver_rule_association", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_route53_resolver_rule_association", &resource.Sweeper{
 		Name: "aws_route53_resolver_rule_association",
 		F:    sweepRuleAssociations,
 	})
 
-	resource.AddTestSweepers("aws_route53_resolver_rule", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_route53_resolver_rule", &resource.Sweeper{
 		Name: "aws_route53_resolver_rule",
 		F:    sweepRules,
 		Dependencies: []string{
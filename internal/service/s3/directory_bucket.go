@@ -38,6 +38,7 @@ func isDirectoryBucket(bucket string) bool {
 }
 
 // @FrameworkResource("aws_s3_directory_bucket", name="Directory Bucket")
+// @Stateful
 func newDirectoryBucketResource(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &directoryBucketResource{}
 
@@ -24,8 +24,9 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
 	return []*types.ServicePackageFrameworkResource{
 		{
-			Factory: newDirectoryBucketResource,
-			Name:    "Directory Bucket",
+			Factory:  newDirectoryBucketResource,
+			Name:     "Directory Bucket",
+			Stateful: true,
 		},
 	}
 }
@@ -88,6 +89,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrBucket,
 				ResourceType:        "Bucket",
 			},
+			Stateful: true,
 		},
 		{
 			Factory:  resourceBucketAccelerateConfiguration,
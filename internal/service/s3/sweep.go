@@ -12,6 +12,7 @@ import (
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
@@ -21,12 +22,13 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/policy"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_s3_object", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_s3_object", &resource.Sweeper{
 		Name: "aws_s3_object",
 		F:    sweepObjects,
 		Dependencies: []string{
@@ -34,7 +36,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_s3_bucket", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_s3_bucket", &resource.Sweeper{
 		Name: "aws_s3_bucket",
 		F:    sweepBuckets,
 		Dependencies: []string{
@@ -45,7 +47,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_s3_directory_bucket", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_s3_directory_bucket", &resource.Sweeper{
 		Name: "aws_s3_directory_bucket",
 		F:    sweepDirectoryBuckets,
 		Dependencies: []string{
@@ -203,7 +205,26 @@ func sweepBuckets(region string) error {
 		d := r.Data(nil)
 		d.SetId(name)
 
-		sweepables = append(sweepables, sweep.NewSweepResource(r, d, client))
+		description := policy.Description{
+			ARN: arn.ARN{
+				Partition: client.Partition(ctx),
+				Service:   "s3",
+				Resource:  name,
+			}.String(),
+			CreationTime: aws.ToTime(bucket.CreationDate),
+		}
+
+		if sweep.PolicyRequiresTags() {
+			tags, err := bucketListTags(ctx, conn, name)
+
+			if err != nil {
+				log.Printf("[WARN] Listing S3 Bucket (%s) tags: %s", name, err)
+			} else {
+				description.Tags = tags.Map()
+			}
+		}
+
+		sweepables = append(sweepables, sweep.Described(sweep.NewSweepResource(r, d, client), description))
 	}
 
 	err = sweep.SweepOrchestrator(ctx, sweepables)
@@ -0,0 +1,362 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securityhub
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securityhub"
+	"github.com/aws/aws-sdk-go-v2/service/securityhub/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	findingsPageSize          = 100
+	findingsDefaultMaxResults = 100
+	findingsMaxMaxResults     = 1000
+)
+
+// @SDKDataSource("aws_securityhub_findings", name="Findings")
+func dataSourceFindings() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceFindingsRead,
+
+		SchemaFunc: func() map[string]*schema.Schema {
+			return map[string]*schema.Schema{
+				"filters": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem:     findingFiltersSchema(),
+				},
+				"findings": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							names.AttrAWSAccountID: {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"compliance_status": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							names.AttrCreatedAt: {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							names.AttrDescription: {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"generator_id": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							names.AttrID: {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"product_arn": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"product_name": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"record_state": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							names.AttrRegion: {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"resources": {
+								Type:     schema.TypeList,
+								Computed: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										names.AttrID: {
+											Type:     schema.TypeString,
+											Computed: true,
+										},
+										"partition": {
+											Type:     schema.TypeString,
+											Computed: true,
+										},
+										names.AttrRegion: {
+											Type:     schema.TypeString,
+											Computed: true,
+										},
+										names.AttrType: {
+											Type:     schema.TypeString,
+											Computed: true,
+										},
+									},
+								},
+							},
+							"severity_label": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"severity_normalized": {
+								Type:     schema.TypeInt,
+								Computed: true,
+							},
+							"title": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"types": {
+								Type:     schema.TypeList,
+								Computed: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"updated_at": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"workflow_status": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+				"max_results": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      findingsDefaultMaxResults,
+					ValidateFunc: validation.IntBetween(1, findingsMaxMaxResults),
+				},
+				"sort_criterion": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							names.AttrField: {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"sort_order": {
+								Type:             schema.TypeString,
+								Optional:         true,
+								Default:          string(types.SortOrderDescending),
+								ValidateDiagFunc: enum.Validate[types.SortOrder](),
+							},
+						},
+					},
+				},
+				"truncated": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+			}
+		},
+	}
+}
+
+func dataSourceFindingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityHubClient(ctx)
+
+	input := &securityhub.GetFindingsInput{}
+
+	if v, ok := d.GetOk("filters"); ok {
+		input.Filters = expandFindingFilters(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("sort_criterion"); ok {
+		input.SortCriteria = expandSortCriteria(v.([]interface{}))
+	}
+
+	findings, truncated, err := findFindings(ctx, conn, input, d.Get("max_results").(int))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Security Hub Findings: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	if err := d.Set("findings", flattenFindings(findings)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting findings: %s", err)
+	}
+	d.Set("truncated", truncated)
+
+	return diags
+}
+
+// findFindings returns up to maxResults findings and whether more findings matched.
+func findFindings(ctx context.Context, conn *securityhub.Client, input *securityhub.GetFindingsInput, maxResults int) ([]types.AwsSecurityFinding, bool, error) {
+	var output []types.AwsSecurityFinding
+
+	input.MaxResults = aws.Int32(int32(min(maxResults, findingsPageSize)))
+
+	pages := securityhub.NewGetFindingsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, false, err
+		}
+
+		output = append(output, page.Findings...)
+
+		if len(output) >= maxResults {
+			return output[:maxResults], len(output) > maxResults || pages.HasMorePages(), nil
+		}
+	}
+
+	return output, false, nil
+}
+
+// findingFiltersSchema returns the schema of the data source's filters.
+// It extends the insight filters with the boolean filters that GetFindings supports.
+func findingFiltersSchema() *schema.Resource {
+	r := securityFindingFiltersSchema()
+	r.Schema["sample"] = booleanFilterSchema()
+
+	return r
+}
+
+func booleanFilterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		MaxItems: 20,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				names.AttrValue: {
+					Type:     schema.TypeBool,
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func expandFindingFilters(tfList []interface{}) *types.AwsSecurityFindingFilters {
+	apiObject := expandSecurityFindingFilters(tfList)
+
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["sample"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Sample = expandBooleanFilters(v.List())
+	}
+
+	return apiObject
+}
+
+func expandBooleanFilters(tfList []interface{}) []types.BooleanFilter {
+	var apiObjects []types.BooleanFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := types.BooleanFilter{}
+
+		if v, ok := tfMap[names.AttrValue].(bool); ok {
+			apiObject.Value = aws.Bool(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandSortCriteria(tfList []interface{}) []types.SortCriterion {
+	var apiObjects []types.SortCriterion
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := types.SortCriterion{}
+
+		if v, ok := tfMap[names.AttrField].(string); ok && v != "" {
+			apiObject.Field = aws.String(v)
+		}
+
+		if v, ok := tfMap["sort_order"].(string); ok && v != "" {
+			apiObject.SortOrder = types.SortOrder(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenFindings(apiObjects []types.AwsSecurityFinding) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			names.AttrAWSAccountID: aws.ToString(apiObject.AwsAccountId),
+			names.AttrCreatedAt:    aws.ToString(apiObject.CreatedAt),
+			names.AttrDescription:  aws.ToString(apiObject.Description),
+			"generator_id":         aws.ToString(apiObject.GeneratorId),
+			names.AttrID:           aws.ToString(apiObject.Id),
+			"product_arn":          aws.ToString(apiObject.ProductArn),
+			"product_name":         aws.ToString(apiObject.ProductName),
+			"record_state":         string(apiObject.RecordState),
+			names.AttrRegion:       aws.ToString(apiObject.Region),
+			"resources":            flattenFindingResources(apiObject.Resources),
+			"title":                aws.ToString(apiObject.Title),
+			"types":                apiObject.Types,
+			"updated_at":           aws.ToString(apiObject.UpdatedAt),
+		}
+
+		if v := apiObject.Compliance; v != nil {
+			tfMap["compliance_status"] = string(v.Status)
+		}
+
+		if v := apiObject.Severity; v != nil {
+			tfMap["severity_label"] = string(v.Label)
+			tfMap["severity_normalized"] = int(aws.ToInt32(v.Normalized))
+		}
+
+		if v := apiObject.Workflow; v != nil {
+			tfMap["workflow_status"] = string(v.Status)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenFindingResources(apiObjects []types.Resource) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrID:     aws.ToString(apiObject.Id),
+			"partition":      string(apiObject.Partition),
+			names.AttrRegion: aws.ToString(apiObject.Region),
+			names.AttrType:   aws.ToString(apiObject.Type),
+		})
+	}
+
+	return tfList
+}
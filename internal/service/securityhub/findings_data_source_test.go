@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securityhub_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccFindingsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_securityhub_findings.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityHubServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFindingsDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "findings.#"),
+					resource.TestCheckResourceAttr(dataSourceName, "max_results", "100"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "truncated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFindingsDataSource_filters(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_securityhub_findings.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityHubServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFindingsDataSourceConfig_filters,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "findings.#"),
+					resource.TestCheckResourceAttr(dataSourceName, "max_results", "5"),
+					resource.TestCheckResourceAttr(dataSourceName, "sort_criterion.0.sort_order", "desc"),
+				),
+			},
+		},
+	})
+}
+
+const testAccFindingsDataSourceConfig_basic = `
+resource "aws_securityhub_account" "test" {}
+
+data "aws_securityhub_findings" "test" {
+  depends_on = [aws_securityhub_account.test]
+}
+`
+
+const testAccFindingsDataSourceConfig_filters = `
+resource "aws_securityhub_account" "test" {}
+
+data "aws_securityhub_findings" "test" {
+  max_results = 5
+
+  filters {
+    record_state {
+      comparison = "EQUALS"
+      value      = "ACTIVE"
+    }
+
+    sample {
+      value = false
+    }
+
+    severity_label {
+      comparison = "EQUALS"
+      value      = "CRITICAL"
+    }
+
+    updated_at {
+      date_range {
+        unit  = "DAYS"
+        value = 7
+      }
+    }
+
+    workflow_status {
+      comparison = "EQUALS"
+      value      = "NEW"
+    }
+  }
+
+  sort_criterion {
+    field = "SeverityNormalized"
+  }
+
+  depends_on = [aws_securityhub_account.test]
+}
+`
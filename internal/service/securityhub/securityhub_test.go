@@ -51,6 +51,10 @@ func TestAccSecurityHub_serial(t *testing.T) {
 			acctest.CtBasic:      testAccFindingAggregator_basic,
 			acctest.CtDisappears: testAccFindingAggregator_disappears,
 		},
+		"FindingsDataSource": {
+			acctest.CtBasic: testAccFindingsDataSource_basic,
+			"filters":       testAccFindingsDataSource_filters,
+		},
 		"Insight": {
 			acctest.CtBasic:      testAccInsight_basic,
 			acctest.CtDisappears: testAccInsight_disappears,
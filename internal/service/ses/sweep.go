@@ -19,22 +19,22 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_ses_configuration_set", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_ses_configuration_set", &resource.Sweeper{
 		Name: "aws_ses_configuration_set",
 		F:    sweepConfigurationSets,
 	})
 
-	resource.AddTestSweepers("aws_ses_domain_identity", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_ses_domain_identity", &resource.Sweeper{
 		Name: "aws_ses_domain_identity",
 		F:    func(region string) error { return sweepIdentities(region, string(awstypes.IdentityTypeDomain)) },
 	})
 
-	resource.AddTestSweepers("aws_ses_email_identity", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_ses_email_identity", &resource.Sweeper{
 		Name: "aws_ses_email_identity",
 		F:    func(region string) error { return sweepIdentities(region, string(awstypes.IdentityTypeEmailAddress)) },
 	})
 
-	resource.AddTestSweepers("aws_ses_receipt_rule_set", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_ses_receipt_rule_set", &resource.Sweeper{
 		Name: "aws_ses_receipt_rule_set",
 		F:    sweepReceiptRuleSets,
 	})
@@ -15,12 +15,12 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_sns_platform_application", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_sns_platform_application", &resource.Sweeper{
 		Name: "aws_sns_platform_application",
 		F:    sweepPlatformApplications,
 	})
 
-	resource.AddTestSweepers("aws_sns_topic", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_sns_topic", &resource.Sweeper{
 		Name: "aws_sns_topic",
 		F:    sweepTopics,
 		Dependencies: []string{
@@ -45,7 +45,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_sns_topic_subscription", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_sns_topic_subscription", &resource.Sweeper{
 		Name: "aws_sns_topic_subscription",
 		F:    sweepTopicSubscriptions,
 	})
@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sns_test
+
+import (
+	"context"
+	"maps"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	"github.com/aws/aws-sdk-go-v2/service/sns/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns/connstest"
+	tfsns "github.com/hashicorp/terraform-provider-aws/internal/service/sns"
+)
+
+func TestTopicCRUD(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fake := connstest.NewFake()
+	topics := newFakeTopics(fake)
+	r := connstest.NewSDKv2Resource(tfsns.ResourceTopic(), fake.AWSClient(ctx, tfsns.ServicePackage(ctx)))
+
+	if diags := r.Apply(ctx, map[string]any{
+		"name":         "test",
+		"display_name": "Test",
+	}); diags.HasError() {
+		t.Fatalf("creating: %v", diags)
+	}
+
+	arn := "arn:aws:sns:us-west-2:123456789012:test" //lintignore:AWSAT003,AWSAT005
+	if got, want := r.ID(), arn; got != want {
+		t.Errorf("ID = %q, want %q", got, want)
+	}
+	for k, want := range map[string]string{
+		"arn":          arn,
+		"display_name": "Test",
+		"name":         "test",
+		"owner":        connstest.AccountID,
+	} {
+		if got := r.Attributes()[k]; got != want {
+			t.Errorf("%s = %q, want %q", k, got, want)
+		}
+	}
+
+	if diags := r.Apply(ctx, map[string]any{
+		"name":         "test",
+		"display_name": "Updated",
+	}); diags.HasError() {
+		t.Fatalf("updating: %v", diags)
+	}
+
+	if got, want := topics.attributes(arn)["DisplayName"], "Updated"; got != want {
+		t.Errorf("DisplayName = %q, want %q", got, want)
+	}
+	if got, want := fake.CallCount(sns.ServiceID, "CreateTopic"), 1; got != want {
+		t.Errorf("CreateTopic calls = %d, want %d", got, want)
+	}
+
+	if diags := r.Destroy(ctx); diags.HasError() {
+		t.Fatalf("deleting: %v", diags)
+	}
+
+	if topics.attributes(arn) != nil {
+		t.Error("topic not deleted")
+	}
+}
+
+func TestTopicCRUD_disappears(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fake := connstest.NewFake()
+	topics := newFakeTopics(fake)
+	r := connstest.NewSDKv2Resource(tfsns.ResourceTopic(), fake.AWSClient(ctx, tfsns.ServicePackage(ctx)))
+
+	if diags := r.Apply(ctx, map[string]any{"name": "test"}); diags.HasError() {
+		t.Fatalf("creating: %v", diags)
+	}
+
+	topics.delete(r.ID())
+
+	if diags := r.Read(ctx); diags.HasError() {
+		t.Fatalf("reading: %v", diags)
+	}
+
+	if r.Exists() {
+		t.Error("state not removed")
+	}
+}
+
+// fakeTopics is an in-memory SNS API serving the operations used by the aws_sns_topic resource.
+type fakeTopics struct {
+	mu     sync.Mutex
+	topics map[string]map[string]string
+}
+
+func newFakeTopics(fake *connstest.Fake) *fakeTopics {
+	f := &fakeTopics{
+		topics: make(map[string]map[string]string),
+	}
+
+	connstest.Handle(fake, sns.ServiceID, "CreateTopic", func(_ context.Context, input *sns.CreateTopicInput) (*sns.CreateTopicOutput, error) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		arn := "arn:aws:sns:" + connstest.Region + ":" + connstest.AccountID + ":" + aws.ToString(input.Name)
+		attributes := map[string]string{
+			"Owner":    connstest.AccountID,
+			"Policy":   `{"Version":"2008-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"*"},"Action":"SNS:Publish","Resource":"` + arn + `"}]}`,
+			"TopicArn": arn,
+		}
+		maps.Copy(attributes, input.Attributes)
+		f.topics[arn] = attributes
+
+		return &sns.CreateTopicOutput{TopicArn: aws.String(arn)}, nil
+	})
+	connstest.Handle(fake, sns.ServiceID, "DeleteTopic", func(_ context.Context, input *sns.DeleteTopicInput) (*sns.DeleteTopicOutput, error) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		arn := aws.ToString(input.TopicArn)
+		if _, ok := f.topics[arn]; !ok {
+			return nil, errTopicNotFound()
+		}
+		delete(f.topics, arn)
+
+		return &sns.DeleteTopicOutput{}, nil
+	})
+	connstest.Handle(fake, sns.ServiceID, "GetTopicAttributes", func(_ context.Context, input *sns.GetTopicAttributesInput) (*sns.GetTopicAttributesOutput, error) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		attributes, ok := f.topics[aws.ToString(input.TopicArn)]
+		if !ok {
+			return nil, errTopicNotFound()
+		}
+
+		return &sns.GetTopicAttributesOutput{Attributes: maps.Clone(attributes)}, nil
+	})
+	connstest.Handle(fake, sns.ServiceID, "SetTopicAttributes", func(_ context.Context, input *sns.SetTopicAttributesInput) (*sns.SetTopicAttributesOutput, error) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		attributes, ok := f.topics[aws.ToString(input.TopicArn)]
+		if !ok {
+			return nil, errTopicNotFound()
+		}
+		attributes[aws.ToString(input.AttributeName)] = aws.ToString(input.AttributeValue)
+
+		return &sns.SetTopicAttributesOutput{}, nil
+	})
+
+	return f
+}
+
+func (f *fakeTopics) attributes(arn string) map[string]string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return maps.Clone(f.topics[arn])
+}
+
+func (f *fakeTopics) delete(arn string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	delete(f.topics, arn)
+}
+
+func errTopicNotFound() error {
+	return &types.NotFoundException{Message: aws.String("Topic does not exist")}
+}
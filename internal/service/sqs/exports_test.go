@@ -3,6 +3,11 @@
 
 package sqs
 
+import (
+	"testing"
+	"time"
+)
+
 // Exports for use in tests only.
 var (
 	ResourceQueue                   = resourceQueue
@@ -22,3 +27,14 @@ var (
 	QueueDeletedTimeout                       = queueDeletedTimeout
 	QueueNameFromURL                          = queueNameFromURL
 )
+
+// SetQueueWaiterPollInterval overrides the queue waiters' polling interval for the duration of the test.
+func SetQueueWaiterPollInterval(t *testing.T, d time.Duration) {
+	t.Helper()
+
+	v := queueWaiterPollInterval
+	queueWaiterPollInterval = d
+	t.Cleanup(func() {
+		queueWaiterPollInterval = v
+	})
+}
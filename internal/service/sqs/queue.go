@@ -191,6 +191,7 @@ var (
 // @SDKResource("aws_sqs_queue", name="Queue")
 // @Tags(identifierAttribute="id")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/sqs/types;awstypes;map[awstypes.QueueAttributeName]string")
+// @Stateful
 func resourceQueue() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceQueueCreate,
@@ -498,6 +499,10 @@ const (
 	queueAttributeStateEqual    = "equal"
 )
 
+// queueWaiterPollInterval, if set, overrides the queue waiters' polling interval.
+// It is only set in unit tests.
+var queueWaiterPollInterval time.Duration
+
 func statusQueueState(ctx context.Context, conn *sqs.Client, url string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := findQueueAttributesByURL(ctx, conn, url)
@@ -584,6 +589,7 @@ func waitQueueAttributesPropagated(ctx context.Context, conn *sqs.Client, url st
 		ContinuousTargetOccurence: 6,               // set to accommodate GovCloud, commercial, China, etc. - avoid lowering
 		MinTimeout:                5 * time.Second, // set to accommodate GovCloud, commercial, China, etc. - avoid lowering
 		NotFoundChecks:            10,              // set to accommodate GovCloud, commercial, China, etc. - avoid lowering
+		PollInterval:              queueWaiterPollInterval,
 	}
 
 	_, err := stateConf.WaitForStateContext(ctx)
@@ -600,6 +606,7 @@ func waitQueueDeleted(ctx context.Context, conn *sqs.Client, url string) error {
 		ContinuousTargetOccurence: 15,              // set to accommodate GovCloud, commercial, China, etc. - avoid lowering
 		MinTimeout:                3 * time.Second, // set to accommodate GovCloud, commercial, China, etc. - avoid lowering
 		NotFoundChecks:            5,               // set to accommodate GovCloud, commercial, China, etc. - avoid lowering
+		PollInterval:              queueWaiterPollInterval,
 	}
 
 	_, err := stateConf.WaitForStateContext(ctx)
@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sqs_test
+
+import (
+	"context"
+	"maps"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns/connstest"
+	tfsqs "github.com/hashicorp/terraform-provider-aws/internal/service/sqs"
+)
+
+func TestQueueCRUD(t *testing.T) { //nolint:paralleltest // Overrides the queue waiters' polling interval.
+	tfsqs.SetQueueWaiterPollInterval(t, time.Millisecond)
+
+	ctx := context.Background()
+	fake := connstest.NewFake()
+	queues := newFakeQueues(fake)
+	r := connstest.NewSDKv2Resource(tfsqs.ResourceQueue(), fake.AWSClient(ctx, tfsqs.ServicePackage(ctx)))
+
+	if diags := r.Apply(ctx, map[string]any{
+		"name":                       "test",
+		"visibility_timeout_seconds": 60,
+	}); diags.HasError() {
+		t.Fatalf("creating: %v", diags)
+	}
+
+	url := "https://sqs.us-west-2.amazonaws.com/123456789012/test" //lintignore:AWSAT003
+	if got, want := r.ID(), url; got != want {
+		t.Errorf("ID = %q, want %q", got, want)
+	}
+	for k, want := range map[string]string{
+		"arn":                        "arn:aws:sqs:us-west-2:123456789012:test", //lintignore:AWSAT003,AWSAT005
+		"name":                       "test",
+		"url":                        url,
+		"visibility_timeout_seconds": "60",
+	} {
+		if got := r.Attributes()[k]; got != want {
+			t.Errorf("%s = %q, want %q", k, got, want)
+		}
+	}
+	if got, want := queues.attributes(url)[string(types.QueueAttributeNameVisibilityTimeout)], "60"; got != want {
+		t.Errorf("VisibilityTimeout = %q, want %q", got, want)
+	}
+
+	if diags := r.Apply(ctx, map[string]any{
+		"name":                       "test",
+		"visibility_timeout_seconds": 90,
+	}); diags.HasError() {
+		t.Fatalf("updating: %v", diags)
+	}
+
+	if got, want := fake.CallCount(sqs.ServiceID, "SetQueueAttributes"), 1; got != want {
+		t.Errorf("SetQueueAttributes calls = %d, want %d", got, want)
+	}
+	if got, want := r.Attributes()["visibility_timeout_seconds"], "90"; got != want {
+		t.Errorf("visibility_timeout_seconds = %q, want %q", got, want)
+	}
+
+	if diags := r.Destroy(ctx); diags.HasError() {
+		t.Fatalf("deleting: %v", diags)
+	}
+
+	if queues.attributes(url) != nil {
+		t.Error("queue not deleted")
+	}
+	if r.Exists() {
+		t.Error("state not removed")
+	}
+}
+
+func TestQueueCRUD_createError(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fake := connstest.NewFake()
+	connstest.Handle(fake, sqs.ServiceID, "CreateQueue", func(context.Context, *sqs.CreateQueueInput) (*sqs.CreateQueueOutput, error) {
+		return nil, connstest.APIError("AccessDenied", "not authorized")
+	})
+	r := connstest.NewSDKv2Resource(tfsqs.ResourceQueue(), fake.AWSClient(ctx, tfsqs.ServicePackage(ctx)))
+
+	if diags := r.Apply(ctx, map[string]any{"name": "test"}); !diags.HasError() {
+		t.Fatal("creating: expected error")
+	}
+
+	if r.Exists() {
+		t.Error("unexpected state")
+	}
+}
+
+// fakeQueues is an in-memory SQS API serving the operations used by the aws_sqs_queue resource.
+type fakeQueues struct {
+	mu     sync.Mutex
+	queues map[string]map[string]string
+}
+
+func newFakeQueues(fake *connstest.Fake) *fakeQueues {
+	f := &fakeQueues{
+		queues: make(map[string]map[string]string),
+	}
+
+	connstest.Handle(fake, sqs.ServiceID, "CreateQueue", func(_ context.Context, input *sqs.CreateQueueInput) (*sqs.CreateQueueOutput, error) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		name := aws.ToString(input.QueueName)
+		url := "https://sqs." + connstest.Region + ".amazonaws.com/" + connstest.AccountID + "/" + name
+		attributes := map[string]string{
+			string(types.QueueAttributeNameQueueArn):                      "arn:aws:sqs:" + connstest.Region + ":" + connstest.AccountID + ":" + name,
+			string(types.QueueAttributeNameSqsManagedSseEnabled):          "true",
+			string(types.QueueAttributeNameMessageRetentionPeriod):        "345600",
+			string(types.QueueAttributeNameVisibilityTimeout):             "30",
+			string(types.QueueAttributeNameMaximumMessageSize):            "262144",
+			string(types.QueueAttributeNameDelaySeconds):                  "0",
+			string(types.QueueAttributeNameReceiveMessageWaitTimeSeconds): "0",
+		}
+		maps.Copy(attributes, input.Attributes)
+		f.queues[url] = attributes
+
+		return &sqs.CreateQueueOutput{QueueUrl: aws.String(url)}, nil
+	})
+	connstest.Handle(fake, sqs.ServiceID, "DeleteQueue", func(_ context.Context, input *sqs.DeleteQueueInput) (*sqs.DeleteQueueOutput, error) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		url := aws.ToString(input.QueueUrl)
+		if _, ok := f.queues[url]; !ok {
+			return nil, errQueueDoesNotExist()
+		}
+		delete(f.queues, url)
+
+		return &sqs.DeleteQueueOutput{}, nil
+	})
+	connstest.Handle(fake, sqs.ServiceID, "GetQueueAttributes", func(_ context.Context, input *sqs.GetQueueAttributesInput) (*sqs.GetQueueAttributesOutput, error) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		attributes, ok := f.queues[aws.ToString(input.QueueUrl)]
+		if !ok {
+			return nil, errQueueDoesNotExist()
+		}
+
+		return &sqs.GetQueueAttributesOutput{Attributes: maps.Clone(attributes)}, nil
+	})
+	connstest.Handle(fake, sqs.ServiceID, "SetQueueAttributes", func(_ context.Context, input *sqs.SetQueueAttributesInput) (*sqs.SetQueueAttributesOutput, error) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		attributes, ok := f.queues[aws.ToString(input.QueueUrl)]
+		if !ok {
+			return nil, errQueueDoesNotExist()
+		}
+		maps.Copy(attributes, input.Attributes)
+
+		return &sqs.SetQueueAttributesOutput{}, nil
+	})
+
+	return f
+}
+
+func (f *fakeQueues) attributes(url string) map[string]string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return maps.Clone(f.queues[url])
+}
+
+func errQueueDoesNotExist() error {
+	return connstest.APIError("AWS.SimpleQueueService.NonExistentQueue", "The specified queue does not exist.")
+}
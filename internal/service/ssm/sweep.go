@@ -22,17 +22,17 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_ssm_default_patch_baseline", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_ssm_default_patch_baseline", &resource.Sweeper{
 		Name: "aws_ssm_default_patch_baseline",
 		F:    sweepDefaultPatchBaselines,
 	})
 
-	resource.AddTestSweepers("aws_ssm_maintenance_window", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_ssm_maintenance_window", &resource.Sweeper{
 		Name: "aws_ssm_maintenance_window",
 		F:    sweepMaintenanceWindows,
 	})
 
-	resource.AddTestSweepers("aws_ssm_patch_baseline", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_ssm_patch_baseline", &resource.Sweeper{
 		Name: "aws_ssm_patch_baseline",
 		F:    sweepPatchBaselines,
 		Dependencies: []string{
@@ -41,12 +41,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ssm_patch_group", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_ssm_patch_group", &resource.Sweeper{
 		Name: "aws_ssm_patch_group",
 		F:    sweepPatchGroups,
 	})
 
-	resource.AddTestSweepers("aws_ssm_resource_data_sync", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_ssm_resource_data_sync", &resource.Sweeper{
 		Name: "aws_ssm_resource_data_sync",
 		F:    sweepResourceDataSyncs,
 	})
@@ -15,7 +15,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_ssmincidents_replication_set", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_ssmincidents_replication_set", &resource.Sweeper{
 		Name: "aws_ssmincidents_replication_set",
 		F:    sweepReplicationSets,
 	})
@@ -0,0 +1,771 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssoadmin
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	orgtypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/aws/aws-sdk-go-v2/service/ssoadmin"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssoadmin/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	tforganizations "github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Account Assignments")
+func newAccountAssignmentsResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &accountAssignmentsResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	// Account assignment operations are asynchronous.
+	// Submit this many requests before polling for their completion.
+	accountAssignmentsBatchSize = 10
+)
+
+type accountAssignmentsResource struct {
+	framework.ResourceWithConfigure
+	framework.WithTimeouts
+}
+
+func (*accountAssignmentsResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_ssoadmin_account_assignments"
+}
+
+func (r *accountAssignmentsResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"assignments": schema.SetAttribute{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[accountAssignmentModel](ctx),
+				Computed:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"instance_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"permission_set_arns": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(fwvalidators.ARN()),
+				},
+			},
+			"reprovision_triggers": schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"principal": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[accountAssignmentsPrincipalModel](ctx),
+				Validators: []validator.Set{
+					setvalidator.IsRequired(),
+					setvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"principal_id": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 47),
+								stringvalidator.RegexMatches(regexache.MustCompile(`^([0-9a-f]{10}-|)[0-9A-Fa-f]{8}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{12}$`), "must match ([0-9a-f]{10}-|)[0-9A-Fa-f]{8}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{12}"),
+							},
+						},
+						"principal_type": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.PrincipalType](),
+							Required:   true,
+						},
+					},
+				},
+			},
+			"targets": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[accountAssignmentsTargetsModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeBetween(1, 1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"account_ids": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+							Validators: []validator.Set{
+								setvalidator.ValueStringsAre(fwvalidators.AWSAccountID()),
+								setvalidator.AtLeastOneOf(path.MatchRelative().AtParent().AtName("organizational_unit_ids")),
+							},
+						},
+						"organizational_unit_ids": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+							Validators: []validator.Set{
+								setvalidator.ValueStringsAre(stringvalidator.RegexMatches(regexache.MustCompile(`^(r-[0-9a-z]{4,32}|ou-[0-9a-z]{4,32}-[0-9a-z]{8,32})$`), "must be an organization root ID or organizational unit ID")),
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *accountAssignmentsResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data accountAssignmentsResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSOAdminClient(ctx)
+
+	instanceARN := data.InstanceARN.ValueString()
+	desired, diags := r.expandMatrix(ctx, &data)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Assignments that already exist aren't adopted, as this resource would then delete them.
+	existing, err := findAccountAssignmentKeys(ctx, conn, instanceARN, desired)
+
+	if err != nil {
+		response.Diagnostics.AddError("listing SSO Account Assignments", err.Error())
+
+		return
+	}
+
+	if len(existing) > 0 {
+		response.Diagnostics.AddError("creating SSO Account Assignments", accountAssignmentsExistError(existing).Error())
+
+		return
+	}
+
+	timeout := r.CreateTimeout(ctx, data.Timeouts)
+	if err := createAccountAssignments(ctx, conn, instanceARN, desired, timeout); err != nil {
+		response.Diagnostics.AddError("creating SSO Account Assignments", err.Error())
+
+		// Save the assignments that were created so that they're deleted when the tainted resource is replaced.
+		if created, err := findAccountAssignmentKeys(ctx, conn, instanceARN, desired); err == nil && len(created) > 0 {
+			data.ID = fwflex.StringValueToFramework(ctx, id.UniqueId())
+			data.Assignments = created.flatten(ctx)
+			response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+		}
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = fwflex.StringValueToFramework(ctx, id.UniqueId())
+	data.Assignments = desired.flatten(ctx)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *accountAssignmentsResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data accountAssignmentsResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSOAdminClient(ctx)
+
+	managed, diags := expandAccountAssignmentKeys(ctx, data.Assignments)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Only report on the assignments this resource created. Drift is detected by comparing these
+	// against the desired matrix in ModifyPlan.
+	actual, err := findAccountAssignmentKeys(ctx, conn, data.InstanceARN.ValueString(), managed)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading SSO Account Assignments (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.Assignments = actual.flatten(ctx)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *accountAssignmentsResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new accountAssignmentsResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSOAdminClient(ctx)
+
+	instanceARN := new.InstanceARN.ValueString()
+	current, diags := expandAccountAssignmentKeys(ctx, old.Assignments)
+	response.Diagnostics.Append(diags...)
+	desired, diags := r.expandMatrix(ctx, &new)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	timeout := r.UpdateTimeout(ctx, new.Timeouts)
+	add, del := desired.difference(current), current.difference(desired)
+
+	// saveProgress saves the assignments that exist after a failed update, so that those that were created
+	// are managed, and eventually deleted, by this resource.
+	saveProgress := func() {
+		if keys, err := findAccountAssignmentKeys(ctx, conn, instanceARN, slices.Concat(current, add)); err == nil {
+			old.Assignments = keys.flatten(ctx)
+			response.Diagnostics.Append(response.State.Set(ctx, &old)...)
+		}
+	}
+
+	if len(add) > 0 {
+		existing, err := findAccountAssignmentKeys(ctx, conn, instanceARN, add)
+
+		if err != nil {
+			response.Diagnostics.AddError("listing SSO Account Assignments", err.Error())
+
+			return
+		}
+
+		if len(existing) > 0 {
+			response.Diagnostics.AddError(fmt.Sprintf("updating SSO Account Assignments (%s)", new.ID.ValueString()), accountAssignmentsExistError(existing).Error())
+
+			return
+		}
+
+		if err := createAccountAssignments(ctx, conn, instanceARN, add, timeout); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating SSO Account Assignments (%s)", new.ID.ValueString()), err.Error())
+			saveProgress()
+
+			return
+		}
+	}
+
+	if len(del) > 0 {
+		if err := deleteAccountAssignments(ctx, conn, instanceARN, del, timeout); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating SSO Account Assignments (%s)", new.ID.ValueString()), err.Error())
+			saveProgress()
+
+			return
+		}
+	}
+
+	if !new.ReprovisionTriggers.IsNull() && !new.ReprovisionTriggers.Equal(old.ReprovisionTriggers) {
+		for _, permissionSetARN := range fwflex.ExpandFrameworkStringValueSet(ctx, new.PermissionSetARNs) {
+			if err := provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN, timeout); err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("updating SSO Account Assignments (%s)", new.ID.ValueString()), err.Error())
+
+				return
+			}
+		}
+	}
+
+	new.Assignments = desired.flatten(ctx)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *accountAssignmentsResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data accountAssignmentsResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSOAdminClient(ctx)
+
+	current, diags := expandAccountAssignmentKeys(ctx, data.Assignments)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := deleteAccountAssignments(ctx, conn, data.InstanceARN.ValueString(), current, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting SSO Account Assignments (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *accountAssignmentsResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	// Destroy.
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan accountAssignmentsResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// The matrix can only be expanded once every dimension is known.
+	if !plan.isMatrixKnown(ctx) {
+		plan.Assignments = fwtypes.NewSetNestedObjectValueOfUnknown[accountAssignmentModel](ctx)
+		response.Diagnostics.Append(response.Plan.Set(ctx, &plan)...)
+
+		return
+	}
+
+	desired, diags := r.expandMatrix(ctx, &plan)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// A difference between the desired matrix and the assignments in state (drift, or accounts
+	// moving into or out of a target OU) shows up as an in-place update.
+	plan.Assignments = desired.flatten(ctx)
+	response.Diagnostics.Append(response.Plan.Set(ctx, &plan)...)
+}
+
+// expandMatrix returns the cross product of the configured principals, permission sets and target accounts.
+// Organizational units are expanded to all active accounts at or below them.
+func (r *accountAssignmentsResource) expandMatrix(ctx context.Context, data *accountAssignmentsResourceModel) (accountAssignmentKeys, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	principals, d := data.Principals.ToSlice(ctx)
+	diags.Append(d...)
+	targets, d := data.Targets.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	accountIDs := fwflex.ExpandFrameworkStringValueSet(ctx, targets.AccountIDs)
+
+	if ouIDs := fwflex.ExpandFrameworkStringValueSet(ctx, targets.OrganizationalUnitIDs); len(ouIDs) > 0 {
+		conn := r.Meta().OrganizationsClient(ctx)
+
+		for _, ouID := range ouIDs {
+			accounts, err := tforganizations.FindAllAccountsForParentAndBelow(ctx, conn, ouID)
+
+			if err != nil {
+				diags.AddError(fmt.Sprintf("listing Organizations accounts for parent (%s)", ouID), err.Error())
+
+				return nil, diags
+			}
+
+			for _, account := range accounts {
+				if account.Status == orgtypes.AccountStatusActive {
+					accountIDs = append(accountIDs, aws.ToString(account.Id))
+				}
+			}
+		}
+	}
+
+	var keys accountAssignmentKeys
+
+	for _, permissionSetARN := range fwflex.ExpandFrameworkStringValueSet(ctx, data.PermissionSetARNs) {
+		for _, principal := range principals {
+			for _, accountID := range accountIDs {
+				keys = append(keys, accountAssignmentKey{
+					accountID:        accountID,
+					permissionSetARN: permissionSetARN,
+					principalID:      principal.PrincipalID.ValueString(),
+					principalType:    principal.PrincipalType.ValueString(),
+				})
+			}
+		}
+	}
+
+	return keys.normalize(), diags
+}
+
+type accountAssignmentsResourceModel struct {
+	Assignments         fwtypes.SetNestedObjectValueOf[accountAssignmentModel]           `tfsdk:"assignments"`
+	ID                  types.String                                                     `tfsdk:"id"`
+	InstanceARN         fwtypes.ARN                                                      `tfsdk:"instance_arn"`
+	PermissionSetARNs   fwtypes.SetValueOf[types.String]                                 `tfsdk:"permission_set_arns"`
+	Principals          fwtypes.SetNestedObjectValueOf[accountAssignmentsPrincipalModel] `tfsdk:"principal"`
+	ReprovisionTriggers fwtypes.MapValueOf[types.String]                                 `tfsdk:"reprovision_triggers"`
+	Targets             fwtypes.ListNestedObjectValueOf[accountAssignmentsTargetsModel]  `tfsdk:"targets"`
+	Timeouts            timeouts.Value                                                   `tfsdk:"timeouts"`
+}
+
+func (m *accountAssignmentsResourceModel) isMatrixKnown(ctx context.Context) bool {
+	if m.PermissionSetARNs.IsUnknown() || m.Principals.IsUnknown() || m.Targets.IsUnknown() {
+		return false
+	}
+
+	for _, v := range m.PermissionSetARNs.Elements() {
+		if v.IsUnknown() {
+			return false
+		}
+	}
+
+	principals, diags := m.Principals.ToSlice(ctx)
+	if diags.HasError() {
+		return false
+	}
+
+	for _, v := range principals {
+		if v.PrincipalID.IsUnknown() || v.PrincipalType.IsUnknown() {
+			return false
+		}
+	}
+
+	targets, diags := m.Targets.ToPtr(ctx)
+	if diags.HasError() || targets == nil {
+		return false
+	}
+
+	for _, v := range []fwtypes.SetValueOf[types.String]{targets.AccountIDs, targets.OrganizationalUnitIDs} {
+		if v.IsUnknown() {
+			return false
+		}
+
+		for _, v := range v.Elements() {
+			if v.IsUnknown() {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+type accountAssignmentModel struct {
+	AccountID        types.String                               `tfsdk:"account_id"`
+	PermissionSetARN fwtypes.ARN                                `tfsdk:"permission_set_arn"`
+	PrincipalID      types.String                               `tfsdk:"principal_id"`
+	PrincipalType    fwtypes.StringEnum[awstypes.PrincipalType] `tfsdk:"principal_type"`
+}
+
+type accountAssignmentsPrincipalModel struct {
+	PrincipalID   types.String                               `tfsdk:"principal_id"`
+	PrincipalType fwtypes.StringEnum[awstypes.PrincipalType] `tfsdk:"principal_type"`
+}
+
+type accountAssignmentsTargetsModel struct {
+	AccountIDs            fwtypes.SetValueOf[types.String] `tfsdk:"account_ids"`
+	OrganizationalUnitIDs fwtypes.SetValueOf[types.String] `tfsdk:"organizational_unit_ids"`
+}
+
+type accountAssignmentKey struct {
+	accountID        string
+	permissionSetARN string
+	principalID      string
+	principalType    string
+}
+
+type accountAssignmentKeys []accountAssignmentKey
+
+// normalize sorts and de-duplicates the keys.
+func (s accountAssignmentKeys) normalize() accountAssignmentKeys {
+	slices.SortFunc(s, func(a, b accountAssignmentKey) int {
+		return cmp.Or(
+			cmp.Compare(a.accountID, b.accountID),
+			cmp.Compare(a.permissionSetARN, b.permissionSetARN),
+			cmp.Compare(a.principalType, b.principalType),
+			cmp.Compare(a.principalID, b.principalID),
+		)
+	})
+
+	return slices.Compact(s)
+}
+
+// difference returns the keys in s that are not in ns.
+func (s accountAssignmentKeys) difference(ns accountAssignmentKeys) accountAssignmentKeys {
+	m := make(map[accountAssignmentKey]struct{}, len(ns))
+	for _, v := range ns {
+		m[v] = struct{}{}
+	}
+
+	var output accountAssignmentKeys
+
+	for _, v := range s {
+		if _, ok := m[v]; !ok {
+			output = append(output, v)
+		}
+	}
+
+	return output
+}
+
+func (s accountAssignmentKeys) flatten(ctx context.Context) fwtypes.SetNestedObjectValueOf[accountAssignmentModel] {
+	models := make([]accountAssignmentModel, 0, len(s))
+
+	for _, v := range s {
+		models = append(models, accountAssignmentModel{
+			AccountID:        fwflex.StringValueToFramework(ctx, v.accountID),
+			PermissionSetARN: fwtypes.ARNValue(v.permissionSetARN),
+			PrincipalID:      fwflex.StringValueToFramework(ctx, v.principalID),
+			PrincipalType:    fwtypes.StringEnumValue(awstypes.PrincipalType(v.principalType)),
+		})
+	}
+
+	return fwtypes.NewSetNestedObjectValueOfValueSliceMust(ctx, models)
+}
+
+func expandAccountAssignmentKeys(ctx context.Context, v fwtypes.SetNestedObjectValueOf[accountAssignmentModel]) (accountAssignmentKeys, diag.Diagnostics) {
+	if v.IsNull() || v.IsUnknown() {
+		return nil, nil
+	}
+
+	models, diags := v.ToSlice(ctx)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	keys := make(accountAssignmentKeys, 0, len(models))
+
+	for _, v := range models {
+		keys = append(keys, accountAssignmentKey{
+			accountID:        v.AccountID.ValueString(),
+			permissionSetARN: v.PermissionSetARN.ValueString(),
+			principalID:      v.PrincipalID.ValueString(),
+			principalType:    v.PrincipalType.ValueString(),
+		})
+	}
+
+	return keys.normalize(), diags
+}
+
+// findAccountAssignmentKeys returns those of the specified keys that exist.
+// Assignments are listed per principal, which is far cheaper than listing per account and permission set.
+func findAccountAssignmentKeys(ctx context.Context, conn *ssoadmin.Client, instanceARN string, keys accountAssignmentKeys) (accountAssignmentKeys, error) {
+	type principal struct {
+		id, typ string
+	}
+
+	wanted := make(map[accountAssignmentKey]struct{}, len(keys))
+	var principals []principal
+
+	for _, v := range keys {
+		wanted[v] = struct{}{}
+		principals = append(principals, principal{id: v.principalID, typ: v.principalType})
+	}
+
+	slices.SortFunc(principals, func(a, b principal) int {
+		return cmp.Or(cmp.Compare(a.typ, b.typ), cmp.Compare(a.id, b.id))
+	})
+
+	var output accountAssignmentKeys
+
+	for _, principal := range slices.Compact(principals) {
+		input := &ssoadmin.ListAccountAssignmentsForPrincipalInput{
+			InstanceArn:   aws.String(instanceARN),
+			PrincipalId:   aws.String(principal.id),
+			PrincipalType: awstypes.PrincipalType(principal.typ),
+		}
+
+		assignments, err := findAccountAssignmentsForPrincipal(ctx, conn, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range assignments {
+			key := accountAssignmentKey{
+				accountID:        aws.ToString(v.AccountId),
+				permissionSetARN: aws.ToString(v.PermissionSetArn),
+				principalID:      aws.ToString(v.PrincipalId),
+				principalType:    string(v.PrincipalType),
+			}
+
+			if _, ok := wanted[key]; ok {
+				output = append(output, key)
+			}
+		}
+	}
+
+	return output.normalize(), nil
+}
+
+func findAccountAssignmentsForPrincipal(ctx context.Context, conn *ssoadmin.Client, input *ssoadmin.ListAccountAssignmentsForPrincipalInput) ([]awstypes.AccountAssignmentForPrincipal, error) {
+	var output []awstypes.AccountAssignmentForPrincipal
+
+	paginator := ssoadmin.NewListAccountAssignmentsForPrincipalPaginator(conn, input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+
+		// The principal no longer exists, so neither do its assignments.
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.AccountAssignments...)
+	}
+
+	return output, nil
+}
+
+func createAccountAssignments(ctx context.Context, conn *ssoadmin.Client, instanceARN string, keys accountAssignmentKeys, timeout time.Duration) error {
+	return batchAccountAssignments(keys, func(key accountAssignmentKey) (string, error) {
+		input := &ssoadmin.CreateAccountAssignmentInput{
+			InstanceArn:      aws.String(instanceARN),
+			PermissionSetArn: aws.String(key.permissionSetARN),
+			PrincipalId:      aws.String(key.principalID),
+			PrincipalType:    awstypes.PrincipalType(key.principalType),
+			TargetId:         aws.String(key.accountID),
+			TargetType:       awstypes.TargetTypeAwsAccount,
+		}
+
+		output, err := conn.CreateAccountAssignment(ctx, input)
+
+		if err != nil {
+			return "", fmt.Errorf("creating SSO Account Assignment (%s): %w", key, err)
+		}
+
+		return aws.ToString(output.AccountAssignmentCreationStatus.RequestId), nil
+	}, func(key accountAssignmentKey, requestID string) error {
+		if _, err := waitAccountAssignmentCreated(ctx, conn, instanceARN, requestID, timeout); err != nil {
+			return fmt.Errorf("waiting for SSO Account Assignment (%s) create: %w", key, err)
+		}
+
+		return nil
+	})
+}
+
+func deleteAccountAssignments(ctx context.Context, conn *ssoadmin.Client, instanceARN string, keys accountAssignmentKeys, timeout time.Duration) error {
+	return batchAccountAssignments(keys, func(key accountAssignmentKey) (string, error) {
+		input := &ssoadmin.DeleteAccountAssignmentInput{
+			InstanceArn:      aws.String(instanceARN),
+			PermissionSetArn: aws.String(key.permissionSetARN),
+			PrincipalId:      aws.String(key.principalID),
+			PrincipalType:    awstypes.PrincipalType(key.principalType),
+			TargetId:         aws.String(key.accountID),
+			TargetType:       awstypes.TargetTypeAwsAccount,
+		}
+
+		output, err := conn.DeleteAccountAssignment(ctx, input)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return "", nil
+		}
+
+		if err != nil {
+			return "", fmt.Errorf("deleting SSO Account Assignment (%s): %w", key, err)
+		}
+
+		return aws.ToString(output.AccountAssignmentDeletionStatus.RequestId), nil
+	}, func(key accountAssignmentKey, requestID string) error {
+		if _, err := waitAccountAssignmentDeleted(ctx, conn, instanceARN, requestID, timeout); err != nil {
+			return fmt.Errorf("waiting for SSO Account Assignment (%s) delete: %w", key, err)
+		}
+
+		return nil
+	})
+}
+
+// batchAccountAssignments submits requests in batches, then waits concurrently for each batch's requests to complete.
+// An empty request ID means there is nothing to wait for.
+func batchAccountAssignments(keys accountAssignmentKeys, submit func(accountAssignmentKey) (string, error), wait func(accountAssignmentKey, string) error) error {
+	for batch := range slices.Chunk(keys, accountAssignmentsBatchSize) {
+		requestIDs := make([]string, len(batch))
+
+		for i, key := range batch {
+			requestID, err := submit(key)
+
+			if err != nil {
+				return err
+			}
+
+			requestIDs[i] = requestID
+		}
+
+		var (
+			wg       sync.WaitGroup
+			mu       sync.Mutex
+			waitErrs []error
+		)
+
+		for i, key := range batch {
+			if requestIDs[i] == "" {
+				continue
+			}
+
+			wg.Add(1)
+			go func(key accountAssignmentKey, requestID string) {
+				defer wg.Done()
+
+				if err := wait(key, requestID); err != nil {
+					mu.Lock()
+					waitErrs = append(waitErrs, err)
+					mu.Unlock()
+				}
+			}(key, requestIDs[i])
+		}
+
+		wg.Wait()
+
+		if err := errors.Join(waitErrs...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// accountAssignmentsExistError returns an error for assignments that exist but aren't managed by this resource.
+func accountAssignmentsExistError(keys accountAssignmentKeys) error {
+	s := make([]string, len(keys))
+	for i, key := range keys {
+		s[i] = key.String()
+	}
+
+	return fmt.Errorf("SSO Account Assignments already exist and must be deleted, or removed from the configuration, before this resource can manage them: %s", strings.Join(s, "; "))
+}
+
+func (k accountAssignmentKey) String() string {
+	return fmt.Sprintf("%s %s, %s, %s", k.principalType, k.principalID, k.permissionSetARN, k.accountID)
+}
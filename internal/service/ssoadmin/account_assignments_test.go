@@ -0,0 +1,286 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssoadmin_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssoadmin "github.com/hashicorp/terraform-provider-aws/internal/service/ssoadmin"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSSOAdminAccountAssignments_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ssoadmin_account_assignments.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	groupName := os.Getenv("AWS_IDENTITY_STORE_GROUP_NAME")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+			testAccPreCheckIdentityStoreGroupName(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSOAdminServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountAssignmentsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountAssignmentsConfig_basic(groupName, rName, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccountAssignmentsExist(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "assignments.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "permission_set_arns.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "principal.#", "1"),
+				),
+			},
+			{
+				Config: testAccAccountAssignmentsConfig_basic(groupName, rName, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccountAssignmentsExist(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "assignments.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "permission_set_arns.#", "2"),
+				),
+			},
+			{
+				Config: testAccAccountAssignmentsConfig_basic(groupName, rName, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccountAssignmentsExist(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "assignments.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSOAdminAccountAssignments_reprovisionTriggers(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ssoadmin_account_assignments.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	groupName := os.Getenv("AWS_IDENTITY_STORE_GROUP_NAME")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+			testAccPreCheckIdentityStoreGroupName(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSOAdminServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountAssignmentsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountAssignmentsConfig_reprovisionTriggers(groupName, rName, "30"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccountAssignmentsExist(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "reprovision_triggers.%", "1"),
+				),
+			},
+			{
+				Config: testAccAccountAssignmentsConfig_reprovisionTriggers(groupName, rName, "60"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccountAssignmentsExist(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "reprovision_triggers.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSOAdminAccountAssignments_existing(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	groupName := os.Getenv("AWS_IDENTITY_STORE_GROUP_NAME")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+			testAccPreCheckIdentityStoreGroupName(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSOAdminServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountAssignmentsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAccountAssignmentsConfig_existing(groupName, rName),
+				ExpectError: regexache.MustCompile(`SSO Account Assignments already exist`),
+			},
+		},
+	})
+}
+
+// testAccAccountAssignmentsFromState returns the flattened assignments of the specified resource, keyed by position.
+func testAccAccountAssignmentsFromState(rs *terraform.ResourceState) map[string]map[string]string {
+	assignments := make(map[string]map[string]string)
+
+	for k, v := range rs.Primary.Attributes {
+		parts := strings.Split(k, ".")
+		if len(parts) != 3 || parts[0] != "assignments" {
+			continue
+		}
+
+		if _, ok := assignments[parts[1]]; !ok {
+			assignments[parts[1]] = make(map[string]string)
+		}
+		assignments[parts[1]][parts[2]] = v
+	}
+
+	return assignments
+}
+
+func testAccCheckAccountAssignmentsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSOAdminClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ssoadmin_account_assignments" {
+				continue
+			}
+
+			for _, v := range testAccAccountAssignmentsFromState(rs) {
+				_, err := tfssoadmin.FindAccountAssignment(ctx, conn, v["principal_id"], v["principal_type"], v["account_id"], v["permission_set_arn"], rs.Primary.Attributes["instance_arn"])
+
+				if tfresource.NotFound(err) {
+					continue
+				}
+
+				if err != nil {
+					return err
+				}
+
+				return fmt.Errorf("SSO Account Assignment for Principal (%s) still exists", v["principal_id"])
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAccountAssignmentsExist(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSOAdminClient(ctx)
+
+		for _, v := range testAccAccountAssignmentsFromState(rs) {
+			_, err := tfssoadmin.FindAccountAssignment(ctx, conn, v["principal_id"], v["principal_type"], v["account_id"], v["permission_set_arn"], rs.Primary.Attributes["instance_arn"])
+
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccAccountAssignmentsConfig_base(groupName, rName string, permissionSetCount int) string {
+	return fmt.Sprintf(`
+data "aws_ssoadmin_instances" "test" {}
+
+data "aws_caller_identity" "current" {}
+
+resource "aws_ssoadmin_permission_set" "test" {
+  count = %[3]d
+
+  name         = "%[2]s-${count.index}"
+  instance_arn = tolist(data.aws_ssoadmin_instances.test.arns)[0]
+}
+
+data "aws_identitystore_group" "test" {
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+
+  alternate_identifier {
+    unique_attribute {
+      attribute_path  = "DisplayName"
+      attribute_value = %[1]q
+    }
+  }
+}
+`, groupName, rName, permissionSetCount)
+}
+
+func testAccAccountAssignmentsConfig_basic(groupName, rName string, permissionSetCount int) string {
+	return acctest.ConfigCompose(testAccAccountAssignmentsConfig_base(groupName, rName, permissionSetCount), `
+resource "aws_ssoadmin_account_assignments" "test" {
+  instance_arn        = tolist(data.aws_ssoadmin_instances.test.arns)[0]
+  permission_set_arns = aws_ssoadmin_permission_set.test[*].arn
+
+  principal {
+    principal_type = "GROUP"
+    principal_id   = data.aws_identitystore_group.test.group_id
+  }
+
+  targets {
+    account_ids = [data.aws_caller_identity.current.account_id]
+  }
+}
+`)
+}
+
+func testAccAccountAssignmentsConfig_reprovisionTriggers(groupName, rName, trigger string) string {
+	return acctest.ConfigCompose(testAccAccountAssignmentsConfig_base(groupName, rName, 1), fmt.Sprintf(`
+resource "aws_ssoadmin_account_assignments" "test" {
+  instance_arn        = tolist(data.aws_ssoadmin_instances.test.arns)[0]
+  permission_set_arns = aws_ssoadmin_permission_set.test[*].arn
+
+  principal {
+    principal_type = "GROUP"
+    principal_id   = data.aws_identitystore_group.test.group_id
+  }
+
+  targets {
+    account_ids = [data.aws_caller_identity.current.account_id]
+  }
+
+  reprovision_triggers = {
+    session_duration = %[1]q
+  }
+}
+`, trigger))
+}
+
+func testAccAccountAssignmentsConfig_existing(groupName, rName string) string {
+	return acctest.ConfigCompose(testAccAccountAssignmentsConfig_base(groupName, rName, 1), `
+resource "aws_ssoadmin_account_assignment" "test" {
+  instance_arn       = tolist(data.aws_ssoadmin_instances.test.arns)[0]
+  permission_set_arn = aws_ssoadmin_permission_set.test[0].arn
+  principal_type     = "GROUP"
+  principal_id       = data.aws_identitystore_group.test.group_id
+  target_type        = "AWS_ACCOUNT"
+  target_id          = data.aws_caller_identity.current.account_id
+}
+
+resource "aws_ssoadmin_account_assignments" "test" {
+  instance_arn        = tolist(data.aws_ssoadmin_instances.test.arns)[0]
+  permission_set_arns = aws_ssoadmin_permission_set.test[*].arn
+
+  principal {
+    principal_type = "GROUP"
+    principal_id   = data.aws_identitystore_group.test.group_id
+  }
+
+  targets {
+    account_ids = [data.aws_caller_identity.current.account_id]
+  }
+
+  depends_on = [aws_ssoadmin_account_assignment.test]
+}
+`)
+}
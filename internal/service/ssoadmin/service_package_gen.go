@@ -39,6 +39,10 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
 	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory: newAccountAssignmentsResource,
+			Name:    "Account Assignments",
+		},
 		{
 			Factory: newResourceApplication,
 			Name:    "Application",
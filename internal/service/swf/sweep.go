@@ -16,7 +16,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_swf_domain", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_swf_domain", &resource.Sweeper{
 		Name: "aws_swf_domain",
 		F:    sweepDomains,
 	})
@@ -17,7 +17,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_timestreaminfluxdb_db_instance", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_timestreaminfluxdb_db_instance", &resource.Sweeper{
 		Name: "aws_timestreaminfluxdb_db_instance",
 		F:    sweepDBInstances,
 	})
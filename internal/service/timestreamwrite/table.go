@@ -29,6 +29,7 @@ import (
 
 // @SDKResource("aws_timestreamwrite_table", name="Table")
 // @Tags(identifierAttribute="arn")
+// @Stateful
 func resourceTable() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceTableCreate,
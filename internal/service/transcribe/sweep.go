@@ -15,7 +15,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_transcribe_language_model", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_transcribe_language_model", &resource.Sweeper{
 		Name: "aws_transcribe_language_model",
 		F:    sweepLanguageModels,
 		Dependencies: []string{
@@ -24,7 +24,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_transcribe_medical_vocabulary", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_transcribe_medical_vocabulary", &resource.Sweeper{
 		Name: "aws_transcribe_medical_vocabulary",
 		F:    sweepMedicalVocabularies,
 		Dependencies: []string{
@@ -32,7 +32,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_transcribe_vocabulary", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_transcribe_vocabulary", &resource.Sweeper{
 		Name: "aws_transcribe_vocabulary",
 		F:    sweepVocabularies,
 		Dependencies: []string{
@@ -40,7 +40,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_transcribe_vocabulary_filter", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_transcribe_vocabulary_filter", &resource.Sweeper{
 		Name: "aws_transcribe_vocabulary_filter",
 		F:    sweepVocabularyFilters,
 		Dependencies: []string{
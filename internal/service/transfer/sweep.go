@@ -16,12 +16,12 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_transfer_server", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_transfer_server", &resource.Sweeper{
 		Name: "aws_transfer_server",
 		F:    sweepServers,
 	})
 
-	resource.AddTestSweepers("aws_transfer_workflow", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_transfer_workflow", &resource.Sweeper{
 		Name: "aws_transfer_workflow",
 		F:    sweepWorkflows,
 		Dependencies: []string{
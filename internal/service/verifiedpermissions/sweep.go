@@ -17,7 +17,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_verifiedpermissions_policy_store", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_verifiedpermissions_policy_store", &resource.Sweeper{
 		Name: "aws_verifiedpermissions_policy_store",
 		F:    sweepPolicyStores,
 	})
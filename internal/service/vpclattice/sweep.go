@@ -16,12 +16,12 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_vpclattice_service", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_vpclattice_service", &resource.Sweeper{
 		Name: "aws_vpclattice_service",
 		F:    sweepServices,
 	})
 
-	resource.AddTestSweepers("aws_vpclattice_service_network", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_vpclattice_service_network", &resource.Sweeper{
 		Name: "aws_vpclattice_service_network",
 		F:    sweepServiceNetworks,
 		Dependencies: []string{
@@ -29,7 +29,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_vpclattice_target_group", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_vpclattice_target_group", &resource.Sweeper{
 		Name: "aws_vpclattice_target_group",
 		F:    sweepTargetGroups,
 	})
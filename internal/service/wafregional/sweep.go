@@ -16,7 +16,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_wafregional_byte_match_set", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_wafregional_byte_match_set", &resource.Sweeper{
 		Name: "aws_wafregional_byte_match_set",
 		F:    sweepByteMatchSet,
 		Dependencies: []string{
@@ -26,7 +26,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_wafregional_geo_match_set", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_wafregional_geo_match_set", &resource.Sweeper{
 		Name: "aws_wafregional_geo_match_set",
 		F:    sweepGeoMatchSet,
 		Dependencies: []string{
@@ -36,7 +36,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_wafregional_ipset", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_wafregional_ipset", &resource.Sweeper{
 		Name: "aws_wafregional_ipset",
 		F:    sweepIPSet,
 		Dependencies: []string{
@@ -46,7 +46,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_wafregional_rate_based_rule", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_wafregional_rate_based_rule", &resource.Sweeper{
 		Name: "aws_wafregional_rate_based_rule",
 		F:    sweepRateBasedRules,
 		Dependencies: []string{
@@ -55,7 +55,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_wafregional_regex_match_set", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_wafregional_regex_match_set", &resource.Sweeper{
 		Name: "aws_wafregional_regex_match_set",
 		F:    sweepRegexMatchSet,
 		Dependencies: []string{
@@ -65,7 +65,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_wafregional_regex_pattern_set", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_wafregional_regex_pattern_set", &resource.Sweeper{
 		Name: "aws_wafregional_regex_pattern_set",
 		F:    sweepRegexPatternSet,
 		Dependencies: []string{
@@ -75,7 +75,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_wafregional_rule_group", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_wafregional_rule_group", &resource.Sweeper{
 		Name: "aws_wafregional_rule_group",
 		F:    sweepRuleGroups,
 		Dependencies: []string{
@@ -83,7 +83,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_wafregional_rule", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_wafregional_rule", &resource.Sweeper{
 		Name: "aws_wafregional_rule",
 		F:    sweepRules,
 		Dependencies: []string{
@@ -92,7 +92,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_wafregional_size_constraint_set", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_wafregional_size_constraint_set", &resource.Sweeper{
 		Name: "aws_wafregional_size_constraint_set",
 		F:    sweepSizeConstraintSet,
 		Dependencies: []string{
@@ -102,7 +102,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_wafregional_sql_injection_match_set", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_wafregional_sql_injection_match_set", &resource.Sweeper{
 		Name: "aws_wafregional_sql_injection_match_set",
 		F:    sweepSQLInjectionMatchSet,
 		Dependencies: []string{
@@ -112,12 +112,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_wafregional_web_acl", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_wafregional_web_acl", &resource.Sweeper{
 		Name: "aws_wafregional_web_acl",
 		F:    sweepWebACLs,
 	})
 
-	resource.AddTestSweepers("aws_wafregional_xss_match_set", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_wafregional_xss_match_set", &resource.Sweeper{
 		Name: "aws_wafregional_xss_match_set",
 		F:    sweepXSSMatchSet,
 		Dependencies: []string{
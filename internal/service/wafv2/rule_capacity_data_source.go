@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wafv2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/wafv2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wafv2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_wafv2_rule_capacity", name="Rule Capacity")
+func dataSourceRuleCapacity() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceRuleCapacityRead,
+
+		SchemaFunc: func() map[string]*schema.Schema {
+			return map[string]*schema.Schema{
+				"capacity": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				names.AttrRule: {
+					Type:          schema.TypeSet,
+					Optional:      true,
+					Elem:          webACLRuleSchema(),
+					ConflictsWith: []string{"rule_json"},
+				},
+				"rule_json": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					ValidateFunc:  validation.StringIsJSON,
+					ConflictsWith: []string{names.AttrRule},
+				},
+				names.AttrScope: {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateDiagFunc: enum.Validate[awstypes.Scope](),
+				},
+			}
+		},
+	}
+}
+
+func dataSourceRuleCapacityRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WAFV2Client(ctx)
+
+	var rules []awstypes.Rule
+
+	if v, ok := d.GetOk("rule_json"); ok {
+		var err error
+		rules, err = expandWebACLRulesJSON(v.(string))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "expanding WAFv2 rules JSON: %s", err)
+		}
+	} else {
+		rules = expandWebACLRules(d.Get(names.AttrRule).(*schema.Set).List())
+	}
+
+	scope := d.Get(names.AttrScope).(string)
+	capacity, err := checkCapacity(ctx, conn, scope, rules)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "checking WAFv2 rule capacity: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("capacity", capacity)
+
+	return diags
+}
+
+// checkCapacity returns the web ACL capacity units (WCUs) required to run the specified rules.
+func checkCapacity(ctx context.Context, conn *wafv2.Client, scope string, rules []awstypes.Rule) (int64, error) {
+	if len(rules) == 0 {
+		return 0, nil
+	}
+
+	input := &wafv2.CheckCapacityInput{
+		Rules: rules,
+		Scope: awstypes.Scope(scope),
+	}
+
+	output, err := conn.CheckCapacity(ctx, input)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return output.Capacity, nil
+}
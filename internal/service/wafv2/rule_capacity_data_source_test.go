@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wafv2_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccWAFV2RuleCapacityDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	datasourceName := "data.aws_wafv2_rule_capacity.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckScopeRegional(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WAFV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleCapacityDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "capacity", "2"),
+				),
+			},
+			{
+				Config: testAccRuleCapacityDataSourceConfig_ruleJSON,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "capacity", "1"),
+				),
+			},
+		},
+	})
+}
+
+const testAccRuleCapacityDataSourceConfig_basic = `
+data "aws_wafv2_rule_capacity" "test" {
+  scope = "REGIONAL"
+
+  rule {
+    name     = "rule-1"
+    priority = 1
+
+    action {
+      allow {}
+    }
+
+    statement {
+      geo_match_statement {
+        country_codes = ["US"]
+      }
+    }
+
+    visibility_config {
+      cloudwatch_metrics_enabled = false
+      metric_name                = "friendly-rule-metric-name-1"
+      sampled_requests_enabled   = false
+    }
+  }
+
+  rule {
+    name     = "rule-2"
+    priority = 2
+
+    action {
+      block {}
+    }
+
+    statement {
+      geo_match_statement {
+        country_codes = ["NL"]
+      }
+    }
+
+    visibility_config {
+      cloudwatch_metrics_enabled = false
+      metric_name                = "friendly-rule-metric-name-2"
+      sampled_requests_enabled   = false
+    }
+  }
+}
+`
+
+const testAccRuleCapacityDataSourceConfig_ruleJSON = `
+data "aws_wafv2_rule_capacity" "test" {
+  scope = "REGIONAL"
+
+  rule_json = jsonencode([{
+    Name     = "rule-1"
+    Priority = 1
+    Action = {
+      Allow = {}
+    }
+    Statement = {
+      GeoMatchStatement = {
+        CountryCodes = ["US"]
+      }
+    }
+    VisibilityConfig = {
+      CloudWatchMetricsEnabled = false
+      MetricName               = "friendly-rule-metric-name-1"
+      SampledRequestsEnabled   = false
+    }
+  }])
+}
+`
@@ -15,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/wafv2"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/wafv2/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -146,7 +147,10 @@ func resourceRuleGroup() *schema.Resource {
 			}
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceRuleGroupCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -292,6 +296,40 @@ func resourceRuleGroupDelete(ctx context.Context, d *schema.ResourceData, meta i
 	return diags
 }
 
+func resourceRuleGroupCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Check the capacity (WCUs) required by the planned rules against the rule group's declared capacity.
+	if !d.HasChanges("capacity", names.AttrRule) {
+		return nil
+	}
+
+	if !d.NewValueKnown("capacity") || !d.NewValueKnown(names.AttrScope) || !d.GetRawPlan().GetAttr(names.AttrRule).IsWhollyKnown() {
+		return nil
+	}
+
+	rules := expandRules(d.Get(names.AttrRule).(*schema.Set).List())
+
+	if len(rules) == 0 {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).WAFV2Client(ctx)
+
+	required, err := checkCapacity(ctx, conn, d.Get(names.AttrScope).(string), rules)
+
+	// Invalid rules are reported when the rule group is created or updated.
+	if err != nil {
+		log.Printf("[WARN] Checking WAFv2 RuleGroup (%s) capacity: %s", d.Get(names.AttrName).(string), err)
+
+		return nil
+	}
+
+	if capacity := int64(d.Get("capacity").(int)); required > capacity {
+		return fmt.Errorf("WAFv2 RuleGroup rules require %d WCUs, which exceeds the rule group's capacity of %d", required, capacity)
+	}
+
+	return nil
+}
+
 func findRuleGroupByThreePartKey(ctx context.Context, conn *wafv2.Client, id, name, scope string) (*wafv2.GetRuleGroupOutput, error) {
 	input := &wafv2.GetRuleGroupInput{
 		Id:    aws.String(id),
@@ -996,6 +996,51 @@ func webACLRootStatementSchema(level int) *schema.Schema {
 	}
 }
 
+func webACLRuleSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			names.AttrAction: {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allow":     allowConfigSchema(),
+						"block":     blockConfigSchema(),
+						"captcha":   captchaConfigSchema(),
+						"challenge": challengeConfigSchema(),
+						"count":     countConfigSchema(),
+					},
+				},
+			},
+			"captcha_config": outerCaptchaConfigSchema(),
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"override_action": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"count": emptySchema(),
+						"none":  emptySchema(),
+					},
+				},
+			},
+			names.AttrPriority: {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"rule_label":        ruleLabelsSchema(),
+			"statement":         webACLRootStatementSchema(webACLRootStatementSchemaLevel),
+			"visibility_config": visibilityConfigSchema(),
+		},
+	}
+}
+
 func managedRuleGroupStatementSchema(level int) *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
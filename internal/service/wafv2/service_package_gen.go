@@ -34,6 +34,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_wafv2_regex_pattern_set",
 			Name:     "Regex Pattern Set",
 		},
+		{
+			Factory:  dataSourceRuleCapacity,
+			TypeName: "aws_wafv2_rule_capacity",
+			Name:     "Rule Capacity",
+		},
 		{
 			Factory:  dataSourceRuleGroup,
 			TypeName: "aws_wafv2_rule_group",
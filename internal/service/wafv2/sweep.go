@@ -18,7 +18,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_wafv2_ip_set", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_wafv2_ip_set", &resource.Sweeper{
 		Name: "aws_wafv2_ip_set",
 		F:    sweepIPSets,
 		Dependencies: []string{
@@ -27,7 +27,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_wafv2_regex_pattern_set", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_wafv2_regex_pattern_set", &resource.Sweeper{
 		Name: "aws_wafv2_regex_pattern_set",
 		F:    sweepRegexPatternSets,
 		Dependencies: []string{
@@ -36,7 +36,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_wafv2_rule_group", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_wafv2_rule_group", &resource.Sweeper{
 		Name: "aws_wafv2_rule_group",
 		F:    sweepRuleGroups,
 		Dependencies: []string{
@@ -44,7 +44,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_wafv2_web_acl", &resource.Sweeper{
+	awsv2.AddTestSweepers("aws_wafv2_web_acl", &resource.Sweeper{
 		Name: "aws_wafv2_web_acl",
 		F:    sweepWebACLs,
 	})
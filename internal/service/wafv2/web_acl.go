@@ -15,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/wafv2"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/wafv2/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -117,48 +118,7 @@ func resourceWebACL() *schema.Resource {
 					Type:          schema.TypeSet,
 					Optional:      true,
 					ConflictsWith: []string{"rule_json"},
-					Elem: &schema.Resource{
-						Schema: map[string]*schema.Schema{
-							names.AttrAction: {
-								Type:     schema.TypeList,
-								Optional: true,
-								MaxItems: 1,
-								Elem: &schema.Resource{
-									Schema: map[string]*schema.Schema{
-										"allow":     allowConfigSchema(),
-										"block":     blockConfigSchema(),
-										"captcha":   captchaConfigSchema(),
-										"challenge": challengeConfigSchema(),
-										"count":     countConfigSchema(),
-									},
-								},
-							},
-							"captcha_config": outerCaptchaConfigSchema(),
-							names.AttrName: {
-								Type:         schema.TypeString,
-								Required:     true,
-								ValidateFunc: validation.StringLenBetween(1, 128),
-							},
-							"override_action": {
-								Type:     schema.TypeList,
-								Optional: true,
-								MaxItems: 1,
-								Elem: &schema.Resource{
-									Schema: map[string]*schema.Schema{
-										"count": emptySchema(),
-										"none":  emptySchema(),
-									},
-								},
-							},
-							names.AttrPriority: {
-								Type:     schema.TypeInt,
-								Required: true,
-							},
-							"rule_label":        ruleLabelsSchema(),
-							"statement":         webACLRootStatementSchema(webACLRootStatementSchemaLevel),
-							"visibility_config": visibilityConfigSchema(),
-						},
-					},
+					Elem:          webACLRuleSchema(),
 				},
 				names.AttrScope: {
 					Type:             schema.TypeString,
@@ -183,7 +143,10 @@ func resourceWebACL() *schema.Resource {
 			}
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceWebACLCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -457,6 +420,47 @@ func resourceWebACLDelete(ctx context.Context, d *schema.ResourceData, meta inte
 	return diags
 }
 
+func resourceWebACLCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Calculate the web ACL's capacity (WCUs) from the planned rules.
+	if d.Id() != "" && !d.HasChanges(names.AttrRule, "rule_json") {
+		return nil
+	}
+
+	if !d.NewValueKnown(names.AttrScope) || !d.GetRawPlan().GetAttr(names.AttrRule).IsWhollyKnown() || !d.NewValueKnown("rule_json") {
+		return d.SetNewComputed("capacity")
+	}
+
+	var rules []awstypes.Rule
+
+	if v, ok := d.GetOk("rule_json"); ok {
+		var err error
+		rules, err = expandWebACLRulesJSON(v.(string))
+
+		if err != nil {
+			return d.SetNewComputed("capacity")
+		}
+	} else {
+		rules = expandWebACLRules(d.Get(names.AttrRule).(*schema.Set).List())
+	}
+
+	if len(rules) == 0 {
+		return d.SetNew("capacity", 0)
+	}
+
+	conn := meta.(*conns.AWSClient).WAFV2Client(ctx)
+
+	capacity, err := checkCapacity(ctx, conn, d.Get(names.AttrScope).(string), rules)
+
+	// Invalid rules are reported when the web ACL is created or updated.
+	if err != nil {
+		log.Printf("[WARN] Checking WAFv2 WebACL (%s) capacity: %s", d.Get(names.AttrName).(string), err)
+
+		return d.SetNewComputed("capacity")
+	}
+
+	return d.SetNew("capacity", capacity)
+}
+
 func findWebACLByThreePartKey(ctx context.Context, conn *wafv2.Client, id, name, scope string) (*wafv2.GetWebACLOutput, error) {
 	input := &wafv2.GetWebACLInput{
 		Id:    aws.String(id),
@@ -4,7 +4,9 @@
 package awsv2
 
 import (
+	"context"
 	"fmt"
+	"maps"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -12,8 +14,33 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/log"
 )
 
+var (
+	// sweepers maps the name of each sweeper registered by AddTestSweepers or Register to the sweeper.
+	sweepers = make(map[string]*resource.Sweeper)
+	// registered maps the name of each sweeper registered by Register to its listing function.
+	registered = make(map[string]sweep.SweeperFn)
+)
+
+// AddTestSweepers registers a sweeper with terraform-plugin-testing and records it for the sweeper runner.
+// Sweepers must be registered using this function, or Register, rather than resource.AddTestSweepers.
+// Sweepers registered using this function can't list their resources, so the sweeper runner can't dry run them.
+func AddTestSweepers(name string, s *resource.Sweeper) {
+	sweepers[name] = s
+
+	resource.AddTestSweepers(name, s)
+}
+
+// Sweepers returns the registered sweepers, keyed by name.
+func Sweepers() map[string]*resource.Sweeper {
+	return maps.Clone(sweepers)
+}
+
+// Register registers a sweeper that lists resources using f and deletes them using sweep.SweepOrchestrator.
+// Its resources can be listed without deleting them, see ListFunc.
 func Register(name string, f sweep.SweeperFn, dependencies ...string) {
-	resource.AddTestSweepers(name, &resource.Sweeper{
+	registered[name] = f
+
+	AddTestSweepers(name, &resource.Sweeper{
 		Name: name,
 		F: func(region string) error {
 			ctx := sweep.Context(region)
@@ -46,3 +73,45 @@ func Register(name string, f sweep.SweeperFn, dependencies ...string) {
 		Dependencies: dependencies,
 	})
 }
+
+// ListFunc returns a function that lists, without deleting, the resources that the named sweeper would delete.
+// Returns nil if the sweeper was not registered by Register.
+func ListFunc(name string) func(ctx context.Context, region string) ([]string, error) {
+	f, ok := registered[name]
+	if !ok {
+		return nil
+	}
+
+	return func(ctx context.Context, region string) ([]string, error) {
+		ctx = log.WithResourceType(ctx, name)
+
+		client, err := sweep.SharedRegionalSweepClient(ctx, region)
+		if err != nil {
+			return nil, fmt.Errorf("getting client: %w", err)
+		}
+		sweepResources, err := f(ctx, client)
+
+		if SkipSweepError(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("listing %q (%s): %w", name, region, err)
+		}
+
+		sweepResources, err = sweep.Select(ctx, sweepResources)
+		if err != nil {
+			return nil, fmt.Errorf("selecting %q (%s): %w", name, region, err)
+		}
+
+		resources := make([]string, 0, len(sweepResources))
+		for _, sweepResource := range sweepResources {
+			if v, ok := sweepResource.(fmt.Stringer); ok {
+				resources = append(resources, v.String())
+			} else {
+				resources = append(resources, fmt.Sprintf("%T", sweepResource))
+			}
+		}
+
+		return resources, nil
+	}
+}
@@ -5,6 +5,7 @@ package framework
 
 import (
 	"context"
+	"fmt"
 	"math/rand"
 	"strings"
 	"time"
@@ -19,7 +20,9 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/policy"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type attribute struct {
@@ -48,6 +51,59 @@ func NewSweepResource(factory func(context.Context) (fwresource.ResourceWithConf
 	}
 }
 
+// String returns the identifying attributes of the resource to be swept.
+func (sr *sweepResource) String() string {
+	attributes := make([]string, 0, len(sr.attributes))
+	for _, attr := range sr.attributes {
+		attributes = append(attributes, fmt.Sprintf("%s=%v", attr.path, attr.value))
+	}
+
+	return strings.Join(attributes, ",")
+}
+
+// Describe returns the ARN, tags and creation time of the resource to be swept, as far as they are known.
+// Sweepers can make these known by passing the corresponding attributes to NewSweepResource.
+func (sr *sweepResource) Describe() policy.Description {
+	var description policy.Description
+
+	values := make(map[string]any, len(sr.attributes))
+	for _, attr := range sr.attributes {
+		values[attr.path] = attr.value
+	}
+
+	if v, ok := values[names.AttrID].(string); ok {
+		description.ID = v
+	} else {
+		description.ID = sr.String()
+	}
+
+	if v, ok := values[names.AttrARN].(string); ok {
+		description.ARN = v
+	}
+
+	for _, key := range []string{names.AttrTagsAll, names.AttrTags} {
+		if v, ok := values[key].(map[string]string); ok {
+			description.Tags = v
+			break
+		}
+	}
+
+	for _, key := range policy.CreationTimeAttributes {
+		switch v := values[key].(type) {
+		case time.Time:
+			description.CreationTime = v
+		case string:
+			description.CreationTime = policy.ParseCreationTime(v)
+		}
+
+		if !description.CreationTime.IsZero() {
+			break
+		}
+	}
+
+	return description
+}
+
 func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
 	resource, err := sr.factory(ctx)
 
@@ -20,6 +20,13 @@ const (
 	loggingKeyResourceType = "tf_resource_type"
 )
 
+type contextKey int
+
+const (
+	contextKeyRegion contextKey = iota
+	contextKeyResourceType
+)
+
 func Logger(ctx context.Context, loggerName, region string) context.Context {
 	ctx = tfsdklog.NewRootProviderLogger(ctx,
 		tfsdklog.WithLevel(hclog.Debug),
@@ -27,10 +34,26 @@ func Logger(ctx context.Context, loggerName, region string) context.Context {
 		tfsdklog.WithoutLocation(),
 	)
 	ctx = tflog.SetField(ctx, loggingKeySweeperRegion, region)
+	ctx = context.WithValue(ctx, contextKeyRegion, region)
 
 	return ctx
 }
 
 func WithResourceType(ctx context.Context, resourceType string) context.Context {
-	return tflog.SetField(ctx, loggingKeyResourceType, resourceType)
+	ctx = tflog.SetField(ctx, loggingKeyResourceType, resourceType)
+	ctx = context.WithValue(ctx, contextKeyResourceType, resourceType)
+
+	return ctx
+}
+
+// Region returns the sweeper Region set by Logger, if any.
+func Region(ctx context.Context) string {
+	v, _ := ctx.Value(contextKeyRegion).(string)
+	return v
+}
+
+// ResourceType returns the resource type set by WithResourceType, if any.
+func ResourceType(ctx context.Context) string {
+	v, _ := ctx.Value(contextKeyResourceType).(string)
+	return v
 }
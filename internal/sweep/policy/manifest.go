@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policy
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+// Manifest records the policy decisions for the resources listed by a sweeper.
+type Manifest struct {
+	Region       string     `json:"region,omitempty"`
+	ResourceType string     `json:"resource_type,omitempty"`
+	Time         time.Time  `json:"time"`
+	Decisions    []Decision `json:"decisions"`
+}
+
+// Write writes the manifest as JSON to a new file in the specified directory and returns the file's name.
+func (m *Manifest) Write(directory string) (string, error) {
+	b, err := json.MarshalIndent(m, "", "  ")
+
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(directory, 0755); err != nil { //nolint:mnd // good protection for new directories
+		return "", fmt.Errorf("creating sweeper manifest directory (%s): %w", directory, err)
+	}
+
+	region, resourceType := m.Region, m.ResourceType
+	if region == "" {
+		region = "unknown"
+	}
+	if resourceType == "" {
+		resourceType = "unknown"
+	}
+
+	filename := filepath.Join(directory, fmt.Sprintf("%s_%s_%s.json", region, resourceType, strconv.FormatInt(m.Time.UnixNano(), 10)))
+
+	if err := os.WriteFile(filename, b, 0644); err != nil { //nolint:mnd // good protection for new files
+		return "", fmt.Errorf("writing sweeper manifest (%s): %w", filename, err)
+	}
+
+	return filename, nil
+}
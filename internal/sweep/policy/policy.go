@@ -0,0 +1,285 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package policy implements the selection policy that determines which of the resources listed by a sweeper are deleted.
+package policy
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Policy selects the resources to be swept.
+// A resource is selected only if it satisfies every configured condition.
+// A resource that can't be shown to satisfy a condition, for example because its tags are unknown, isn't selected.
+type Policy struct {
+	// RequiredTags are the tags that a resource must have.
+	// An empty value matches any value of the tag.
+	RequiredTags map[string]string
+	// MinimumAge is the minimum time since a resource's creation.
+	MinimumAge time.Duration
+	// AllowARNs are patterns, one of which a resource's ARN must match.
+	AllowARNs []*Pattern
+	// DenyARNs are patterns, none of which a resource's ARN may match.
+	DenyARNs []*Pattern
+	// ManifestDirectory is the directory to which manifests are written.
+	ManifestDirectory string
+}
+
+type config struct {
+	RequiredTags      map[string]string `json:"required_tags"`
+	MinimumAge        string            `json:"minimum_age"`
+	AllowARNs         []string          `json:"allow_arns"`
+	DenyARNs          []string          `json:"deny_arns"`
+	ManifestDirectory string            `json:"manifest_directory"`
+}
+
+// Load reads a policy from the specified JSON file.
+func Load(filename string) (*Policy, error) {
+	b, err := os.ReadFile(filename)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading sweeper policy file (%s): %w", filename, err)
+	}
+
+	p, err := Parse(b)
+
+	if err != nil {
+		return nil, fmt.Errorf("parsing sweeper policy file (%s): %w", filename, err)
+	}
+
+	return p, nil
+}
+
+// Parse parses a JSON-encoded policy.
+func Parse(b []byte) (*Policy, error) {
+	var c config
+
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&c); err != nil {
+		return nil, err
+	}
+
+	p := &Policy{
+		RequiredTags:      c.RequiredTags,
+		ManifestDirectory: c.ManifestDirectory,
+	}
+
+	if c.MinimumAge != "" {
+		d, err := time.ParseDuration(c.MinimumAge)
+
+		if err != nil {
+			return nil, fmt.Errorf("minimum_age: %w", err)
+		}
+
+		if d < 0 {
+			return nil, fmt.Errorf("minimum_age: must not be negative")
+		}
+
+		p.MinimumAge = d
+	}
+
+	for _, v := range c.AllowARNs {
+		pattern, err := NewPattern(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("allow_arns: %w", err)
+		}
+
+		p.AllowARNs = append(p.AllowARNs, pattern)
+	}
+
+	for _, v := range c.DenyARNs {
+		pattern, err := NewPattern(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("deny_arns: %w", err)
+		}
+
+		p.DenyARNs = append(p.DenyARNs, pattern)
+	}
+
+	return p, nil
+}
+
+// Description describes a resource to be swept.
+// Zero values indicate that the corresponding property is unknown.
+type Description struct {
+	ID           string
+	ARN          string
+	Tags         map[string]string
+	CreationTime time.Time
+}
+
+// Merge returns the description with each unknown property taken from other.
+func (d Description) Merge(other Description) Description {
+	if d.ID == "" {
+		d.ID = other.ID
+	}
+	if d.ARN == "" {
+		d.ARN = other.ARN
+	}
+	if d.Tags == nil {
+		d.Tags = other.Tags
+	}
+	if d.CreationTime.IsZero() {
+		d.CreationTime = other.CreationTime
+	}
+
+	return d
+}
+
+// Decision records whether a resource was selected by a policy and why.
+type Decision struct {
+	ID           string            `json:"id,omitempty"`
+	ARN          string            `json:"arn,omitempty"`
+	Tags         map[string]string `json:"tags,omitempty"`
+	CreationTime *time.Time        `json:"creation_time,omitempty"`
+	Selected     bool              `json:"selected"`
+	// Reasons lists why the resource wasn't selected.
+	Reasons []string `json:"reasons,omitempty"`
+}
+
+// Decide applies the policy to the described resource at the specified time.
+func (p *Policy) Decide(d Description, now time.Time) Decision {
+	decision := Decision{
+		ID:   d.ID,
+		ARN:  d.ARN,
+		Tags: d.Tags,
+	}
+
+	if !d.CreationTime.IsZero() {
+		decision.CreationTime = &d.CreationTime
+	}
+
+	if len(p.AllowARNs) > 0 || len(p.DenyARNs) > 0 {
+		if d.ARN == "" {
+			decision.Reasons = append(decision.Reasons, "ARN unknown")
+		} else {
+			for _, pattern := range p.DenyARNs {
+				if pattern.Match(d.ARN) {
+					decision.Reasons = append(decision.Reasons, fmt.Sprintf("ARN matches deny pattern (%s)", pattern))
+				}
+			}
+
+			if len(p.AllowARNs) > 0 && !anyMatch(p.AllowARNs, d.ARN) {
+				decision.Reasons = append(decision.Reasons, "ARN matches no allow pattern")
+			}
+		}
+	}
+
+	if len(p.RequiredTags) > 0 {
+		if d.Tags == nil {
+			decision.Reasons = append(decision.Reasons, "tags unknown")
+		} else {
+			for _, key := range slices.Sorted(maps.Keys(p.RequiredTags)) {
+				want := p.RequiredTags[key]
+				got, ok := d.Tags[key]
+
+				switch {
+				case !ok:
+					decision.Reasons = append(decision.Reasons, fmt.Sprintf("required tag (%s) missing", key))
+				case want != "" && got != want:
+					decision.Reasons = append(decision.Reasons, fmt.Sprintf("required tag (%s) has value (%s), want (%s)", key, got, want))
+				}
+			}
+		}
+	}
+
+	if p.MinimumAge > 0 {
+		if d.CreationTime.IsZero() {
+			decision.Reasons = append(decision.Reasons, "creation time unknown")
+		} else if age := now.Sub(d.CreationTime); age < p.MinimumAge {
+			decision.Reasons = append(decision.Reasons, fmt.Sprintf("age (%s) is less than minimum age (%s)", age.Truncate(time.Second), p.MinimumAge))
+		}
+	}
+
+	decision.Selected = len(decision.Reasons) == 0
+
+	return decision
+}
+
+// Pattern is an ARN pattern.
+// '*' matches any sequence of characters, including '/' and ':', and '?' matches any single character.
+type Pattern struct {
+	re *regexp.Regexp
+	s  string
+}
+
+// NewPattern compiles an ARN pattern.
+func NewPattern(s string) (*Pattern, error) {
+	if s == "" {
+		return nil, fmt.Errorf("empty pattern")
+	}
+
+	var expr strings.Builder
+
+	expr.WriteString("^")
+	for _, r := range s {
+		switch r {
+		case '*':
+			expr.WriteString(".*")
+		case '?':
+			expr.WriteString(".")
+		default:
+			expr.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	expr.WriteString("$")
+
+	re, err := regexp.Compile(expr.String())
+
+	if err != nil {
+		return nil, fmt.Errorf("compiling pattern (%s): %w", s, err)
+	}
+
+	return &Pattern{re: re, s: s}, nil
+}
+
+// Match returns whether the ARN matches the pattern.
+func (p *Pattern) Match(arn string) bool {
+	return p.re.MatchString(arn)
+}
+
+func (p *Pattern) String() string {
+	return p.s
+}
+
+func anyMatch(patterns []*Pattern, arn string) bool {
+	return slices.ContainsFunc(patterns, func(pattern *Pattern) bool {
+		return pattern.Match(arn)
+	})
+}
+
+// CreationTimeAttributes are the names of the attributes that commonly hold a resource's creation timestamp.
+var CreationTimeAttributes = []string{
+	names.AttrCreationDate,
+	names.AttrCreationTime,
+	names.AttrCreatedAt,
+	names.AttrCreatedDate,
+	names.AttrCreatedTime,
+	names.AttrCreateTime,
+}
+
+// ParseCreationTime parses an RFC 3339 creation timestamp.
+// The zero time is returned if the timestamp can't be parsed.
+func ParseCreationTime(s string) time.Time {
+	t, err := time.Parse(time.RFC3339, s)
+
+	if err != nil {
+		return time.Time{}
+	}
+
+	return t
+}
@@ -0,0 +1,300 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policy_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/policy"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         string
+		expectedError bool
+	}{
+		"empty": {
+			input: `{}`,
+		},
+		"full": {
+			input: `{
+  "required_tags": {"Owner": "acceptance-tests", "Sweepable": ""},
+  "minimum_age": "6h",
+  "allow_arns": ["arn:aws:s3:::tf-acc-test-*"],
+  "deny_arns": ["arn:aws:iam::*:role/keep-*"],
+  "manifest_directory": "manifests"
+}`,
+		},
+		"unknown field": {
+			input:         `{"required_tag": {"Owner": ""}}`,
+			expectedError: true,
+		},
+		"invalid minimum age": {
+			input:         `{"minimum_age": "6 hours"}`,
+			expectedError: true,
+		},
+		"negative minimum age": {
+			input:         `{"minimum_age": "-6h"}`,
+			expectedError: true,
+		},
+		"empty pattern": {
+			input:         `{"deny_arns": [""]}`,
+			expectedError: true,
+		},
+		"invalid JSON": {
+			input:         `{`,
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := policy.Parse([]byte(testCase.input))
+
+			if got, want := err != nil, testCase.expectedError; got != want {
+				t.Errorf("Parse(%q) err %t, want %t (%v)", testCase.input, got, want, err)
+			}
+		})
+	}
+}
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+
+	filename := filepath.Join(t.TempDir(), "policy.json")
+	if err := os.WriteFile(filename, []byte(`{"minimum_age": "1h30m", "required_tags": {"Owner": ""}}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := policy.Load(filename)
+
+	if err != nil {
+		t.Fatalf("Load: %s", err)
+	}
+
+	if got, want := p.MinimumAge, 90*time.Minute; got != want {
+		t.Errorf("MinimumAge = %s, want %s", got, want)
+	}
+
+	if diff := cmp.Diff(p.RequiredTags, map[string]string{"Owner": ""}); diff != "" {
+		t.Errorf("unexpected RequiredTags diff (+wanted, -got): %s", diff)
+	}
+
+	if _, err := policy.Load(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Error("Load of missing file: expected error")
+	}
+}
+
+func TestDecide(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	p, err := policy.Parse([]byte(`{
+  "required_tags": {"Owner": "acceptance-tests", "Sweepable": ""},
+  "minimum_age": "6h",
+  "allow_arns": ["arn:aws:s3:::tf-acc-test-*", "arn:aws:iam::*:role/*"],
+  "deny_arns": ["arn:aws:iam::*:role/keep-*"]
+}`))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tags := map[string]string{
+		"Owner":     "acceptance-tests",
+		"Sweepable": "true",
+	}
+
+	testCases := map[string]struct {
+		description     policy.Description
+		expectedReasons []string
+	}{
+		"selected": {
+			description: policy.Description{
+				ARN:          "arn:aws:s3:::tf-acc-test-123",
+				Tags:         tags,
+				CreationTime: now.Add(-7 * time.Hour),
+			},
+		},
+		"nothing known": {
+			description: policy.Description{ID: "tf-acc-test-123"},
+			expectedReasons: []string{
+				"ARN unknown",
+				"tags unknown",
+				"creation time unknown",
+			},
+		},
+		"denied ARN": {
+			description: policy.Description{
+				ARN:          "arn:aws:iam::123456789012:role/keep-me",
+				Tags:         tags,
+				CreationTime: now.Add(-7 * time.Hour),
+			},
+			expectedReasons: []string{"ARN matches deny pattern (arn:aws:iam::*:role/keep-*)"},
+		},
+		"ARN not allowed": {
+			description: policy.Description{
+				ARN:          "arn:aws:s3:::production-bucket",
+				Tags:         tags,
+				CreationTime: now.Add(-7 * time.Hour),
+			},
+			expectedReasons: []string{"ARN matches no allow pattern"},
+		},
+		"tags mismatch": {
+			description: policy.Description{
+				ARN:          "arn:aws:s3:::tf-acc-test-123",
+				Tags:         map[string]string{"Owner": "someone-else"},
+				CreationTime: now.Add(-7 * time.Hour),
+			},
+			expectedReasons: []string{
+				"required tag (Owner) has value (someone-else), want (acceptance-tests)",
+				"required tag (Sweepable) missing",
+			},
+		},
+		"too young": {
+			description: policy.Description{
+				ARN:          "arn:aws:s3:::tf-acc-test-123",
+				Tags:         tags,
+				CreationTime: now.Add(-90 * time.Minute),
+			},
+			expectedReasons: []string{"age (1h30m0s) is less than minimum age (6h0m0s)"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			decision := p.Decide(testCase.description, now)
+
+			if got, want := decision.Selected, len(testCase.expectedReasons) == 0; got != want {
+				t.Errorf("Selected = %t, want %t", got, want)
+			}
+
+			if diff := cmp.Diff(decision.Reasons, testCase.expectedReasons); diff != "" {
+				t.Errorf("unexpected Reasons diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestDecideEmptyPolicy(t *testing.T) {
+	t.Parallel()
+
+	var p policy.Policy
+
+	if decision := p.Decide(policy.Description{ID: "example"}, time.Now()); !decision.Selected {
+		t.Errorf("empty policy did not select resource: %v", decision.Reasons)
+	}
+}
+
+func TestDescriptionMerge(t *testing.T) {
+	t.Parallel()
+
+	created := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	d := policy.Description{
+		ARN:          "arn:aws:iam::123456789012:role/tf-acc-test-123",
+		CreationTime: created,
+	}
+	other := policy.Description{
+		ID:           "tf-acc-test-123",
+		ARN:          "arn:aws:iam::123456789012:role/other",
+		Tags:         map[string]string{"Owner": "acceptance-tests"},
+		CreationTime: created.Add(time.Hour),
+	}
+
+	got := d.Merge(other)
+	want := policy.Description{
+		ID:           "tf-acc-test-123",
+		ARN:          "arn:aws:iam::123456789012:role/tf-acc-test-123",
+		Tags:         map[string]string{"Owner": "acceptance-tests"},
+		CreationTime: created,
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestPattern(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		pattern  string
+		arn      string
+		expected bool
+	}{
+		{pattern: "arn:aws:s3:::tf-acc-test-*", arn: "arn:aws:s3:::tf-acc-test-123", expected: true},
+		{pattern: "arn:aws:s3:::tf-acc-test-*", arn: "arn:aws:s3:::tf-acc-test-123/key/path", expected: true},
+		{pattern: "arn:aws:s3:::tf-acc-test-*", arn: "arn:aws:s3:::other", expected: false},
+		{pattern: "arn:aws:iam::*:role/test", arn: "arn:aws:iam::123456789012:role/test", expected: true},
+		{pattern: "arn:aws:iam::*:role/test", arn: "arn:aws:iam::123456789012:role/test2", expected: false},
+		{pattern: "arn:aws:ec2:us-west-?:*", arn: "arn:aws:ec2:us-west-2:123456789012:vpc/vpc-1", expected: true},
+		{pattern: "arn:aws:ec2:us-west-?:*", arn: "arn:aws:ec2:us-west-10:123456789012:vpc/vpc-1", expected: false},
+		{pattern: "arn:aws:s3:::a.b", arn: "arn:aws:s3:::axb", expected: false},
+	}
+
+	for _, testCase := range testCases {
+		pattern, err := policy.NewPattern(testCase.pattern)
+
+		if err != nil {
+			t.Fatalf("NewPattern(%q): %s", testCase.pattern, err)
+		}
+
+		if got, want := pattern.Match(testCase.arn), testCase.expected; got != want {
+			t.Errorf("NewPattern(%q).Match(%q) = %t, want %t", testCase.pattern, testCase.arn, got, want)
+		}
+	}
+}
+
+func TestManifestWrite(t *testing.T) {
+	t.Parallel()
+
+	directory := filepath.Join(t.TempDir(), "manifests")
+	manifest := policy.Manifest{
+		Region:       "us-west-2",
+		ResourceType: "aws_example_thing",
+		Time:         time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC),
+		Decisions: []policy.Decision{
+			{ID: "a", Selected: true},
+			{ID: "b", Reasons: []string{"tags unknown"}},
+		},
+	}
+
+	filename, err := manifest.Write(directory)
+
+	if err != nil {
+		t.Fatalf("Write: %s", err)
+	}
+
+	if got, want := filepath.Dir(filename), directory; got != want {
+		t.Errorf("manifest directory = %s, want %s", got, want)
+	}
+
+	b, err := os.ReadFile(filename)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got policy.Manifest
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(got, manifest); diff != "" {
+		t.Errorf("unexpected manifest diff (+wanted, -got): %s", diff)
+	}
+}
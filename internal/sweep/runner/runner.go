@@ -0,0 +1,386 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package runner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/depgraph"
+)
+
+const (
+	defaultParallelism        = 10
+	defaultServiceConcurrency = 2
+)
+
+// Sweeper is a named resource sweeper.
+type Sweeper struct {
+	Name string
+	// Dependencies are the names of the sweepers that must run before this sweeper.
+	Dependencies []string
+	// List returns a description of each resource that Sweep would delete in the specified Region.
+	// Optional. Used for dry runs, which fail if any selected sweeper has no List function.
+	List func(ctx context.Context, region string) ([]string, error)
+	// Sweep deletes the sweeper's resources in the specified Region.
+	Sweep func(ctx context.Context, region string) error
+}
+
+// Options configures a Runner.
+type Options struct {
+	// DryRun lists the resources that would be deleted instead of deleting them.
+	// Every selected sweeper must have a List function.
+	DryRun bool
+	// Filter selects the sweepers whose names contain any of the specified (case-insensitive) strings, and their dependencies.
+	// All sweepers are selected if Filter is empty.
+	Filter []string
+	// Output receives dry run listings and progress messages. Defaults to io.Discard.
+	Output io.Writer
+	// Parallelism is the maximum number of sweepers run concurrently. Defaults to 10.
+	Parallelism int
+	// ServiceConcurrency is the maximum number of sweepers for the same service run concurrently. Defaults to 2.
+	ServiceConcurrency int
+	// ServiceFunc returns the service of the named sweeper. Defaults to the sweeper name.
+	ServiceFunc func(name string) string
+	// StateFile is the path of the file recording completed sweepers.
+	// If set, sweepers completed by a previous failed run are not run again.
+	StateFile string
+}
+
+// Runner runs sweepers in dependency order.
+// Sweepers that don't depend on each other run concurrently.
+type Runner struct {
+	graph    *depgraph.Graph
+	options  Options
+	sweepers map[string]*Sweeper
+}
+
+// New returns a new Runner for the specified sweepers.
+// Returns an error if a dependency cycle is detected.
+// Dependencies on unregistered sweepers are ignored.
+func New(sweepers []*Sweeper, options Options) (*Runner, error) {
+	if options.Output == nil {
+		options.Output = io.Discard
+	}
+	if options.Parallelism <= 0 {
+		options.Parallelism = defaultParallelism
+	}
+	if options.ServiceConcurrency <= 0 {
+		options.ServiceConcurrency = defaultServiceConcurrency
+	}
+	if options.ServiceFunc == nil {
+		options.ServiceFunc = func(name string) string { return name }
+	}
+
+	r := &Runner{
+		graph:    depgraph.New(),
+		options:  options,
+		sweepers: make(map[string]*Sweeper, len(sweepers)),
+	}
+
+	for _, sweeper := range sweepers {
+		if _, ok := r.sweepers[sweeper.Name]; ok {
+			return nil, fmt.Errorf("duplicate sweeper: %s", sweeper.Name)
+		}
+
+		r.sweepers[sweeper.Name] = sweeper
+		r.graph.AddNode(sweeper.Name)
+	}
+
+	for _, sweeper := range sweepers {
+		for _, dependency := range sweeper.Dependencies {
+			if !r.graph.HasNode(dependency) {
+				fmt.Fprintf(r.options.Output, "[WARN] sweeper (%s) has dependency (%s), but that sweeper was not found\n", sweeper.Name, dependency)
+				continue
+			}
+
+			if err := r.graph.AddDependency(sweeper.Name, dependency); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if _, err := r.graph.OverallOrder(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// Selected returns the names of the sweepers selected by the runner's filter, in dependency order.
+func (r *Runner) Selected() ([]string, error) {
+	order, err := r.graph.OverallOrder()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(r.options.Filter) == 0 {
+		return order, nil
+	}
+
+	selected := make(map[string]struct{})
+
+	for _, name := range order {
+		if !matchesFilter(name, r.options.Filter) {
+			continue
+		}
+
+		selected[name] = struct{}{}
+
+		dependencies, err := r.graph.DependenciesOf(name)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, dependency := range dependencies {
+			selected[dependency] = struct{}{}
+		}
+	}
+
+	return slices.DeleteFunc(order, func(name string) bool {
+		_, ok := selected[name]
+		return !ok
+	}), nil
+}
+
+// Run runs the selected sweepers in each of the specified Regions.
+// Regions are swept one at a time.
+// A sweeper that fails prevents the sweepers that depend on it from running in that Region.
+// A dry run returns an error, without listing any resources, if any selected sweeper can't list its resources.
+func (r *Runner) Run(ctx context.Context, regions ...string) error {
+	names, err := r.Selected()
+
+	if err != nil {
+		return err
+	}
+
+	if r.options.DryRun {
+		var unlistable []string
+
+		for _, name := range names {
+			if r.sweepers[name].List == nil {
+				unlistable = append(unlistable, name)
+			}
+		}
+
+		if len(unlistable) > 0 {
+			slices.Sort(unlistable)
+
+			return fmt.Errorf("dry run: %d selected sweepers can't list their resources: %s", len(unlistable), strings.Join(unlistable, ", "))
+		}
+	}
+
+	var state *state
+
+	if !r.options.DryRun && r.options.StateFile != "" {
+		state, err = loadState(r.options.StateFile)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	var errs []error
+
+	for _, region := range regions {
+		if err := r.runRegion(ctx, region, names, state); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return err
+	}
+
+	if state != nil {
+		return state.remove()
+	}
+
+	return nil
+}
+
+type result struct {
+	name   string
+	output []string
+	err    error
+}
+
+// runRegion runs the named sweepers in the specified Region.
+func (r *Runner) runRegion(ctx context.Context, region string, names []string, state *state) error {
+	// Number of dependencies of each sweeper that haven't yet completed.
+	pending := make(map[string]int, len(names))
+	var ready []string
+
+	for _, name := range names {
+		pending[name] = 0
+	}
+
+	for _, name := range names {
+		dependencies, err := r.graph.DirectDependenciesOf(name)
+
+		if err != nil {
+			return err
+		}
+
+		for _, dependency := range dependencies {
+			if _, ok := pending[dependency]; ok {
+				pending[name]++
+			}
+		}
+	}
+
+	done := make(map[string]struct{}, len(names))
+	var errs []error
+
+	// complete marks the named sweeper as done and returns any dependents that are now ready to run.
+	complete := func(name string) []string {
+		done[name] = struct{}{}
+
+		var ready []string
+		dependents, _ := r.graph.DirectDependentsOf(name)
+
+		for _, dependent := range dependents {
+			if _, ok := pending[dependent]; !ok {
+				continue
+			}
+
+			if pending[dependent]--; pending[dependent] == 0 {
+				if _, ok := done[dependent]; !ok {
+					ready = append(ready, dependent)
+				}
+			}
+		}
+
+		return ready
+	}
+
+	// Sweepers completed by a previous run are done.
+	var resumed []string
+	for _, name := range names {
+		if state.completed(region, name) {
+			resumed = append(resumed, name)
+		}
+	}
+	for _, name := range resumed {
+		fmt.Fprintf(r.options.Output, "%s: %s: already completed\n", region, name)
+		complete(name)
+	}
+
+	for _, name := range names {
+		if _, ok := done[name]; !ok && pending[name] == 0 {
+			ready = append(ready, name)
+		}
+	}
+
+	results := make(chan result)
+	running := 0
+	runningByService := make(map[string]int)
+
+	for len(ready) > 0 || running > 0 {
+		slices.Sort(ready)
+
+		// Start as many ready sweepers as the concurrency limits allow.
+		for i := 0; i < len(ready) && running < r.options.Parallelism; {
+			name := ready[i]
+			service := r.options.ServiceFunc(name)
+
+			if runningByService[service] >= r.options.ServiceConcurrency {
+				i++
+				continue
+			}
+
+			ready = slices.Delete(ready, i, i+1)
+			running++
+			runningByService[service]++
+
+			go func() {
+				output, err := r.runSweeper(ctx, region, name)
+				results <- result{name: name, output: output, err: err}
+			}()
+		}
+
+		result := <-results
+		running--
+		runningByService[r.options.ServiceFunc(result.name)]--
+
+		for _, line := range result.output {
+			fmt.Fprintf(r.options.Output, "%s: %s: %s\n", region, result.name, line)
+		}
+
+		if err := result.err; err != nil {
+			fmt.Fprintf(r.options.Output, "%s: %s: failed: %s\n", region, result.name, err)
+			errs = append(errs, fmt.Errorf("running sweeper (%s) in %s: %w", result.name, region, err))
+
+			// Skip all (transitive) dependents.
+			done[result.name] = struct{}{}
+			dependents, _ := r.graph.DependentsOf(result.name)
+
+			for _, dependent := range dependents {
+				if _, ok := pending[dependent]; !ok {
+					continue
+				}
+
+				if _, ok := done[dependent]; !ok {
+					fmt.Fprintf(r.options.Output, "%s: %s: skipped, dependency (%s) failed\n", region, dependent, result.name)
+					done[dependent] = struct{}{}
+				}
+			}
+
+			continue
+		}
+
+		if !r.options.DryRun {
+			fmt.Fprintf(r.options.Output, "%s: %s: completed\n", region, result.name)
+
+			if err := state.add(region, result.name); err != nil {
+				errs = append(errs, err)
+			}
+		}
+
+		ready = append(ready, complete(result.name)...)
+	}
+
+	return errors.Join(errs...)
+}
+
+// runSweeper runs or, for a dry run, lists the named sweeper in the specified Region.
+// Any output lines are returned so that output from concurrently running sweepers isn't interleaved.
+func (r *Runner) runSweeper(ctx context.Context, region, name string) ([]string, error) {
+	sweeper := r.sweepers[name]
+
+	if !r.options.DryRun {
+		return nil, sweeper.Sweep(ctx, region)
+	}
+
+	resources, err := sweeper.List(ctx, region)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resources) == 0 {
+		return []string{"no resources"}, nil
+	}
+
+	output := make([]string, 0, len(resources))
+	for _, resource := range resources {
+		output = append(output, "would delete "+resource)
+	}
+
+	return output, nil
+}
+
+func matchesFilter(name string, filter []string) bool {
+	name = strings.ToLower(name)
+
+	return slices.ContainsFunc(filter, func(s string) bool {
+		return strings.Contains(name, strings.ToLower(s))
+	})
+}
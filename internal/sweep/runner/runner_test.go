@@ -0,0 +1,364 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package runner_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/runner"
+)
+
+// fakeSweepers records the sweepers run by a test.
+type fakeSweepers struct {
+	mu       sync.Mutex
+	finished map[string]time.Time
+	started  map[string]time.Time
+	ran      []string
+	running  map[string]int // Keyed by service.
+	maxByKey map[string]int // Keyed by service, plus "" for all services.
+	failures map[string]error
+}
+
+func newFakeSweepers() *fakeSweepers {
+	return &fakeSweepers{
+		finished: make(map[string]time.Time),
+		started:  make(map[string]time.Time),
+		running:  make(map[string]int),
+		maxByKey: make(map[string]int),
+		failures: make(map[string]error),
+	}
+}
+
+func (f *fakeSweepers) sweeper(name string, dependencies ...string) *runner.Sweeper {
+	return &runner.Sweeper{
+		Name:         name,
+		Dependencies: dependencies,
+		Sweep: func(ctx context.Context, region string) error {
+			service := serviceOf(name)
+
+			f.mu.Lock()
+			f.started[name] = time.Now()
+			f.ran = append(f.ran, name)
+			f.running[service]++
+			f.running[""]++
+			f.maxByKey[service] = max(f.maxByKey[service], f.running[service])
+			f.maxByKey[""] = max(f.maxByKey[""], f.running[""])
+			err := f.failures[name]
+			f.mu.Unlock()
+
+			time.Sleep(20 * time.Millisecond)
+
+			f.mu.Lock()
+			f.finished[name] = time.Now()
+			f.running[service]--
+			f.running[""]--
+			f.mu.Unlock()
+
+			return err
+		},
+	}
+}
+
+// serviceOf returns the service of a fake sweeper named "<service>_<resource>".
+func serviceOf(name string) string {
+	service, _, _ := strings.Cut(name, "_")
+	return service
+}
+
+func TestRunnerDependencyOrder(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	f := newFakeSweepers()
+	sweepers := []*runner.Sweeper{
+		f.sweeper("ec2_vpc", "ec2_subnet", "ec2_security_group"),
+		f.sweeper("ec2_subnet", "rds_instance", "lambda_function"),
+		f.sweeper("ec2_security_group", "rds_instance"),
+		f.sweeper("rds_instance"),
+		f.sweeper("lambda_function"),
+	}
+
+	r, err := runner.New(sweepers, runner.Options{})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := r.Run(ctx, "us-west-2"); err != nil { //lintignore:AWSAT003
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := len(f.ran), len(sweepers); got != want {
+		t.Fatalf("ran %d sweepers, want %d", got, want)
+	}
+
+	for _, sweeper := range sweepers {
+		for _, dependency := range sweeper.Dependencies {
+			if f.started[sweeper.Name].Before(f.finished[dependency]) {
+				t.Errorf("sweeper %s started before dependency %s finished", sweeper.Name, dependency)
+			}
+		}
+	}
+}
+
+func TestRunnerConcurrency(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	f := newFakeSweepers()
+	var sweepers []*runner.Sweeper
+	for _, name := range []string{"ec2_a", "ec2_b", "ec2_c", "ec2_d", "rds_a", "rds_b", "s3_a", "s3_b"} {
+		sweepers = append(sweepers, f.sweeper(name))
+	}
+
+	r, err := runner.New(sweepers, runner.Options{
+		Parallelism:        5,
+		ServiceConcurrency: 2,
+		ServiceFunc:        serviceOf,
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := r.Run(ctx, "us-west-2"); err != nil { //lintignore:AWSAT003
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := len(f.ran), len(sweepers); got != want {
+		t.Fatalf("ran %d sweepers, want %d", got, want)
+	}
+
+	if got := f.maxByKey[""]; got < 2 {
+		t.Errorf("independent sweepers did not run concurrently: maximum concurrency %d", got)
+	}
+	if got, want := f.maxByKey[""], 5; got > want {
+		t.Errorf("maximum concurrency %d, want at most %d", got, want)
+	}
+	for _, service := range []string{"ec2", "rds", "s3"} {
+		if got, want := f.maxByKey[service], 2; got > want {
+			t.Errorf("maximum %s concurrency %d, want at most %d", service, got, want)
+		}
+	}
+}
+
+func TestRunnerDependencyCycle(t *testing.T) {
+	t.Parallel()
+
+	f := newFakeSweepers()
+	sweepers := []*runner.Sweeper{
+		f.sweeper("a", "b"),
+		f.sweeper("b", "c"),
+		f.sweeper("c", "a"),
+	}
+
+	_, err := runner.New(sweepers, runner.Options{})
+
+	if err == nil || !strings.Contains(err.Error(), "dependency cycle") {
+		t.Fatalf("expected dependency cycle error, got: %v", err)
+	}
+}
+
+func TestRunnerFilter(t *testing.T) {
+	t.Parallel()
+
+	f := newFakeSweepers()
+	sweepers := []*runner.Sweeper{
+		f.sweeper("ec2_vpc", "ec2_subnet"),
+		f.sweeper("ec2_subnet", "rds_instance"),
+		f.sweeper("rds_instance"),
+		f.sweeper("s3_bucket"),
+	}
+
+	r, err := runner.New(sweepers, runner.Options{Filter: []string{"SUBNET"}})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got, err := r.Selected()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	slices.Sort(got)
+	want := []string{"ec2_subnet", "rds_instance"}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+want, -got): %s", diff)
+	}
+}
+
+func TestRunnerDryRun(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	f := newFakeSweepers()
+	vpc := f.sweeper("ec2_vpc", "ec2_subnet")
+	vpc.List = func(ctx context.Context, region string) ([]string, error) {
+		return []string{"vpc-1", "vpc-2"}, nil
+	}
+	subnet := f.sweeper("ec2_subnet")
+	subnet.List = func(ctx context.Context, region string) ([]string, error) {
+		return nil, nil
+	}
+	sweepers := []*runner.Sweeper{
+		vpc,
+		subnet,
+	}
+
+	var output strings.Builder
+	r, err := runner.New(sweepers, runner.Options{
+		DryRun: true,
+		Output: &output,
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := r.Run(ctx, "us-west-2"); err != nil { //lintignore:AWSAT003
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(f.ran) != 0 {
+		t.Errorf("dry run ran sweepers: %v", f.ran)
+	}
+
+	got := strings.Split(strings.TrimSpace(output.String()), "\n")
+	slices.Sort(got)
+	want := []string{
+		"us-west-2: ec2_subnet: no resources",    //lintignore:AWSAT003
+		"us-west-2: ec2_vpc: would delete vpc-1", //lintignore:AWSAT003
+		"us-west-2: ec2_vpc: would delete vpc-2", //lintignore:AWSAT003
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+want, -got): %s", diff)
+	}
+}
+
+func TestRunnerDryRunNotListable(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	f := newFakeSweepers()
+	vpc := f.sweeper("ec2_vpc")
+	listed := false
+	vpc.List = func(ctx context.Context, region string) ([]string, error) {
+		listed = true
+		return []string{"vpc-1"}, nil
+	}
+	sweepers := []*runner.Sweeper{
+		vpc,
+		f.sweeper("s3_object"),
+		f.sweeper("s3_bucket", "s3_object"),
+	}
+
+	var output strings.Builder
+	r, err := runner.New(sweepers, runner.Options{
+		DryRun: true,
+		Output: &output,
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	err = r.Run(ctx, "us-west-2") //lintignore:AWSAT003
+
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+
+	if got, want := err.Error(), "dry run: 2 selected sweepers can't list their resources: s3_bucket, s3_object"; got != want {
+		t.Errorf("expected error %q, got %q", want, got)
+	}
+
+	if len(f.ran) != 0 {
+		t.Errorf("dry run ran sweepers: %v", f.ran)
+	}
+
+	if listed {
+		t.Error("dry run listed resources")
+	}
+
+	if got := output.String(); got != "" {
+		t.Errorf("unexpected output: %q", got)
+	}
+}
+
+func TestRunnerResume(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	stateFile := filepath.Join(t.TempDir(), "sweep-state.json")
+	f := newFakeSweepers()
+	f.failures["ec2_subnet"] = errors.New("DependencyViolation")
+	sweepers := []*runner.Sweeper{
+		f.sweeper("ec2_vpc", "ec2_subnet"),
+		f.sweeper("ec2_subnet", "rds_instance"),
+		f.sweeper("rds_instance"),
+		f.sweeper("s3_bucket"),
+	}
+	options := runner.Options{StateFile: stateFile}
+
+	r, err := runner.New(sweepers, options)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	err = r.Run(ctx, "us-west-2") //lintignore:AWSAT003
+
+	if err == nil || !strings.Contains(err.Error(), "DependencyViolation") {
+		t.Fatalf("expected DependencyViolation error, got: %v", err)
+	}
+
+	got := slices.Clone(f.ran)
+	slices.Sort(got)
+	want := []string{"ec2_subnet", "rds_instance", "s3_bucket"}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("first run: unexpected diff (+want, -got): %s", diff)
+	}
+
+	if _, err := os.Stat(stateFile); err != nil {
+		t.Fatalf("state file not written: %s", err)
+	}
+
+	// Resume.
+	f.mu.Lock()
+	f.ran = nil
+	delete(f.failures, "ec2_subnet")
+	f.mu.Unlock()
+
+	r, err = runner.New(sweepers, options)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := r.Run(ctx, "us-west-2"); err != nil { //lintignore:AWSAT003
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff(f.ran, []string{"ec2_subnet", "ec2_vpc"}); diff != "" {
+		t.Errorf("resumed run: unexpected diff (+want, -got): %s", diff)
+	}
+
+	if _, err := os.Stat(stateFile); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("state file not removed after successful run: %v", err)
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package runner
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"slices"
+)
+
+// state records the sweepers completed in each Region so that a failed run can be resumed.
+// A nil *state records nothing.
+type state struct {
+	filename string
+	// Completed maps Region to the names of the sweepers completed in that Region.
+	Completed map[string][]string `json:"completed"`
+}
+
+// loadState reads the state from the specified file.
+// An empty state is returned if the file doesn't exist.
+func loadState(filename string) (*state, error) {
+	s := &state{
+		filename:  filename,
+		Completed: make(map[string][]string),
+	}
+
+	b, err := os.ReadFile(filename)
+
+	if errors.Is(err, fs.ErrNotExist) {
+		return s, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("reading sweeper state file (%s): %w", filename, err)
+	}
+
+	if err := json.Unmarshal(b, s); err != nil {
+		return nil, fmt.Errorf("parsing sweeper state file (%s): %w", filename, err)
+	}
+
+	if s.Completed == nil {
+		s.Completed = make(map[string][]string)
+	}
+
+	return s, nil
+}
+
+// completed returns whether the named sweeper has completed in the specified Region.
+func (s *state) completed(region, name string) bool {
+	if s == nil {
+		return false
+	}
+
+	return slices.Contains(s.Completed[region], name)
+}
+
+// add records that the named sweeper has completed in the specified Region and saves the state.
+func (s *state) add(region, name string) error {
+	if s == nil {
+		return nil
+	}
+
+	if !slices.Contains(s.Completed[region], name) {
+		s.Completed[region] = append(s.Completed[region], name)
+	}
+
+	b, err := json.MarshalIndent(s, "", "  ")
+
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(s.filename, b, 0644); err != nil { //nolint:mnd // good protection for new files
+		return fmt.Errorf("writing sweeper state file (%s): %w", s.filename, err)
+	}
+
+	return nil
+}
+
+// remove deletes the state file.
+func (s *state) remove() error {
+	if s == nil {
+		return nil
+	}
+
+	if err := os.Remove(s.filename); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("removing sweeper state file (%s): %w", s.filename, err)
+	}
+
+	return nil
+}
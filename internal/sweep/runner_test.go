@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep_test
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/runner"
+)
+
+var (
+	flagSweepRunner             = flag.Bool("sweep-runner", false, "Run Sweepers concurrently in dependency order")
+	flagSweepDryRun             = flag.Bool("sweep-dry-run", false, "List the resources that Sweepers would delete without deleting them. Fails if any selected Sweeper can't list its resources (requires -sweep-runner)")
+	flagSweepStateFile          = flag.String("sweep-state-file", "", "File recording completed Sweepers, used to resume a failed run (requires -sweep-runner)")
+	flagSweepParallelism        = flag.Int("sweep-parallelism", 10, "Maximum number of Sweepers to run concurrently (requires -sweep-runner)")
+	flagSweepServiceConcurrency = flag.Int("sweep-service-concurrency", 2, "Maximum number of Sweepers for the same service to run concurrently (requires -sweep-runner)")
+)
+
+// runSweepers runs the registered Sweepers in the Regions specified by the -sweep flag using the sweeper runner
+// and returns the process exit code.
+func runSweepers(ctx context.Context) int {
+	regions := strings.Split(flag.Lookup("sweep").Value.String(), ",")
+	var filter []string
+	if v := flag.Lookup("sweep-run").Value.String(); v != "" {
+		filter = strings.Split(v, ",")
+	}
+
+	sweeperFuncs := awsv2.Sweepers()
+	sweepers := make([]*runner.Sweeper, 0, len(sweeperFuncs))
+	for name, sweeper := range sweeperFuncs {
+		sweepers = append(sweepers, &runner.Sweeper{
+			Name:         name,
+			Dependencies: sweeper.Dependencies,
+			List:         awsv2.ListFunc(name),
+			Sweep: func(_ context.Context, region string) error {
+				return sweeper.F(region)
+			},
+		})
+	}
+
+	services := sweeperServices(ctx)
+	r, err := runner.New(sweepers, runner.Options{
+		DryRun:             *flagSweepDryRun,
+		Filter:             filter,
+		Output:             os.Stdout,
+		Parallelism:        *flagSweepParallelism,
+		ServiceConcurrency: *flagSweepServiceConcurrency,
+		ServiceFunc: func(name string) string {
+			if v, ok := services[name]; ok {
+				return v
+			}
+			return name
+		},
+		StateFile: *flagSweepStateFile,
+	})
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "[ERR] %s\n", err)
+		return 1
+	}
+
+	if err := r.Run(ctx, regions...); err != nil {
+		fmt.Fprintf(os.Stderr, "[ERR] %s\n", err)
+		return 1
+	}
+
+	return 0
+}
+
+// sweeperServices returns a map of resource type name to service package name.
+// Sweepers are registered using the name of the resource type that they sweep.
+func sweeperServices(ctx context.Context) map[string]string {
+	services := make(map[string]string)
+
+	for _, sp := range sweep.ServicePackages {
+		servicePackageName := sp.ServicePackageName()
+
+		for _, v := range sp.SDKResources(ctx) {
+			services[v.TypeName] = servicePackageName
+		}
+
+		for _, v := range sp.FrameworkResources(ctx) {
+			r, err := v.Factory(ctx)
+			if err != nil {
+				continue
+			}
+
+			var response fwresource.MetadataResponse
+			r.Metadata(ctx, fwresource.MetadataRequest{}, &response)
+			services[response.TypeName] = servicePackageName
+		}
+	}
+
+	return services
+}
@@ -17,7 +17,9 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/policy"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type sweepResource struct {
@@ -39,6 +41,58 @@ func newSweepResource(resource *schema.Resource, d *schema.ResourceData, meta *c
 	}
 }
 
+// String returns the ID of the resource to be swept.
+func (sr *sweepResource) String() string {
+	return sr.d.Id()
+}
+
+// Describe returns the ARN, tags and creation time of the resource to be swept, as far as they are known.
+// Sweepers can make these known by setting the corresponding attributes when listing resources.
+func (sr *sweepResource) Describe() policy.Description {
+	description := policy.Description{
+		ID: sr.d.Id(),
+	}
+
+	if v, ok := sr.getOk(names.AttrARN); ok {
+		description.ARN, _ = v.(string)
+	}
+
+	for _, key := range []string{names.AttrTagsAll, names.AttrTags} {
+		if v, ok := sr.getOk(key); ok {
+			if m, ok := v.(map[string]any); ok {
+				tags := make(map[string]string, len(m))
+				for k, v := range m {
+					tags[k], _ = v.(string)
+				}
+				description.Tags = tags
+				break
+			}
+		}
+	}
+
+	for _, key := range policy.CreationTimeAttributes {
+		if v, ok := sr.getOk(key); ok {
+			if s, ok := v.(string); ok {
+				if t := policy.ParseCreationTime(s); !t.IsZero() {
+					description.CreationTime = t
+					break
+				}
+			}
+		}
+	}
+
+	return description
+}
+
+// getOk returns the value of the specified top-level attribute, if the resource's schema has the attribute and its value is set.
+func (sr *sweepResource) getOk(key string) (any, bool) {
+	if _, ok := sr.resource.SchemaMap()[key]; !ok {
+		return nil, false
+	}
+
+	return sr.d.GetOk(key)
+}
+
 func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
 	ctx = tflog.SetField(ctx, "id", sr.d.Id())
 
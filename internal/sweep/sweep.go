@@ -5,9 +5,11 @@ package sweep
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
 	"strconv"
+	"sync"
 	"time"
 
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
@@ -15,6 +17,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/log"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/policy"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -29,12 +33,20 @@ const defaultSweeperAssumeRoleDurationSeconds = 3600
 // ServicePackages is set in TestMain in order to break an import cycle.
 var ServicePackages []conns.ServicePackage
 
-// sweeperClients is a shared cache of regional conns.AWSClient
-// This prevents client re-initialization for every resource with no benefit.
-var sweeperClients map[string]*conns.AWSClient = make(map[string]*conns.AWSClient)
+var (
+	// sweeperClients is a shared cache of regional conns.AWSClient
+	// This prevents client re-initialization for every resource with no benefit.
+	sweeperClients = make(map[string]*conns.AWSClient)
+	// sweeperClientsMutex guards sweeperClients, which is accessed concurrently when sweepers are run by the sweeper runner.
+	sweeperClientsMutex sync.Mutex
+)
 
 // SharedRegionalSweepClient returns a common conns.AWSClient setup needed for the sweeper functions for a given Region.
+// It's safe for concurrent use. Only one client is configured per Region.
 func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSClient, error) {
+	sweeperClientsMutex.Lock()
+	defer sweeperClientsMutex.Unlock()
+
 	if client, ok := sweeperClients[region]; ok {
 		return client, nil
 	}
@@ -106,7 +118,150 @@ type Sweepable interface {
 	Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error
 }
 
+// Describer is implemented by Sweepables that can describe the resource that they delete.
+type Describer interface {
+	Describe() policy.Description
+}
+
+// describedSweepable is a Sweepable whose ARN, tags and creation time were determined when listing resources.
+type describedSweepable struct {
+	Sweepable
+	description policy.Description
+}
+
+// Described returns a Sweepable that reports the specified ARN, tags and creation time to the sweeper selection policy.
+// Sweepers use it where the values are returned by the List or Describe API but can't be set as attributes of the resource,
+// for example where the resource's schema has no creation time attribute.
+// Properties not set in description are taken from the wrapped Sweepable, if it's a Describer.
+func Described(sweepable Sweepable, description policy.Description) Sweepable {
+	return &describedSweepable{
+		Sweepable:   sweepable,
+		description: description,
+	}
+}
+
+func (ds *describedSweepable) Describe() policy.Description {
+	description := ds.description
+
+	switch v := ds.Sweepable.(type) {
+	case Describer:
+		description = description.Merge(v.Describe())
+	case fmt.Stringer:
+		description = description.Merge(policy.Description{ID: v.String()})
+	}
+
+	return description
+}
+
+func (ds *describedSweepable) String() string {
+	if v, ok := ds.Sweepable.(fmt.Stringer); ok {
+		return v.String()
+	}
+
+	return ds.description.ID
+}
+
+// sweeperPolicy returns the selection policy read from the file named by the TF_AWS_SWEEPER_POLICY_FILE environment variable.
+// Returns nil if the environment variable isn't set.
+var sweeperPolicy = sync.OnceValues(func() (*policy.Policy, error) {
+	filename := os.Getenv(envvar.SweeperPolicyFile)
+
+	if filename == "" {
+		return nil, nil
+	}
+
+	return policy.Load(filename)
+})
+
+// PolicyRequiresTags returns whether the sweeper selection policy has required tags.
+// Sweepers use it to avoid reading tags with separate API calls when they aren't needed.
+func PolicyRequiresTags() bool {
+	p, err := sweeperPolicy()
+
+	if err != nil {
+		// Select reports the error.
+		return false
+	}
+
+	return p != nil && len(p.RequiredTags) > 0
+}
+
+// Select returns the sweepables selected by the sweeper selection policy.
+// All sweepables are selected if no policy is configured.
+// Otherwise every decision is reported in a JSON manifest before Select returns.
+func Select(ctx context.Context, sweepables []Sweepable) ([]Sweepable, error) {
+	p, err := sweeperPolicy()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if p == nil || len(sweepables) == 0 {
+		return sweepables, nil
+	}
+
+	now := time.Now()
+	manifest := policy.Manifest{
+		Region:       log.Region(ctx),
+		ResourceType: log.ResourceType(ctx),
+		Time:         now,
+		Decisions:    make([]policy.Decision, 0, len(sweepables)),
+	}
+	selected := make([]Sweepable, 0, len(sweepables))
+
+	for _, sweepable := range sweepables {
+		var description policy.Description
+
+		switch v := sweepable.(type) {
+		case Describer:
+			description = v.Describe()
+		case fmt.Stringer:
+			description.ID = v.String()
+		}
+
+		decision := p.Decide(description, now)
+		manifest.Decisions = append(manifest.Decisions, decision)
+
+		if decision.Selected {
+			selected = append(selected, sweepable)
+		}
+	}
+
+	fields := map[string]any{
+		"listed":   len(sweepables),
+		"selected": len(selected),
+	}
+
+	if p.ManifestDirectory != "" {
+		filename, err := manifest.Write(p.ManifestDirectory)
+
+		if err != nil {
+			return nil, err
+		}
+
+		fields["manifest_file"] = filename
+	} else {
+		b, err := json.Marshal(manifest)
+
+		if err != nil {
+			return nil, err
+		}
+
+		fields["manifest"] = string(b)
+	}
+
+	tflog.Info(ctx, "Applied sweeper selection policy", fields)
+
+	return selected, nil
+}
+
 func SweepOrchestrator(ctx context.Context, sweepables []Sweepable, optFns ...tfresource.OptionsFunc) error {
+	sweepables, err := Select(ctx, sweepables)
+
+	if err != nil {
+		return fmt.Errorf("applying sweeper selection policy: %w", err)
+	}
+
 	if len(sweepables) == 0 {
 		tflog.Info(ctx, "No resources to sweep")
 	}
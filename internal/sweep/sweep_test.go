@@ -5,6 +5,8 @@ package sweep_test
 
 import (
 	"context"
+	"flag"
+	"os"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -18,5 +20,11 @@ func TestMain(m *testing.M) {
 
 	registerSweepers()
 
+	flag.Parse()
+
+	if *flagSweepRunner {
+		os.Exit(runSweepers(ctx))
+	}
+
 	resource.TestMain(m)
 }
@@ -17,6 +17,17 @@ type ServicePackageResourceTags struct {
 	ResourceType        string // Extra resourceType parameter value for UpdateTags etc.
 }
 
+// ServicePackageResourceIdentity represents resource-level identity information.
+// A resource's identity is the set of attributes which together uniquely identify it,
+// and is used to import the resource from a composite ID, an ARN or a map of attribute values.
+type ServicePackageResourceIdentity struct {
+	Attributes   []string // The identity attributes, in the order they appear in a composite import ID
+	IDAttribute  string   // The identity attribute whose value is also the "id" attribute's value. If empty, "id" is the composite import ID
+	ARNAttribute string   // The attribute for the resource's ARN, if the resource can be imported by ARN
+	ARNResource  string   // The format of an ARN's resource part, with identity attributes as "{attribute}" placeholders
+	Separator    string   // The separator between the parts of a composite import ID. Defaults to ","
+}
+
 // ServicePackageFrameworkDataSource represents a Terraform Plugin Framework data source
 // implemented by a service package.
 type ServicePackageFrameworkDataSource struct {
@@ -28,9 +39,11 @@ type ServicePackageFrameworkDataSource struct {
 // ServicePackageFrameworkResource represents a Terraform Plugin Framework resource
 // implemented by a service package.
 type ServicePackageFrameworkResource struct {
-	Factory func(context.Context) (resource.ResourceWithConfigure, error)
-	Name    string
-	Tags    *ServicePackageResourceTags
+	Factory  func(context.Context) (resource.ResourceWithConfigure, error)
+	Name     string
+	Tags     *ServicePackageResourceTags
+	Identity *ServicePackageResourceIdentity
+	Stateful bool // Replacement destroys data, e.g. a database or file system.
 }
 
 // ServicePackageSDKDataSource represents a Terraform Plugin SDK data source
@@ -49,4 +62,5 @@ type ServicePackageSDKResource struct {
 	TypeName string
 	Name     string
 	Tags     *ServicePackageResourceTags
+	Stateful bool // Replacement destroys data, e.g. a database or file system.
 }
@@ -0,0 +1,848 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/hashicorp/terraform-provider-aws/names/data"
+	"github.com/hashicorp/terraform-provider-aws/skaff/convert"
+	"github.com/hashicorp/terraform-provider-aws/skaff/sdkmodel"
+	"golang.org/x/tools/imports"
+)
+
+//go:embed resourcesdk.gtpl
+var resourceSDKTmpl string
+
+//go:embed resourcesdktest.gtpl
+var resourceSDKTestTmpl string
+
+//go:embed sweepsdk.gtpl
+var sweepSDKTmpl string
+
+// SDKTemplateData is the template data for a resource generated from an AWS SDK for Go v2 API.
+type SDKTemplateData struct {
+	TemplateData
+
+	// Noun is the resource's name in the API (e.g., "Prompt" for "CreatePrompt").
+	Noun        string
+	CreateOp    string
+	ClientToken bool
+	// CreateIDExpr is the expression for the resource's identifier in the create operation's output.
+	CreateIDExpr string
+	// ARN is whether the resource has an ARN.
+	ARN bool
+	// NameField is the model field holding the resource's name, used in error messages.
+	NameField string
+
+	SchemaAttributes string
+	SchemaBlocks     string
+	Models           string
+
+	ReadOp            string
+	ReadIDField       string
+	ReadIDList        bool
+	FindType          string
+	FindOutputField   string
+	FindOutputList    bool
+	NotFoundException string
+
+	UpdateOp      string
+	UpdateIDField string
+	UpdateFields  []string
+
+	DeleteOp      string
+	DeleteIDField string
+
+	StatusField   string
+	CreatePending []string
+	UpdatePending []string
+	DeletePending []string
+	Target        []string
+
+	ListOp         string
+	ListItemsField string
+	ListIDField    string
+
+	TestArguments []testArgument
+	TestUsesName  bool
+}
+
+type testArgument struct {
+	Name  string
+	Value string
+}
+
+var (
+	createPendingRegexp = regexp.MustCompile(`CREAT|PENDING|IN_PROGRESS|PROVISIONING|STARTING|INITIALIZING`)
+	updatePendingRegexp = regexp.MustCompile(`UPDAT|MODIFY|PENDING`)
+	deletePendingRegexp = regexp.MustCompile(`DELET`)
+	targetRegexp        = regexp.MustCompile(`^(ACTIVE|AVAILABLE|READY|CREATED|ENABLED|COMPLETED?|SUCCEEDED|RUNNING|PREPARED|IN_SERVICE|ONLINE|DEPLOYED)$`)
+	failedRegexp        = regexp.MustCompile(`FAIL`)
+)
+
+// CreateFromSDK creates scaffolding for a Plugin Framework resource by inspecting an AWS SDK for Go v2 API.
+// spec identifies the resource's create operation as "<SDK package>:<operation>" (e.g., "bedrockagent:CreatePrompt").
+func CreateFromSDK(spec, resName, snakeName string, comments, force bool) error {
+	wd, err := os.Getwd() // os.Getenv("GOPACKAGE") not available since this is not run with go generate
+	if err != nil {
+		return fmt.Errorf("error reading working directory: %s", err)
+	}
+
+	servicePackage := filepath.Base(wd)
+
+	sdkPackage, createOp, ok := strings.Cut(spec, ":")
+	if !ok || sdkPackage == "" || createOp == "" {
+		return fmt.Errorf("error checking: --from-sdk must be of the form <service>:<Create operation> (e.g., bedrockagent:CreatePrompt)")
+	}
+
+	noun, ok := strings.CutPrefix(createOp, "Create")
+	if !ok || noun == "" {
+		return fmt.Errorf("error checking: operation (%s) is not a Create operation", createOp)
+	}
+
+	if resName == "" {
+		resName = noun
+	}
+
+	if resName == strings.ToLower(resName) {
+		return fmt.Errorf("error checking: name should be properly capitalized (e.g., DBInstance)")
+	}
+
+	if snakeName != "" && snakeName != strings.ToLower(snakeName) {
+		return fmt.Errorf("error checking: snake name should be all lower case with underscores, if needed (e.g., db_instance)")
+	}
+
+	if snakeName == "" {
+		snakeName = names.ToSnakeCase(resName)
+	}
+
+	service, err := data.LookupService(servicePackage)
+	if err != nil {
+		return fmt.Errorf("error looking up service package data for %q: %w", servicePackage, err)
+	}
+
+	api, err := sdkmodel.Load(wd, sdkPackage)
+	if err != nil {
+		return err
+	}
+
+	templateData := &SDKTemplateData{
+		TemplateData: TemplateData{
+			Resource:             resName,
+			ResourceLower:        strings.ToLower(resName),
+			ResourceSnake:        snakeName,
+			HumanFriendlyService: service.HumanFriendly(),
+			IncludeComments:      comments,
+			SDKPackage:           sdkPackage,
+			ServicePackage:       servicePackage,
+			Service:              service.ProviderNameUpper(),
+			ServiceLower:         strings.ToLower(service.ProviderNameUpper()),
+			AWSServiceName:       service.FullHumanFriendly(),
+			PluginFramework:      true,
+			HumanResourceName:    convert.ToHumanResName(resName),
+			ProviderResourceName: convert.ToProviderResourceName(servicePackage, snakeName),
+		},
+		Noun:     noun,
+		CreateOp: createOp,
+	}
+
+	// names.Attr* constants are generated from this file.
+	attrConsts, err := readAttrConsts(filepath.Join("..", "..", "..", "names", "attr_constants.csv"))
+	if err != nil {
+		return err
+	}
+
+	if err := populateSDKTemplateData(api, attrConsts, templateData); err != nil {
+		return err
+	}
+
+	f := fmt.Sprintf("%s.go", snakeName)
+	if err = writeSDKTemplate("newres", f, resourceSDKTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing resource template: %w", err)
+	}
+
+	tf := fmt.Sprintf("%s_test.go", snakeName)
+	if err = writeSDKTemplate("restest", tf, resourceSDKTestTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing resource test template: %w", err)
+	}
+
+	if templateData.ListOp != "" {
+		if err = writeSweeper(templateData); err != nil {
+			return fmt.Errorf("writing sweeper: %w", err)
+		}
+	}
+
+	wf := fmt.Sprintf("%s_%s.html.markdown", servicePackage, snakeName)
+	wf = filepath.Join("..", "..", "..", "website", "docs", "r", wf)
+	if err = writeTemplate("webdoc", wf, websiteTmpl, force, templateData.TemplateData); err != nil {
+		return fmt.Errorf("writing resource website doc template: %w", err)
+	}
+
+	fmt.Printf("Add the following to exports_test.go:\n\tResource%[1]s = newResource%[1]s\n\tFind%[1]sByID = find%[1]sByID\n", resName)
+
+	return nil
+}
+
+// populateSDKTemplateData populates the template data from the API's create, read, update, delete and list operations.
+func populateSDKTemplateData(api *sdkmodel.API, attrConsts map[string]string, td *SDKTemplateData) error {
+	noun := td.Noun
+
+	create, ok := api.Operation(td.CreateOp)
+	if !ok {
+		return fmt.Errorf("operation %s not found in AWS SDK for Go v2 package %q", td.CreateOp, api.Package)
+	}
+
+	// Finder.
+	var read *sdkmodel.Operation
+	for _, name := range []string{"Get" + noun, "Describe" + noun, "Describe" + noun + "s"} {
+		if read, ok = api.Operation(name); ok {
+			break
+		}
+	}
+	if read == nil {
+		return fmt.Errorf("no Get%[1]s or Describe%[1]s operation found in AWS SDK for Go v2 package %q", noun, api.Package)
+	}
+	td.ReadOp = read.Name
+
+	idField, idList := identifierField(read.Input, noun)
+	if idField == "" {
+		return fmt.Errorf("unable to determine the identifier member of %sInput", read.Name)
+	}
+	td.ReadIDField, td.ReadIDList = idField, idList
+
+	findStruct := read.Output
+	td.FindType = fmt.Sprintf("%s.%sOutput", api.Package, read.Name)
+	if field := resourceField(read.Output, noun); field != nil {
+		findStruct = field.Shape.Struct
+		td.FindType = "awstypes." + field.Shape.Name
+		td.FindOutputField = field.Name
+		td.FindOutputList = field.Shape.Kind == sdkmodel.KindList
+	}
+
+	for _, name := range []string{"ResourceNotFoundException", noun + "NotFoundException", "NotFoundException", "NoSuch" + noun, "NoSuch" + noun + "Exception"} {
+		if api.HasType(name) {
+			td.NotFoundException = name
+			break
+		}
+	}
+
+	td.CreateIDExpr = createIDExpr(create.Output, noun, idField)
+
+	// Update.
+	var update *sdkmodel.Operation
+	if update, ok = api.Operation("Update" + noun); ok {
+		td.UpdateOp = update.Name
+		td.UpdateIDField, _ = identifierField(update.Input, noun)
+	}
+
+	// Delete.
+	if del, ok := api.Operation("Delete" + noun); ok {
+		td.DeleteOp = del.Name
+		td.DeleteIDField, _ = identifierField(del.Input, noun)
+	}
+
+	// Schema and models.
+	g := &schemaGenerator{
+		attrConsts: attrConsts,
+		models:     make(map[string]string),
+	}
+
+	var members []member
+	for _, field := range create.Input.Fields {
+		switch {
+		case field.Name == "ClientToken":
+			td.ClientToken = true
+			continue
+		case field.Name == "Tags" && field.Shape.Kind == sdkmodel.KindMap:
+			td.IncludeTags = true
+			continue
+		}
+
+		forceNew := true
+		if update != nil {
+			if _, ok := update.Input.Field(field.Name); ok && field.Name != td.UpdateIDField {
+				forceNew = false
+				td.UpdateFields = append(td.UpdateFields, field.Name)
+			}
+		}
+
+		members = append(members, member{field: field, forceNew: forceNew})
+
+		if field.Name == "Name" && field.Shape.Kind == sdkmodel.KindString {
+			td.NameField = "Name"
+		}
+	}
+
+	if len(td.UpdateFields) == 0 {
+		td.UpdateOp = ""
+	}
+	slices.Sort(td.UpdateFields)
+
+	var hasARN bool
+	for _, field := range findStruct.Fields {
+		if _, ok := create.Input.Field(field.Name); ok {
+			continue
+		}
+
+		switch field.Name {
+		case "Arn", noun + "Arn":
+			hasARN = true
+			continue
+		case "Id", noun + "Id", idField, "Tags", "NextToken":
+			continue
+		}
+
+		members = append(members, member{field: field, computed: true})
+	}
+
+	attributes, blocks, fields := g.object(members, nil)
+
+	attributes = append(attributes, entry{key: names.AttrID, code: "names.AttrID: framework.IDAttribute(),"})
+	fields = append(fields, modelField{name: "ID", typ: "types.String", tag: names.AttrID})
+	if hasARN {
+		td.ARN = true
+		attributes = append(attributes, entry{key: names.AttrARN, code: "names.AttrARN: framework.ARNAttributeComputedOnly(),"})
+		fields = append(fields, modelField{name: "ARN", typ: "types.String", tag: names.AttrARN})
+	}
+	if td.IncludeTags {
+		attributes = append(attributes,
+			entry{key: names.AttrTags, code: "names.AttrTags: tftags.TagsAttribute(),"},
+			entry{key: names.AttrTagsAll, code: "names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),"},
+		)
+		fields = append(fields,
+			modelField{name: "Tags", typ: "tftags.Map", tag: names.AttrTags},
+			modelField{name: "TagsAll", typ: "tftags.Map", tag: names.AttrTagsAll},
+		)
+	}
+	fields = append(fields, modelField{name: "Timeouts", typ: "timeouts.Value", tag: "timeouts"})
+
+	td.SchemaAttributes = joinEntries(attributes)
+	td.SchemaBlocks = joinEntries(blocks)
+	td.Models = g.model("resource"+td.Resource+"Model", fields) + g.nestedModels()
+
+	// Status and waiters.
+	for _, name := range []string{"Status", noun + "Status", "State", noun + "State"} {
+		if field, ok := findStruct.Field(name); ok && field.Shape.Kind == sdkmodel.KindEnum {
+			td.StatusField = name
+			for _, v := range api.EnumValues(field.Shape.Name) {
+				constant := "awstypes." + v.Constant
+				switch {
+				case failedRegexp.MatchString(v.Value):
+				case deletePendingRegexp.MatchString(v.Value):
+					td.DeletePending = append(td.DeletePending, constant)
+				case targetRegexp.MatchString(v.Value):
+					td.Target = append(td.Target, constant)
+				case createPendingRegexp.MatchString(v.Value):
+					td.CreatePending = append(td.CreatePending, constant)
+				case updatePendingRegexp.MatchString(v.Value):
+					td.UpdatePending = append(td.UpdatePending, constant)
+				}
+			}
+			break
+		}
+	}
+	if len(td.Target) == 0 {
+		td.CreatePending, td.UpdatePending = nil, nil
+	}
+	if td.UpdateOp == "" {
+		td.UpdatePending = nil
+	}
+
+	// Sweeper.
+	if list, ok := api.Operation("List" + noun + "s"); ok && list.Paginated {
+		for _, field := range list.Output.Fields {
+			if field.Shape.Kind != sdkmodel.KindList || field.Shape.Elem.Kind != sdkmodel.KindStruct {
+				continue
+			}
+
+			for _, name := range []string{idField, noun + "Id", "Id", noun + "Arn", "Arn", noun + "Name", "Name"} {
+				if v, ok := field.Shape.Elem.Struct.Field(name); ok && v.Shape.Kind == sdkmodel.KindString {
+					td.ListOp = list.Name
+					td.ListItemsField = field.Name
+					td.ListIDField = name
+					break
+				}
+			}
+			break
+		}
+	}
+
+	// Acceptance test configuration.
+	for _, m := range members {
+		if m.computed || !m.field.Required {
+			continue
+		}
+
+		name := names.ToSnakeCase(m.field.Name)
+		var value string
+
+		switch shape := m.field.Shape; shape.Kind {
+		case sdkmodel.KindString:
+			value = "%[1]q"
+			td.TestUsesName = true
+		case sdkmodel.KindEnum:
+			if v := api.EnumValues(shape.Name); len(v) > 0 {
+				value = fmt.Sprintf("%q", v[0].Value)
+			}
+		case sdkmodel.KindInt64:
+			value = "1"
+		case sdkmodel.KindBool:
+			value = "true"
+		case sdkmodel.KindFloat64:
+			value = "1.0"
+		}
+
+		if value == "" {
+			value = "# TODO: configure required argument"
+			name = "# " + name
+		}
+
+		td.TestArguments = append(td.TestArguments, testArgument{Name: name, Value: value})
+	}
+
+	return nil
+}
+
+// identifierField returns the name of the input member identifying the resource and whether it is a list of identifiers.
+func identifierField(input *sdkmodel.Struct, noun string) (string, bool) {
+	for _, name := range []string{noun + "Identifier", noun + "Id", "Identifier", "Id", noun + "Arn", "Arn", noun + "Name", "Name"} {
+		if field, ok := input.Field(name); ok && field.Shape.Kind == sdkmodel.KindString {
+			return name, false
+		}
+	}
+
+	for _, field := range input.Fields {
+		if field.Required && field.Shape.Kind == sdkmodel.KindString {
+			return field.Name, false
+		}
+	}
+
+	for _, name := range []string{noun + "Ids", noun + "Identifiers", noun + "Arns", noun + "Names"} {
+		if field, ok := input.Field(name); ok && field.Shape.Kind == sdkmodel.KindList && field.Shape.Elem.Kind == sdkmodel.KindString {
+			return name, true
+		}
+	}
+
+	return "", false
+}
+
+// resourceField returns the output member holding the resource's description, if any.
+func resourceField(output *sdkmodel.Struct, noun string) *sdkmodel.Field {
+	for _, field := range output.Fields {
+		shape := field.Shape
+
+		if shape.Kind == sdkmodel.KindStruct && (field.Name == noun || shape.Name == noun) {
+			return field
+		}
+
+		if shape.Kind == sdkmodel.KindList && shape.Elem.Kind == sdkmodel.KindStruct && (field.Name == noun+"s" || shape.Elem.Name == noun) {
+			return &sdkmodel.Field{
+				Name:  field.Name,
+				Shape: &sdkmodel.Shape{Kind: sdkmodel.KindList, Name: shape.Elem.Name, Struct: shape.Elem.Struct},
+			}
+		}
+	}
+
+	return nil
+}
+
+// createIDExpr returns the expression for the resource's identifier in the create operation's output.
+func createIDExpr(output *sdkmodel.Struct, noun, idField string) string {
+	candidates := []string{idField, noun + "Id", "Id", noun + "Arn", "Arn", noun + "Name", "Name"}
+
+	for _, name := range candidates {
+		if field, ok := output.Field(name); ok && field.Shape.Kind == sdkmodel.KindString {
+			return "out." + name
+		}
+	}
+
+	if field := resourceField(output, noun); field != nil && field.Shape.Kind == sdkmodel.KindStruct {
+		for _, name := range candidates {
+			if v, ok := field.Shape.Struct.Field(name); ok && v.Shape.Kind == sdkmodel.KindString {
+				return "out." + field.Name + "." + name
+			}
+		}
+	}
+
+	return ""
+}
+
+type member struct {
+	field    *sdkmodel.Field
+	computed bool
+	forceNew bool
+}
+
+type entry struct {
+	key  string
+	code string
+}
+
+type modelField struct {
+	name string
+	typ  string
+	tag  string
+}
+
+type schemaGenerator struct {
+	attrConsts map[string]string
+	// models maps nested model name to definition.
+	models map[string]string
+}
+
+// object returns the attribute and block definitions and the model fields for the specified members.
+// path lists the enclosing structures, used to detect recursion.
+func (g *schemaGenerator) object(members []member, path []string) ([]entry, []entry, []modelField) {
+	var attributes, blocks []entry
+	var fields []modelField
+
+	for _, m := range members {
+		name := names.ToSnakeCase(m.field.Name)
+		key := g.key(name)
+		shape := m.field.Shape
+
+		todo := func(reason string) {
+			attributes = append(attributes, entry{key: name, code: fmt.Sprintf("// TODO: %s (%s) %s.", name, shape.Name, reason)})
+		}
+
+		var flags []string
+		switch {
+		case m.computed:
+			flags = append(flags, "Computed: true,")
+		case m.field.Required:
+			flags = append(flags, "Required: true,")
+		default:
+			flags = append(flags, "Optional: true,")
+		}
+
+		planModifiers := func(typ string) {
+			pkg := strings.ToLower(typ) + "planmodifier"
+			switch {
+			case m.computed:
+				flags = append(flags, fmt.Sprintf("PlanModifiers: []planmodifier.%s{\n%s.UseStateForUnknown(),\n},", typ, pkg))
+			case m.forceNew:
+				flags = append(flags, fmt.Sprintf("PlanModifiers: []planmodifier.%s{\n%s.RequiresReplace(),\n},", typ, pkg))
+			}
+		}
+
+		attribute := func(schemaType, customType, elementType, modelType, planModifierType string) {
+			var lines []string
+			if customType != "" {
+				lines = append(lines, "CustomType: "+customType+",")
+			}
+			if elementType != "" {
+				lines = append(lines, "ElementType: "+elementType+",")
+			}
+			planModifiers(planModifierType)
+			lines = append(lines, flags...)
+
+			attributes = append(attributes, entry{key: name, code: fmt.Sprintf("%s: schema.%s{\n%s\n},", key, schemaType, strings.Join(lines, "\n"))})
+			fields = append(fields, modelField{name: m.field.Name, typ: modelType, tag: name})
+		}
+
+		switch shape.Kind {
+		case sdkmodel.KindString:
+			attribute("StringAttribute", "", "", "types.String", "String")
+		case sdkmodel.KindInt64:
+			attribute("Int64Attribute", "", "", "types.Int64", "Int64")
+		case sdkmodel.KindBool:
+			attribute("BoolAttribute", "", "", "types.Bool", "Bool")
+		case sdkmodel.KindFloat64:
+			attribute("Float64Attribute", "", "", "types.Float64", "Float64")
+		case sdkmodel.KindTimestamp:
+			attribute("StringAttribute", "timetypes.RFC3339Type{}", "", "timetypes.RFC3339", "String")
+		case sdkmodel.KindEnum:
+			attribute("StringAttribute", fmt.Sprintf("fwtypes.StringEnumType[awstypes.%s]()", shape.Name), "", fmt.Sprintf("fwtypes.StringEnum[awstypes.%s]", shape.Name), "String")
+
+		case sdkmodel.KindList:
+			switch elem := shape.Elem; elem.Kind {
+			case sdkmodel.KindString:
+				attribute("ListAttribute", "fwtypes.ListOfStringType", "types.StringType", "fwtypes.ListValueOf[types.String]", "List")
+			case sdkmodel.KindEnum:
+				enum := fmt.Sprintf("fwtypes.StringEnum[awstypes.%s]", elem.Name)
+				attribute("SetAttribute", fmt.Sprintf("fwtypes.NewSetTypeOf[%s](ctx)", enum), fmt.Sprintf("fwtypes.StringEnumType[awstypes.%s]()", elem.Name), fmt.Sprintf("fwtypes.SetValueOf[%s]", enum), "Set")
+			case sdkmodel.KindInt64, sdkmodel.KindBool, sdkmodel.KindFloat64:
+				typ := scalarType(elem.Kind)
+				attribute("ListAttribute", "", "types."+typ+"Type", "types.List", "List")
+			case sdkmodel.KindStruct:
+				if slices.Contains(path, elem.Name) {
+					shape = elem
+					todo("is recursive and is not supported by skaff")
+					continue
+				}
+				g.nested(m, elem, false, name, key, path, &attributes, &blocks, &fields)
+			default:
+				todo("is not supported by skaff")
+			}
+
+		case sdkmodel.KindMap:
+			switch elem := shape.Elem; elem.Kind {
+			case sdkmodel.KindString:
+				attribute("MapAttribute", "fwtypes.MapOfStringType", "types.StringType", "fwtypes.MapOfString", "Map")
+			case sdkmodel.KindInt64, sdkmodel.KindBool, sdkmodel.KindFloat64:
+				typ := scalarType(elem.Kind)
+				attribute("MapAttribute", "", "types."+typ+"Type", "types.Map", "Map")
+			default:
+				shape = &sdkmodel.Shape{Name: "map of " + elem.Name}
+				todo("is not supported by skaff")
+			}
+
+		case sdkmodel.KindStruct:
+			if slices.Contains(path, shape.Name) {
+				todo("is recursive and is not supported by skaff")
+				continue
+			}
+			g.nested(m, shape, true, name, key, path, &attributes, &blocks, &fields)
+
+		default:
+			todo("is not supported by skaff")
+		}
+	}
+
+	return attributes, blocks, fields
+}
+
+// nested adds the definition of a nested object.
+// Configurable objects are blocks; computed-only objects are nested attributes.
+func (g *schemaGenerator) nested(m member, shape *sdkmodel.Shape, single bool, name, key string, path []string, attributes, blocks *[]entry, fields *[]modelField) {
+	modelName := lowerFirst(shape.Name) + "Model"
+	path = append(slices.Clone(path), shape.Name)
+
+	var members []member
+	for _, field := range shape.Struct.Fields {
+		members = append(members, member{field: field, computed: m.computed})
+	}
+
+	nestedAttributes, nestedBlocks, nestedFields := g.object(members, path)
+
+	if _, ok := g.models[modelName]; !ok {
+		// Reserve the name before generating to terminate recursion.
+		g.models[modelName] = ""
+		g.models[modelName] = g.model(modelName, nestedFields)
+	}
+
+	*fields = append(*fields, modelField{name: m.field.Name, typ: fmt.Sprintf("fwtypes.ListNestedObjectValueOf[%s]", modelName), tag: name})
+
+	var lines []string
+	lines = append(lines, fmt.Sprintf("CustomType: fwtypes.NewListNestedObjectTypeOf[%s](ctx),", modelName))
+
+	if m.computed {
+		lines = append(lines, "Computed: true,", "PlanModifiers: []planmodifier.List{\nlistplanmodifier.UseStateForUnknown(),\n},")
+		lines = append(lines, fmt.Sprintf("NestedObject: schema.NestedAttributeObject{\nAttributes: map[string]schema.Attribute{\n%s\n},\n},", joinEntries(nestedAttributes)))
+
+		*attributes = append(*attributes, entry{key: name, code: fmt.Sprintf("%s: schema.ListNestedAttribute{\n%s\n},", key, strings.Join(lines, "\n"))})
+
+		return
+	}
+
+	var validators []string
+	if m.field.Required {
+		validators = append(validators, "listvalidator.IsRequired(),")
+	}
+	if single {
+		validators = append(validators, "listvalidator.SizeAtMost(1),")
+	}
+	if len(validators) > 0 {
+		lines = append(lines, fmt.Sprintf("Validators: []validator.List{\n%s\n},", strings.Join(validators, "\n")))
+	}
+	if m.forceNew {
+		lines = append(lines, "PlanModifiers: []planmodifier.List{\nlistplanmodifier.RequiresReplace(),\n},")
+	}
+
+	object := fmt.Sprintf("NestedObject: schema.NestedBlockObject{\nAttributes: map[string]schema.Attribute{\n%s\n},", joinEntries(nestedAttributes))
+	if len(nestedBlocks) > 0 {
+		object += fmt.Sprintf("\nBlocks: map[string]schema.Block{\n%s\n},", joinEntries(nestedBlocks))
+	}
+	object += "\n},"
+	lines = append(lines, object)
+
+	*blocks = append(*blocks, entry{key: name, code: fmt.Sprintf("%s: schema.ListNestedBlock{\n%s\n},", key, strings.Join(lines, "\n"))})
+}
+
+// key returns the schema map key for the named attribute, preferring a names.Attr* constant.
+func (g *schemaGenerator) key(name string) string {
+	if v, ok := g.attrConsts[name]; ok {
+		return "names.Attr" + v
+	}
+
+	return fmt.Sprintf("%q", name)
+}
+
+// model returns the definition of the named model struct.
+func (g *schemaGenerator) model(name string, fields []modelField) string {
+	slices.SortFunc(fields, func(a, b modelField) int {
+		return strings.Compare(a.name, b.name)
+	})
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "\ntype %s struct {\n", name)
+	for _, f := range fields {
+		fmt.Fprintf(&b, "%s %s `tfsdk:%q`\n", f.name, f.typ, f.tag)
+	}
+	b.WriteString("}\n")
+
+	return b.String()
+}
+
+// nestedModels returns the definitions of the nested model structs, sorted by name.
+func (g *schemaGenerator) nestedModels() string {
+	var b strings.Builder
+
+	for _, name := range slices.Sorted(maps.Keys(g.models)) {
+		b.WriteString(g.models[name])
+	}
+
+	return b.String()
+}
+
+func joinEntries(entries []entry) string {
+	slices.SortFunc(entries, func(a, b entry) int {
+		return strings.Compare(a.key, b.key)
+	})
+
+	codes := make([]string, 0, len(entries))
+	for _, e := range entries {
+		codes = append(codes, e.code)
+	}
+
+	return strings.Join(codes, "\n")
+}
+
+func scalarType(kind sdkmodel.Kind) string {
+	switch kind {
+	case sdkmodel.KindInt64:
+		return "Int64"
+	case sdkmodel.KindBool:
+		return "Bool"
+	case sdkmodel.KindFloat64:
+		return "Float64"
+	default:
+		return "String"
+	}
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+
+	return strings.ToLower(s[:1]) + s[1:]
+}
+
+// readAttrConsts reads the mapping of attribute name to names.Attr* constant suffix.
+// An empty mapping is returned if the file doesn't exist.
+func readAttrConsts(filename string) (map[string]string, error) {
+	f, err := os.Open(filename)
+	if errors.Is(err, fs.ErrNotExist) {
+		return map[string]string{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error opening file (%s): %s", filename, err)
+	}
+	defer f.Close()
+
+	records, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("error reading file (%s): %s", filename, err)
+	}
+
+	consts := make(map[string]string, len(records))
+	for _, record := range records {
+		if len(record) >= 2 {
+			consts[record[0]] = record[1]
+		}
+	}
+
+	return consts, nil
+}
+
+// writeSweeper writes the resource's sweeper to sweep.go, creating the file if it doesn't exist.
+func writeSweeper(td *SDKTemplateData) error {
+	const filename = "sweep.go"
+
+	_, err := os.Stat(filename)
+	exists := err == nil
+
+	tplate, err := template.New("sweep").Parse(sweepSDKTmpl)
+	if err != nil {
+		return fmt.Errorf("error parsing template: %s", err)
+	}
+
+	var buffer bytes.Buffer
+	if err := tplate.Execute(&buffer, struct {
+		*SDKTemplateData
+		NewFile bool
+	}{td, !exists}); err != nil {
+		return fmt.Errorf("error executing template: %s", err)
+	}
+
+	if !exists {
+		return writeSource(filename, buffer.Bytes())
+	}
+
+	existing, err := os.ReadFile(filename)
+	if err != nil {
+		return fmt.Errorf("error reading file (%s): %s", filename, err)
+	}
+
+	if err := writeSource(filename, append(existing, buffer.Bytes()...)); err != nil {
+		return err
+	}
+
+	fmt.Printf("Register the sweeper in RegisterSweepers in %s:\n\tawsv2.Register(%q, sweep%ss)\n", filename, td.ProviderResourceName, td.Resource)
+
+	return nil
+}
+
+func writeSDKTemplate(templateName, filename, tmpl string, force bool, td *SDKTemplateData) error {
+	if _, err := os.Stat(filename); !errors.Is(err, fs.ErrNotExist) && !force {
+		return fmt.Errorf("file (%s) already exists and force is not set", filename)
+	}
+
+	tplate, err := template.New(templateName).Parse(tmpl)
+	if err != nil {
+		return fmt.Errorf("error parsing template: %s", err)
+	}
+
+	var buffer bytes.Buffer
+	err = tplate.Execute(&buffer, td)
+	if err != nil {
+		return fmt.Errorf("error executing template: %s", err)
+	}
+
+	return writeSource(filename, buffer.Bytes())
+}
+
+// writeSource formats Go source, removing unused imports, and writes it to the named file.
+// Unformatted source is written if formatting fails so that it can be fixed by hand.
+func writeSource(filename string, src []byte) error {
+	contents, formatErr := imports.Process(filename, src, nil)
+	if formatErr != nil {
+		contents = src
+	}
+
+	if err := os.WriteFile(filename, contents, 0644); err != nil {
+		return fmt.Errorf("error writing to file (%s): %s", filename, err)
+	}
+
+	if formatErr != nil {
+		return fmt.Errorf("error formatting generated file (%s): %s", filename, formatErr)
+	}
+
+	return nil
+}
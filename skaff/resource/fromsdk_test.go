@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"bytes"
+	"go/format"
+	"slices"
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/hashicorp/terraform-provider-aws/skaff/sdkmodel"
+)
+
+func TestPopulateSDKTemplateData(t *testing.T) {
+	api, err := sdkmodel.LoadPackage(".", "github.com/hashicorp/terraform-provider-aws/skaff/sdkmodel/testdata/example")
+	if err != nil {
+		t.Fatalf("LoadPackage: %s", err)
+	}
+
+	td := &SDKTemplateData{
+		TemplateData: TemplateData{
+			Resource:             "Widget",
+			ResourceLower:        "widget",
+			ResourceSnake:        "widget",
+			HumanFriendlyService: "Example",
+			SDKPackage:           "example",
+			ServicePackage:       "example",
+			Service:              "Example",
+			ServiceLower:         "example",
+			AWSServiceName:       "Example Service",
+			PluginFramework:      true,
+			HumanResourceName:    "Widget",
+			ProviderResourceName: "aws_example_widget",
+		},
+		Noun:     "Widget",
+		CreateOp: "CreateWidget",
+	}
+
+	attrConsts := map[string]string{
+		"description": "Description",
+		"name":        "Name",
+	}
+
+	if err := populateSDKTemplateData(api, attrConsts, td); err != nil {
+		t.Fatalf("populateSDKTemplateData: %s", err)
+	}
+
+	for _, v := range []struct {
+		name      string
+		got, want string
+	}{
+		{"ReadOp", td.ReadOp, "GetWidget"},
+		{"ReadIDField", td.ReadIDField, "WidgetIdentifier"},
+		{"FindType", td.FindType, "awstypes.Widget"},
+		{"FindOutputField", td.FindOutputField, "Widget"},
+		{"NotFoundException", td.NotFoundException, "ResourceNotFoundException"},
+		{"CreateIDExpr", td.CreateIDExpr, "out.WidgetId"},
+		{"UpdateOp", td.UpdateOp, "UpdateWidget"},
+		{"DeleteIDField", td.DeleteIDField, "WidgetIdentifier"},
+		{"StatusField", td.StatusField, "Status"},
+		{"ListOp", td.ListOp, "ListWidgets"},
+		{"ListItemsField", td.ListItemsField, "WidgetSummaries"},
+		{"ListIDField", td.ListIDField, "WidgetId"},
+	} {
+		if v.got != v.want {
+			t.Errorf("%s = %q, want %q", v.name, v.got, v.want)
+		}
+	}
+
+	if !td.ARN || !td.ClientToken || !td.IncludeTags {
+		t.Errorf("ARN, ClientToken, IncludeTags = %t, %t, %t, want true, true, true", td.ARN, td.ClientToken, td.IncludeTags)
+	}
+
+	if got, want := td.UpdateFields, []string{"Capacity", "Description"}; !slices.Equal(got, want) {
+		t.Errorf("UpdateFields = %v, want %v", got, want)
+	}
+
+	if got, want := td.CreatePending, []string{"awstypes.WidgetStatusCreating"}; !slices.Equal(got, want) {
+		t.Errorf("CreatePending = %v, want %v", got, want)
+	}
+	if got, want := td.DeletePending, []string{"awstypes.WidgetStatusDeleting"}; !slices.Equal(got, want) {
+		t.Errorf("DeletePending = %v, want %v", got, want)
+	}
+	if got, want := td.Target, []string{"awstypes.WidgetStatusActive"}; !slices.Equal(got, want) {
+		t.Errorf("Target = %v, want %v", got, want)
+	}
+
+	for _, want := range []string{
+		"names.AttrName: schema.StringAttribute{",
+		`"kind": schema.StringAttribute{
+CustomType: fwtypes.StringEnumType[awstypes.WidgetKind](),`,
+		`"capacity": schema.Int64Attribute{
+Optional: true,`,
+		`"labels": schema.ListAttribute{
+CustomType: fwtypes.ListOfStringType,`,
+		`"status": schema.StringAttribute{
+CustomType: fwtypes.StringEnumType[awstypes.WidgetStatus](),
+Computed: true,`,
+		`"created_at": schema.StringAttribute{
+CustomType: timetypes.RFC3339Type{},`,
+		"names.AttrARN: framework.ARNAttributeComputedOnly(),",
+		"// TODO: payload ([]byte) is not supported by skaff.",
+	} {
+		if !strings.Contains(td.SchemaAttributes, want) {
+			t.Errorf("schema attributes do not contain %q:\n%s", want, td.SchemaAttributes)
+		}
+	}
+
+	for _, want := range []string{
+		`"configuration": schema.ListNestedBlock{
+CustomType: fwtypes.NewListNestedObjectTypeOf[widgetConfigurationModel](ctx),
+Validators: []validator.List{
+listvalidator.SizeAtMost(1),
+},`,
+		"// TODO: children (WidgetConfiguration) is recursive and is not supported by skaff.",
+		`"kinds": schema.SetAttribute{
+CustomType: fwtypes.NewSetTypeOf[fwtypes.StringEnum[awstypes.WidgetKind]](ctx),`,
+	} {
+		if !strings.Contains(td.SchemaBlocks, want) {
+			t.Errorf("schema blocks do not contain %q:\n%s", want, td.SchemaBlocks)
+		}
+	}
+
+	for _, want := range []string{
+		"type resourceWidgetModel struct {",
+		"Parts fwtypes.ListNestedObjectValueOf[partModel] `tfsdk:\"parts\"`",
+		"type partModel struct {",
+		"type widgetConfigurationModel struct {",
+	} {
+		if !strings.Contains(td.Models, want) {
+			t.Errorf("models do not contain %q:\n%s", want, td.Models)
+		}
+	}
+
+	for _, v := range []struct {
+		name string
+		tmpl string
+	}{
+		{"resource", resourceSDKTmpl},
+		{"test", resourceSDKTestTmpl},
+	} {
+		tplate, err := template.New(v.name).Parse(v.tmpl)
+		if err != nil {
+			t.Fatalf("parsing %s template: %s", v.name, err)
+		}
+
+		var buffer bytes.Buffer
+		if err := tplate.Execute(&buffer, td); err != nil {
+			t.Fatalf("executing %s template: %s", v.name, err)
+		}
+
+		if _, err := format.Source(buffer.Bytes()); err != nil {
+			t.Errorf("generated %s source is invalid: %s\n%s", v.name, err, buffer.String())
+		}
+	}
+}
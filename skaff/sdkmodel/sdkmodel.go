@@ -0,0 +1,406 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package sdkmodel builds a model of an AWS SDK for Go v2 service API from the service's Go packages.
+package sdkmodel
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/types"
+	"path"
+	"slices"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+const (
+	sdkModulePath = "github.com/aws/aws-sdk-go-v2/service/"
+
+	// requiredMarker is the sentence that the SDK code generator adds to the documentation of required members.
+	requiredMarker = "This member is required."
+)
+
+// Kind is the kind of value held by a shape.
+type Kind int
+
+const (
+	KindUnsupported Kind = iota
+	KindString
+	KindInt64
+	KindBool
+	KindFloat64
+	KindTimestamp
+	KindEnum
+	KindStruct
+	KindList
+	KindMap
+)
+
+// Shape describes the type of a member.
+type Shape struct {
+	Kind Kind
+	// Name is the name of the type in the service's types package, for enums and structures,
+	// or a description of the Go type, for unsupported shapes.
+	Name string
+	// Elem is the element shape of a list or map.
+	Elem *Shape
+	// Struct is the structure definition of a KindStruct shape.
+	Struct *Struct
+	// Pointer is whether the member is a pointer.
+	Pointer bool
+}
+
+// Field is a member of a structure.
+type Field struct {
+	Name     string
+	Shape    *Shape
+	Required bool
+}
+
+// Struct is a structure.
+type Struct struct {
+	Name   string
+	Fields []*Field
+}
+
+// Field returns the named member of the structure.
+func (s *Struct) Field(name string) (*Field, bool) {
+	i := slices.IndexFunc(s.Fields, func(f *Field) bool {
+		return f.Name == name
+	})
+
+	if i < 0 {
+		return nil, false
+	}
+
+	return s.Fields[i], true
+}
+
+// Operation is an API operation.
+type Operation struct {
+	Name   string
+	Input  *Struct
+	Output *Struct
+	// Paginated is whether the SDK has a paginator for the operation.
+	Paginated bool
+}
+
+// EnumValue is a value of an enum.
+type EnumValue struct {
+	// Constant is the name of the Go constant in the service's types package.
+	Constant string
+	Value    string
+}
+
+// API is a service API.
+type API struct {
+	// Package is the name of the service's Go package (e.g., "bedrockagent").
+	Package string
+
+	pkg        *types.Package
+	typesPkg   *types.Package
+	required   map[string]map[string]bool
+	structs    map[string]*Struct
+	enumValues map[string][]EnumValue
+}
+
+// Load loads the API of the specified service using the Go module in the specified directory.
+// The service is identified by its AWS SDK for Go v2 package name (e.g., "bedrockagent").
+func Load(dir, service string) (*API, error) {
+	return LoadPackage(dir, sdkModulePath+service)
+}
+
+// LoadPackage loads the API of the service whose Go package has the specified import path.
+// The service's types must be declared in the "types" subpackage.
+func LoadPackage(dir, pkgPath string) (*API, error) {
+	service := path.Base(pkgPath)
+	typesPkgPath := pkgPath + "/types"
+
+	config := &packages.Config{
+		Dir:  dir,
+		Mode: packages.NeedName | packages.NeedImports | packages.NeedDeps | packages.NeedTypes | packages.NeedSyntax | packages.NeedTypesInfo,
+	}
+
+	pkgs, err := packages.Load(config, pkgPath, typesPkgPath)
+
+	if err != nil {
+		return nil, fmt.Errorf("loading AWS SDK for Go v2 packages for %q: %w", service, err)
+	}
+
+	api := &API{
+		Package:    service,
+		required:   make(map[string]map[string]bool),
+		structs:    make(map[string]*Struct),
+		enumValues: make(map[string][]EnumValue),
+	}
+
+	var errs []error
+	for _, pkg := range pkgs {
+		for _, err := range pkg.Errors {
+			errs = append(errs, err)
+		}
+
+		switch pkg.PkgPath {
+		case pkgPath:
+			api.pkg = pkg.Types
+		case typesPkgPath:
+			api.typesPkg = pkg.Types
+		}
+
+		for _, file := range pkg.Syntax {
+			api.addRequired(pkg.PkgPath, file)
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, fmt.Errorf("loading AWS SDK for Go v2 packages for %q: %w", service, err)
+	}
+
+	if api.pkg == nil || api.typesPkg == nil {
+		return nil, fmt.Errorf("AWS SDK for Go v2 packages for %q not found", service)
+	}
+
+	api.addEnumValues()
+
+	return api, nil
+}
+
+// Operation returns the named operation.
+func (api *API) Operation(name string) (*Operation, bool) {
+	client, ok := api.pkg.Scope().Lookup("Client").(*types.TypeName)
+
+	if !ok {
+		return nil, false
+	}
+
+	if obj, _, _ := types.LookupFieldOrMethod(types.NewPointer(client.Type()), true, api.pkg, name); obj == nil {
+		return nil, false
+	}
+
+	operation := &Operation{
+		Name:      name,
+		Paginated: api.pkg.Scope().Lookup("New"+name+"Paginator") != nil,
+	}
+
+	for _, v := range []struct {
+		suffix string
+		s      **Struct
+	}{
+		{"Input", &operation.Input},
+		{"Output", &operation.Output},
+	} {
+		obj, ok := api.pkg.Scope().Lookup(name + v.suffix).(*types.TypeName)
+
+		if !ok {
+			return nil, false
+		}
+
+		*v.s = api.structure(obj)
+	}
+
+	return operation, true
+}
+
+// HasType returns whether the service's types package declares the named type.
+func (api *API) HasType(name string) bool {
+	_, ok := api.typesPkg.Scope().Lookup(name).(*types.TypeName)
+
+	return ok
+}
+
+// TypeNames returns the names of the types declared in the service's types package.
+func (api *API) TypeNames() []string {
+	return api.typesPkg.Scope().Names()
+}
+
+// EnumValues returns the values of the named enum.
+func (api *API) EnumValues(name string) []EnumValue {
+	return api.enumValues[name]
+}
+
+// structure returns the structure definition of the named type.
+func (api *API) structure(obj *types.TypeName) *Struct {
+	key := obj.Pkg().Path() + "." + obj.Name()
+
+	if s, ok := api.structs[key]; ok {
+		return s
+	}
+
+	s := &Struct{
+		Name: obj.Name(),
+	}
+	// Register before populating to terminate recursion for recursive structures.
+	api.structs[key] = s
+
+	underlying, ok := obj.Type().Underlying().(*types.Struct)
+
+	if !ok {
+		return s
+	}
+
+	for i := range underlying.NumFields() {
+		field := underlying.Field(i)
+
+		if !field.Exported() || field.Embedded() {
+			continue
+		}
+
+		// Response metadata isn't part of the API model.
+		if field.Name() == "ResultMetadata" {
+			continue
+		}
+
+		s.Fields = append(s.Fields, &Field{
+			Name:     field.Name(),
+			Shape:    api.shape(field.Type()),
+			Required: api.required[key][field.Name()],
+		})
+	}
+
+	return s
+}
+
+// shape returns the shape of the specified member type.
+func (api *API) shape(typ types.Type) *Shape {
+	pointer := false
+	if v, ok := typ.(*types.Pointer); ok {
+		typ = v.Elem()
+		pointer = true
+	}
+
+	shape := api.valueShape(typ)
+	shape.Pointer = pointer
+
+	return shape
+}
+
+// valueShape returns the shape of the specified non-pointer member type.
+func (api *API) valueShape(typ types.Type) *Shape {
+	switch v := typ.(type) {
+	case *types.Basic:
+		switch v.Kind() {
+		case types.String:
+			return &Shape{Kind: KindString}
+		case types.Int, types.Int8, types.Int16, types.Int32, types.Int64, types.Uint8, types.Uint16, types.Uint32, types.Uint64:
+			return &Shape{Kind: KindInt64}
+		case types.Bool:
+			return &Shape{Kind: KindBool}
+		case types.Float32, types.Float64:
+			return &Shape{Kind: KindFloat64}
+		}
+
+	case *types.Named:
+		obj := v.Obj()
+
+		if obj.Pkg() == nil {
+			break
+		}
+
+		if obj.Pkg().Path() == "time" && obj.Name() == "Time" {
+			return &Shape{Kind: KindTimestamp}
+		}
+
+		if obj.Pkg().Path() != api.typesPkg.Path() {
+			break
+		}
+
+		switch underlying := v.Underlying().(type) {
+		case *types.Basic:
+			if underlying.Kind() == types.String {
+				return &Shape{Kind: KindEnum, Name: obj.Name()}
+			}
+		case *types.Struct:
+			return &Shape{Kind: KindStruct, Name: obj.Name(), Struct: api.structure(obj)}
+		}
+
+	case *types.Slice:
+		// Blobs.
+		if v, ok := v.Elem().(*types.Basic); ok && v.Kind() == types.Byte {
+			break
+		}
+
+		if elem := api.shape(v.Elem()); elem.Kind != KindUnsupported {
+			return &Shape{Kind: KindList, Elem: elem}
+		}
+
+	case *types.Map:
+		if v, ok := v.Key().(*types.Basic); !ok || v.Kind() != types.String {
+			break
+		}
+
+		if elem := api.shape(v.Elem()); elem.Kind != KindUnsupported {
+			return &Shape{Kind: KindMap, Elem: elem}
+		}
+	}
+
+	return &Shape{Kind: KindUnsupported, Name: types.TypeString(typ, types.RelativeTo(api.typesPkg))}
+}
+
+// addRequired records the members of the structures declared in the file that are documented as required.
+func (api *API) addRequired(pkgPath string, file *ast.File) {
+	for _, decl := range file.Decls {
+		decl, ok := decl.(*ast.GenDecl)
+
+		if !ok {
+			continue
+		}
+
+		for _, spec := range decl.Specs {
+			spec, ok := spec.(*ast.TypeSpec)
+
+			if !ok {
+				continue
+			}
+
+			s, ok := spec.Type.(*ast.StructType)
+
+			if !ok {
+				continue
+			}
+
+			key := pkgPath + "." + spec.Name.Name
+
+			for _, field := range s.Fields.List {
+				if field.Doc == nil || !strings.Contains(field.Doc.Text(), requiredMarker) {
+					continue
+				}
+
+				for _, name := range field.Names {
+					if api.required[key] == nil {
+						api.required[key] = make(map[string]bool)
+					}
+					api.required[key][name.Name] = true
+				}
+			}
+		}
+	}
+}
+
+// addEnumValues records the values of the enums declared in the service's types package.
+func (api *API) addEnumValues() {
+	scope := api.typesPkg.Scope()
+
+	for _, name := range scope.Names() {
+		c, ok := scope.Lookup(name).(*types.Const)
+
+		if !ok || c.Val().Kind() != constant.String {
+			continue
+		}
+
+		named, ok := c.Type().(*types.Named)
+
+		if !ok || named.Obj().Pkg().Path() != api.typesPkg.Path() {
+			continue
+		}
+
+		enum := named.Obj().Name()
+		api.enumValues[enum] = append(api.enumValues[enum], EnumValue{
+			Constant: name,
+			Value:    constant.StringVal(c.Val()),
+		})
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkmodel
+
+import (
+	"testing"
+)
+
+const testPkgPath = "github.com/hashicorp/terraform-provider-aws/skaff/sdkmodel/testdata/example"
+
+func TestLoadPackage(t *testing.T) {
+	api, err := LoadPackage(".", testPkgPath)
+	if err != nil {
+		t.Fatalf("LoadPackage: %s", err)
+	}
+
+	if got, want := api.Package, "example"; got != want {
+		t.Errorf("Package = %q, want %q", got, want)
+	}
+
+	create, ok := api.Operation("CreateWidget")
+	if !ok {
+		t.Fatal("operation CreateWidget not found")
+	}
+
+	if create.Paginated {
+		t.Error("CreateWidget: Paginated = true, want false")
+	}
+
+	testCases := []struct {
+		Field    string
+		Kind     Kind
+		Name     string
+		Required bool
+	}{
+		{Field: "Name", Kind: KindString, Required: true},
+		{Field: "Kind", Kind: KindEnum, Name: "WidgetKind", Required: true},
+		{Field: "Description", Kind: KindString},
+		{Field: "Capacity", Kind: KindInt64},
+		{Field: "Enabled", Kind: KindBool},
+		{Field: "Labels", Kind: KindList},
+		{Field: "Tags", Kind: KindMap},
+		{Field: "Configuration", Kind: KindStruct, Name: "WidgetConfiguration"},
+		{Field: "Parts", Kind: KindList},
+		{Field: "Payload", Kind: KindUnsupported, Name: "[]byte"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Field, func(t *testing.T) {
+			field, ok := create.Input.Field(testCase.Field)
+			if !ok {
+				t.Fatalf("field %s not found", testCase.Field)
+			}
+
+			if got, want := field.Shape.Kind, testCase.Kind; got != want {
+				t.Errorf("Kind = %d, want %d", got, want)
+			}
+			if got, want := field.Shape.Name, testCase.Name; got != want {
+				t.Errorf("Name = %q, want %q", got, want)
+			}
+			if got, want := field.Required, testCase.Required; got != want {
+				t.Errorf("Required = %t, want %t", got, want)
+			}
+		})
+	}
+
+	if _, ok := create.Output.Field("ResultMetadata"); ok {
+		t.Error("CreateWidgetOutput: ResultMetadata not excluded")
+	}
+
+	if field, ok := create.Output.Field("CreatedAt"); !ok || field.Shape.Kind != KindTimestamp {
+		t.Errorf("CreateWidgetOutput: CreatedAt is not a timestamp")
+	}
+
+	// Nested structures, including recursive ones, are resolved.
+	field, _ := create.Input.Field("Configuration")
+	if !field.Shape.Pointer {
+		t.Error("Configuration: Pointer = false, want true")
+	}
+	mode, ok := field.Shape.Struct.Field("Mode")
+	if !ok || !mode.Required {
+		t.Error("WidgetConfiguration: Mode not found or not required")
+	}
+	children, ok := field.Shape.Struct.Field("Children")
+	if !ok || children.Shape.Elem.Struct != field.Shape.Struct {
+		t.Error("WidgetConfiguration: Children is not a list of WidgetConfiguration")
+	}
+
+	parts, _ := create.Input.Field("Parts")
+	if got, want := parts.Shape.Elem.Kind, KindStruct; got != want {
+		t.Errorf("Parts element Kind = %d, want %d", got, want)
+	}
+	if parts.Shape.Elem.Pointer {
+		t.Error("Parts element: Pointer = true, want false")
+	}
+
+	list, ok := api.Operation("ListWidgets")
+	if !ok {
+		t.Fatal("operation ListWidgets not found")
+	}
+	if !list.Paginated {
+		t.Error("ListWidgets: Paginated = false, want true")
+	}
+
+	if _, ok := api.Operation("DescribeWidget"); ok {
+		t.Error("operation DescribeWidget unexpectedly found")
+	}
+
+	if got, want := len(api.EnumValues("WidgetStatus")), 5; got != want {
+		t.Errorf("len(EnumValues(WidgetStatus)) = %d, want %d", got, want)
+	}
+
+	if !api.HasType("ResourceNotFoundException") {
+		t.Error("type ResourceNotFoundException not found")
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package example is a minimal stand-in for an AWS SDK for Go v2 service package.
+package example
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/skaff/sdkmodel/testdata/example/types"
+)
+
+type Client struct{}
+
+type CreateWidgetInput struct {
+	// The name of the widget.
+	//
+	// This member is required.
+	Name *string
+
+	// The widget's kind.
+	//
+	// This member is required.
+	Kind types.WidgetKind
+
+	ClientToken *string
+
+	Description *string
+
+	Capacity *int32
+
+	Enabled *bool
+
+	Labels []string
+
+	Tags map[string]string
+
+	Configuration *types.WidgetConfiguration
+
+	Parts []types.Part
+
+	Payload []byte
+
+	noSmithyDocumentSerde
+}
+
+type CreateWidgetOutput struct {
+	// This member is required.
+	WidgetArn *string
+
+	// This member is required.
+	WidgetId *string
+
+	CreatedAt *time.Time
+
+	ResultMetadata struct{}
+
+	noSmithyDocumentSerde
+}
+
+type GetWidgetInput struct {
+	// This member is required.
+	WidgetIdentifier *string
+
+	noSmithyDocumentSerde
+}
+
+type GetWidgetOutput struct {
+	Widget *types.Widget
+
+	noSmithyDocumentSerde
+}
+
+type UpdateWidgetInput struct {
+	// This member is required.
+	WidgetIdentifier *string
+
+	Description *string
+
+	Capacity *int32
+
+	noSmithyDocumentSerde
+}
+
+type UpdateWidgetOutput struct {
+	noSmithyDocumentSerde
+}
+
+type DeleteWidgetInput struct {
+	// This member is required.
+	WidgetIdentifier *string
+
+	noSmithyDocumentSerde
+}
+
+type DeleteWidgetOutput struct {
+	noSmithyDocumentSerde
+}
+
+type ListWidgetsInput struct {
+	NextToken *string
+
+	noSmithyDocumentSerde
+}
+
+type ListWidgetsOutput struct {
+	// This member is required.
+	WidgetSummaries []types.WidgetSummary
+
+	NextToken *string
+
+	noSmithyDocumentSerde
+}
+
+type ListWidgetsPaginator struct{}
+
+func NewListWidgetsPaginator(client *Client, params *ListWidgetsInput) *ListWidgetsPaginator {
+	return &ListWidgetsPaginator{}
+}
+
+func (c *Client) CreateWidget(ctx context.Context, params *CreateWidgetInput) (*CreateWidgetOutput, error) {
+	return nil, nil
+}
+
+func (c *Client) GetWidget(ctx context.Context, params *GetWidgetInput) (*GetWidgetOutput, error) {
+	return nil, nil
+}
+
+func (c *Client) UpdateWidget(ctx context.Context, params *UpdateWidgetInput) (*UpdateWidgetOutput, error) {
+	return nil, nil
+}
+
+func (c *Client) DeleteWidget(ctx context.Context, params *DeleteWidgetInput) (*DeleteWidgetOutput, error) {
+	return nil, nil
+}
+
+func (c *Client) ListWidgets(ctx context.Context, params *ListWidgetsInput) (*ListWidgetsOutput, error) {
+	return nil, nil
+}
+
+type noSmithyDocumentSerde struct{}
@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"time"
+)
+
+type WidgetKind string
+
+const (
+	WidgetKindSmall WidgetKind = "SMALL"
+	WidgetKindLarge WidgetKind = "LARGE"
+)
+
+type WidgetStatus string
+
+const (
+	WidgetStatusCreating WidgetStatus = "CREATING"
+	WidgetStatusActive   WidgetStatus = "ACTIVE"
+	WidgetStatusUpdating WidgetStatus = "UPDATING"
+	WidgetStatusDeleting WidgetStatus = "DELETING"
+	WidgetStatusFailed   WidgetStatus = "FAILED"
+)
+
+type WidgetConfiguration struct {
+	// This member is required.
+	Mode *string
+
+	Children []WidgetConfiguration
+
+	noSmithyDocumentSerde
+}
+
+type Part struct {
+	// This member is required.
+	PartName *string
+
+	Weight *float64
+
+	Attributes map[string]string
+
+	Kinds []WidgetKind
+
+	noSmithyDocumentSerde
+}
+
+type Widget struct {
+	WidgetArn *string
+
+	WidgetId *string
+
+	Name *string
+
+	Kind WidgetKind
+
+	Status WidgetStatus
+
+	Description *string
+
+	Capacity *int32
+
+	Enabled *bool
+
+	Labels []string
+
+	Configuration *WidgetConfiguration
+
+	Parts []Part
+
+	CreatedAt *time.Time
+
+	noSmithyDocumentSerde
+}
+
+type WidgetSummary struct {
+	WidgetArn *string
+
+	WidgetId *string
+
+	Name *string
+
+	noSmithyDocumentSerde
+}
+
+type ResourceNotFoundException struct {
+	Message *string
+}
+
+func (e *ResourceNotFoundException) Error() string {
+	return "ResourceNotFoundException"
+}
+
+type noSmithyDocumentSerde struct{}
@@ -4,15 +4,20 @@
 package main
 
 import (
+	"bytes"
 	"context"
 	_ "embed"
 	"flag"
 	"fmt"
+	"go/format"
 	"io"
 	"os"
 	"path"
+	"reflect"
 	"sort"
 	"strings"
+	"text/template"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
@@ -114,7 +119,7 @@ func (m *migrator) migrate(outputFilename string) error {
 		return fmt.Errorf("creating target directory %s: %w", dirname, err)
 	}
 
-	templateData, err := m.generateTemplateData()
+	body, err := m.render()
 
 	if err != nil {
 		return err
@@ -122,40 +127,89 @@ func (m *migrator) migrate(outputFilename string) error {
 
 	d := m.Generator.NewGoFileDestination(outputFilename)
 
-	if err := d.BufferTemplate("schema", m.Template, templateData); err != nil {
+	if err := d.BufferBytes(body); err != nil {
 		return err
 	}
 
 	return d.Write()
 }
 
+// render generates the Go source code, formatted but without import fixups.
+func (m *migrator) render() ([]byte, error) {
+	templateData, err := m.generateTemplateData()
+
+	if err != nil {
+		return nil, err
+	}
+
+	tmpl, err := template.New("schema").Funcs(template.FuncMap{
+		"Duration": durationExpr,
+	}).Parse(m.Template)
+
+	if err != nil {
+		return nil, fmt.Errorf("parsing template: %w", err)
+	}
+
+	var buffer bytes.Buffer
+
+	if err := tmpl.Execute(&buffer, templateData); err != nil {
+		return nil, fmt.Errorf("executing template: %w", err)
+	}
+
+	body, err := format.Source(buffer.Bytes())
+
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated source:\n%s\n%w", buffer.String(), err)
+	}
+
+	return body, nil
+}
+
 func (m *migrator) generateTemplateData() (*templateData, error) {
+	if _, ok := m.Resource.Schema["id"]; ok {
+		m.warnf("Explicit `id` attribute defined")
+	} else {
+		m.Resource.Schema["id"] = &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: m.IsDataSource,
+			Computed: true,
+		}
+	}
+
+	modelNamePrefix := "resource" + m.Name
+	if m.IsDataSource {
+		modelNamePrefix = "dataSource" + m.Name
+	}
+
 	sbSchema := strings.Builder{}
 	sbStruct := strings.Builder{}
-	emitter := &emitter{
-		Generator:    m.Generator,
-		IsDataSource: m.IsDataSource,
-		SchemaWriter: &sbSchema,
-		StructWriter: &sbStruct,
+	sbModels := strings.Builder{}
+	e := &emitter{
+		Generator:       m.Generator,
+		IsDataSource:    m.IsDataSource,
+		ModelNamePrefix: modelNamePrefix,
+		ModelsWriter:    &sbModels,
+		SchemaWriter:    &sbSchema,
+		StructWriter:    &sbStruct,
 	}
 
-	err := emitter.emitSchemaForResource(m.Resource)
+	err := e.emitSchemaForResource(m.Resource)
 
 	if err != nil {
 		return nil, fmt.Errorf("emitting schema code: %w", err)
 	}
 
 	templateData := &templateData{
-		DefaultCreateTimeout:         emitter.DefaultCreateTimeout,
-		DefaultReadTimeout:           emitter.DefaultReadTimeout,
-		DefaultUpdateTimeout:         emitter.DefaultUpdateTimeout,
-		DefaultDeleteTimeout:         emitter.DefaultDeleteTimeout,
-		EmitResourceImportState:      m.Resource.Importer != nil,
-		EmitResourceModifyPlan:       !m.IsDataSource && emitter.HasTopLevelTagsAllMap && emitter.HasTopLevelTagsMap,
+		DefaultCreateTimeout:         e.DefaultCreateTimeout,
+		DefaultReadTimeout:           e.DefaultReadTimeout,
+		DefaultUpdateTimeout:         e.DefaultUpdateTimeout,
+		DefaultDeleteTimeout:         e.DefaultDeleteTimeout,
+		EmitResourceModifyPlan:       !m.IsDataSource && e.HasTopLevelTagsAllMap && e.HasTopLevelTagsMap,
 		EmitResourceUpdateSkeleton:   m.Resource.Update != nil || m.Resource.UpdateContext != nil || m.Resource.UpdateWithoutTimeout != nil,
-		HasTimeouts:                  emitter.HasTimeouts,
-		ImportFrameworkAttr:          emitter.ImportFrameworkAttr,
-		ImportProviderFrameworkTypes: emitter.ImportProviderFrameworkTypes,
+		HasTimeouts:                  e.HasTimeouts,
+		ImportFrameworkAttr:          e.ImportFrameworkAttr,
+		ImportProviderFrameworkTypes: e.ImportProviderFrameworkTypes,
+		Models:                       sbModels.String(),
 		Name:                         m.Name,
 		PackageName:                  m.PackageName,
 		Schema:                       sbSchema.String(),
@@ -163,19 +217,61 @@ func (m *migrator) generateTemplateData() (*templateData, error) {
 		TFTypeName:                   m.TFTypeName,
 	}
 
-	for _, v := range emitter.FrameworkPlanModifierPackages {
-		if !slices.Contains(templateData.FrameworkPlanModifierPackages, v) {
-			templateData.FrameworkPlanModifierPackages = append(templateData.FrameworkPlanModifierPackages, v)
+	if importer := m.Resource.Importer; importer != nil {
+		if importer.StateContext != nil && reflect.ValueOf(importer.StateContext).Pointer() == reflect.ValueOf(schema.ImportStatePassthroughContext).Pointer() {
+			templateData.EmitResourceWithImportByID = true
+		} else {
+			templateData.EmitResourceImportState = true
 		}
 	}
-	for _, v := range emitter.FrameworkValidatorsPackages {
-		if !slices.Contains(templateData.FrameworkValidatorsPackages, v) {
-			templateData.FrameworkValidatorsPackages = append(templateData.FrameworkValidatorsPackages, v)
+
+	emitters := []*emitter{e}
+
+	if !m.IsDataSource {
+		// Emit the Plugin Framework equivalent of the Plugin SDKv2 schema for use as the state upgrader's prior schema.
+		sbSDKv2Schema := strings.Builder{}
+		priorSchemaEmitter := &emitter{
+			Generator:     m.Generator,
+			IsPriorSchema: true,
+			ModelsWriter:  io.Discard,
+			SchemaWriter:  &sbSDKv2Schema,
+			StructWriter:  io.Discard,
 		}
+
+		err := priorSchemaEmitter.emitSchemaForResource(m.Resource)
+
+		if err != nil {
+			return nil, fmt.Errorf("emitting Plugin SDKv2 schema code: %w", err)
+		}
+
+		templateData.EmitResourceUpgradeState = true
+		templateData.ImportFrameworkAttr = templateData.ImportFrameworkAttr || priorSchemaEmitter.ImportFrameworkAttr
+		templateData.SDKv2Schema = sbSDKv2Schema.String()
+		templateData.SDKv2SchemaVersion = m.Resource.SchemaVersion
+		templateData.SDKv2StateUpgraders = len(m.Resource.StateUpgraders)
+
+		if n := templateData.SDKv2StateUpgraders; n > 0 {
+			m.warnf("Resource has %d Plugin SDKv2 state upgrader(s); state older than version %d is not upgraded", n, m.Resource.SchemaVersion)
+		}
+
+		emitters = append(emitters, priorSchemaEmitter)
 	}
-	for _, v := range emitter.GoImports {
-		if !slices.Contains(templateData.GoImports, v) {
-			templateData.GoImports = append(templateData.GoImports, v)
+
+	for _, e := range emitters {
+		for _, v := range e.FrameworkPlanModifierPackages {
+			if !slices.Contains(templateData.FrameworkPlanModifierPackages, v) {
+				templateData.FrameworkPlanModifierPackages = append(templateData.FrameworkPlanModifierPackages, v)
+			}
+		}
+		for _, v := range e.FrameworkValidatorsPackages {
+			if !slices.Contains(templateData.FrameworkValidatorsPackages, v) {
+				templateData.FrameworkValidatorsPackages = append(templateData.FrameworkValidatorsPackages, v)
+			}
+		}
+		for _, v := range e.GoImports {
+			if !slices.Contains(templateData.GoImports, v) {
+				templateData.GoImports = append(templateData.GoImports, v)
+			}
 		}
 	}
 
@@ -186,6 +282,10 @@ func (m *migrator) infof(format string, a ...interface{}) {
 	m.Generator.Infof(format, a...)
 }
 
+func (m *migrator) warnf(format string, a ...interface{}) {
+	m.Generator.Warnf(format, a...)
+}
+
 type emitter struct {
 	DefaultCreateTimeout          int64
 	DefaultReadTimeout            int64
@@ -201,22 +301,15 @@ type emitter struct {
 	ImportFrameworkAttr           bool
 	ImportProviderFrameworkTypes  bool
 	IsDataSource                  bool
+	IsPriorSchema                 bool   // Emit only the structure of the Plugin SDKv2 schema, for use as a state upgrader's prior schema.
+	ModelNamePrefix               string // e.g. resourceInstance
+	ModelsWriter                  io.Writer
 	SchemaWriter                  io.Writer
 	StructWriter                  io.Writer
 }
 
 // emitSchemaForResource generates the Plugin Framework code for a Plugin SDK Resource and emits the generated code to the emitter's Writer.
 func (e *emitter) emitSchemaForResource(resource *schema.Resource) error {
-	if _, ok := resource.Schema["id"]; ok {
-		e.warnf("Explicit `id` attribute defined")
-	} else {
-		resource.Schema["id"] = &schema.Schema{
-			Type:     schema.TypeString,
-			Optional: e.IsDataSource,
-			Computed: true,
-		}
-	}
-
 	if v := resource.Timeouts; v != nil {
 		e.HasTimeouts = true
 
@@ -236,22 +329,30 @@ func (e *emitter) emitSchemaForResource(resource *schema.Resource) error {
 
 	fprintf(e.SchemaWriter, "schema.Schema{\n")
 
-	err := e.emitAttributesAndBlocks(nil, resource.Schema)
+	err := e.emitAttributesAndBlocks(nil, resource.Schema, e.StructWriter)
 
 	if err != nil {
 		return err
 	}
 
-	if version := resource.SchemaVersion; version > 0 {
+	version := int64(resource.SchemaVersion)
+	if !e.IsDataSource && !e.IsPriorSchema {
+		// The Plugin Framework resource's schema version is one more than the Plugin SDKv2 resource's
+		// so that prior state is upgraded.
+		version++
+	}
+	if version > 0 {
 		fprintf(e.SchemaWriter, "Version:%d,\n", version)
 	}
 
-	if description := resource.Description; description != "" {
-		fprintf(e.SchemaWriter, "Description:%q,\n", description)
-	}
+	if !e.IsPriorSchema {
+		if description := resource.Description; description != "" {
+			fprintf(e.SchemaWriter, "Description:%q,\n", description)
+		}
 
-	if deprecationMessage := resource.DeprecationMessage; deprecationMessage != "" {
-		fprintf(e.SchemaWriter, "DeprecationMessage:%q,\n", deprecationMessage)
+		if deprecationMessage := resource.DeprecationMessage; deprecationMessage != "" {
+			fprintf(e.SchemaWriter, "DeprecationMessage:%q,\n", deprecationMessage)
+		}
 	}
 
 	fprintf(e.SchemaWriter, "}")
@@ -260,11 +361,9 @@ func (e *emitter) emitSchemaForResource(resource *schema.Resource) error {
 }
 
 // emitAttributesAndBlocks generates the Plugin Framework code for a set of Plugin SDK Attributes and Blocks
-// and emits the generated code to the emitter's Writer.
+// and emits the generated code to the emitter's Writer and the corresponding model struct fields to structWriter.
 // Property names are sorted prior to code generation to reduce diffs.
-func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*schema.Schema) error {
-	isTopLevelAttribute := len(path) == 0
-
+func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*schema.Schema, structWriter io.Writer) error {
 	// At this point we are emitting code for a schema.Block or Schema.
 	names := make([]string, 0)
 	for name := range schema {
@@ -286,21 +385,15 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 		}
 
 		fprintf(e.SchemaWriter, "%q:", name)
+		fprintf(structWriter, "%s ", naming.ToCamelCase(name))
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "%s ", naming.ToCamelCase(name))
-		}
-
-		err := e.emitAttributeProperty(append(path, name), property)
+		err := e.emitAttributeProperty(append(path, name), property, structWriter)
 
 		if err != nil {
 			return err
 		}
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, " `tfsdk:%q`\n", name)
-		}
-
+		fprintf(structWriter, " `tfsdk:%q`\n", name)
 		fprintf(e.SchemaWriter, ",\n")
 	}
 	if emittedFieldName {
@@ -321,13 +414,15 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 		}
 
 		fprintf(e.SchemaWriter, "%q:", name)
+		fprintf(structWriter, "%s ", naming.ToCamelCase(name))
 
-		err := e.emitBlockProperty(append(path, name), property)
+		err := e.emitBlockProperty(append(path, name), property, structWriter)
 
 		if err != nil {
 			return err
 		}
 
+		fprintf(structWriter, " `tfsdk:%q`\n", name)
 		fprintf(e.SchemaWriter, ",\n")
 	}
 	if emittedFieldName {
@@ -338,13 +433,13 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 }
 
 // emitAttributeProperty generates the Plugin Framework code for a Plugin SDK Attribute's property
-// and emits the generated code to the emitter's Writer.
-func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema) error {
+// and emits the generated code to the emitter's Writer and the model struct field's type to structWriter.
+func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema, structWriter io.Writer) error {
 	attributeName := path[len(path)-1]
 	isComputedOnly := property.Computed && !property.Optional
 	isTopLevelAttribute := len(path) == 1
 	var planModifiers []string
-	var defaultSpec string
+	var defaultSpec, fieldType string
 	var fwPlanModifierPackage, fwPlanModifierType, fwValidatorsPackage, fwValidatorType string
 
 	// At this point we are emitting code for the values of a schema.Schema's Attributes (map[string]schema.Attribute).
@@ -354,55 +449,40 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 	//
 	case schema.TypeBool:
 		fprintf(e.SchemaWriter, "schema.BoolAttribute{\n")
-
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Bool")
-		}
+		fieldType = "types.Bool"
 
 		fwPlanModifierPackage = "boolplanmodifier"
 		fwPlanModifierType = "Bool"
 
 	case schema.TypeFloat:
 		fprintf(e.SchemaWriter, "schema.Float64Attribute{\n")
-
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Float64")
-		}
+		fieldType = "types.Float64"
 
 		fwPlanModifierPackage = "float64planmodifier"
 		fwPlanModifierType = "Float64"
 
 	case schema.TypeInt:
 		fprintf(e.SchemaWriter, "schema.Int64Attribute{\n")
-
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Int64")
-		}
+		fieldType = "types.Int64"
 
 		fwPlanModifierPackage = "int64planmodifier"
 		fwPlanModifierType = "Int64"
 
 	case schema.TypeString:
 		// Computed-only ARN attributes are easiest handled as strings.
-		if (attributeName == "arn" || strings.HasSuffix(attributeName, "_arn")) && !isComputedOnly {
+		if (attributeName == "arn" || strings.HasSuffix(attributeName, "_arn")) && !isComputedOnly && !e.IsPriorSchema {
 			e.ImportProviderFrameworkTypes = true
 
 			fprintf(e.SchemaWriter, "schema.StringAttribute{\n")
 			fprintf(e.SchemaWriter, "CustomType:fwtypes.ARNType,\n")
-
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "fwtypes.ARN")
-			}
+			fieldType = "fwtypes.ARN"
 		} else {
-			if isTopLevelAttribute && attributeName == "id" {
+			if isTopLevelAttribute && attributeName == "id" && !e.IsPriorSchema {
 				fprintf(e.SchemaWriter, "// TODO framework.IDAttribute()\n")
 			}
 
 			fprintf(e.SchemaWriter, "schema.StringAttribute{\n")
-
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.String")
-			}
+			fieldType = "types.String"
 		}
 
 		fwPlanModifierPackage = "stringplanmodifier"
@@ -418,10 +498,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 		case schema.TypeList:
 			aggregateSchemaFactory = "schema.ListAttribute{"
 			typeName = "list"
-
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.List")
-			}
+			fieldType = "types.List"
 
 			fwPlanModifierPackage = "listplanmodifier"
 			fwPlanModifierType = "List"
@@ -431,10 +508,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 		case schema.TypeMap:
 			aggregateSchemaFactory = "schema.MapAttribute{"
 			typeName = "map"
-
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.Map")
-			}
+			fieldType = "types.Map"
 
 			fwPlanModifierPackage = "mapplanmodifier"
 			fwPlanModifierType = "Map"
@@ -444,10 +518,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 		case schema.TypeSet:
 			aggregateSchemaFactory = "schema.SetAttribute{"
 			typeName = "set"
-
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.Set")
-			}
+			fieldType = "types.Set"
 
 			fwPlanModifierPackage = "setplanmodifier"
 			fwPlanModifierType = "Set"
@@ -472,7 +543,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			case schema.TypeString:
 				elementType = "types.StringType"
 				// Special handling for 'tags' and 'tags_all'.
-				if typeName == "map" && isTopLevelAttribute {
+				if typeName == "map" && isTopLevelAttribute && !e.IsPriorSchema {
 					if attributeName == "tags" {
 						e.HasTopLevelTagsMap = true
 						if property.Optional {
@@ -491,18 +562,53 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			}
 
 			fprintf(e.SchemaWriter, "%s\n", aggregateSchemaFactory)
+
+			// Collections of strings are handled with the provider's custom types.
+			if elementType == "types.StringType" && !e.IsPriorSchema {
+				e.ImportProviderFrameworkTypes = true
+
+				switch typeName {
+				case "list":
+					fprintf(e.SchemaWriter, "CustomType:fwtypes.ListOfStringType,\n")
+					fieldType = "fwtypes.ListValueOf[types.String]"
+				case "map":
+					fprintf(e.SchemaWriter, "CustomType:fwtypes.MapOfStringType,\n")
+					fieldType = "fwtypes.MapOfString"
+				case "set":
+					fprintf(e.SchemaWriter, "CustomType:fwtypes.SetOfStringType,\n")
+					fieldType = "fwtypes.SetOfString"
+				}
+			}
+
 			fprintf(e.SchemaWriter, "ElementType:%s,\n", elementType)
 
 		case *schema.Resource:
 			// We get here for Computed-only nested blocks or when ConfigMode is SchemaConfigModeBlock.
 			fprintf(e.SchemaWriter, "%s\n", aggregateSchemaFactory)
-			fprintf(e.SchemaWriter, "ElementType:")
 
-			if err := e.emitComputedOnlyBlock(path, v.Schema); err != nil {
-				return err
-			}
+			if typeName != "map" && !e.IsPriorSchema {
+				e.ImportProviderFrameworkTypes = true
 
-			fprintf(e.SchemaWriter, ",\n")
+				modelName := e.modelName(path)
+				nestedObjectTypeName := naming.ToCamelCase(typeName) + "NestedObject"
+				fprintf(e.SchemaWriter, "CustomType:fwtypes.New%sTypeOf[%s](ctx),\n", nestedObjectTypeName, modelName)
+				fprintf(e.SchemaWriter, "ElementType:types.ObjectType{\n")
+				fprintf(e.SchemaWriter, "AttrTypes:fwtypes.AttributeTypesMust[%s](ctx),\n", modelName)
+				fprintf(e.SchemaWriter, "},\n")
+				fieldType = fmt.Sprintf("fwtypes.%sValueOf[%s]", nestedObjectTypeName, modelName)
+
+				if err := e.emitComputedOnlyModel(path, v.Schema); err != nil {
+					return err
+				}
+			} else {
+				fprintf(e.SchemaWriter, "ElementType:")
+
+				if err := e.emitComputedOnlyBlock(path, v.Schema); err != nil {
+					return err
+				}
+
+				fprintf(e.SchemaWriter, ",\n")
+			}
 
 		default:
 			return unsupportedTypeError(path, fmt.Sprintf("(Attribute) %s of %T", typeName, v))
@@ -512,6 +618,8 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 		return unsupportedTypeError(path, v.String())
 	}
 
+	fprintf(structWriter, "%s", fieldType)
+
 	if property.Required {
 		fprintf(e.SchemaWriter, "Required:true,\n")
 	}
@@ -532,6 +640,13 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 		fprintf(e.SchemaWriter, "Sensitive:true,\n")
 	}
 
+	// A prior schema only describes the structure of state.
+	if e.IsPriorSchema {
+		fprintf(e.SchemaWriter, "}")
+
+		return nil
+	}
+
 	if description := property.Description; description != "" {
 		fprintf(e.SchemaWriter, "Description:%q,\n", description)
 	}
@@ -614,8 +729,9 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 }
 
 // emitBlockProperty generates the Plugin Framework code for a Plugin SDK Block's property
-// and emits the generated code to the emitter's Writer.
-func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) error {
+// and emits the generated code to the emitter's Writer and the model struct field's type to structWriter.
+// The block's nested model struct is emitted to the emitter's ModelsWriter.
+func (e *emitter) emitBlockProperty(path []string, property *schema.Schema, structWriter io.Writer) error {
 	var planModifiers []string
 	var fwPlanModifierPackage, fwPlanModifierType, fwValidatorsPackage, fwValidatorType string
 
@@ -624,50 +740,50 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 	//
 	// Complex types.
 	//
-	case schema.TypeList:
-		switch v := property.Elem.(type) {
-		case *schema.Resource:
+	case schema.TypeList, schema.TypeSet:
+		var blockType string
+
+		switch v {
+		case schema.TypeList:
+			blockType = "List"
 			fwPlanModifierPackage = "listplanmodifier"
-			fwPlanModifierType = "List"
 			fwValidatorsPackage = "listvalidator"
-			fwValidatorType = "List"
-
-			fprintf(e.SchemaWriter, "schema.ListNestedBlock{\n")
-			fprintf(e.SchemaWriter, "NestedObject:schema.NestedBlockObject{\n")
-
-			err := e.emitAttributesAndBlocks(path, v.Schema)
-
-			if err != nil {
-				return err
-			}
-
-			fprintf(e.SchemaWriter, "},\n")
-
-		default:
-			return unsupportedTypeError(path, fmt.Sprintf("(Block) list of %T", v))
+		case schema.TypeSet:
+			blockType = "Set"
+			fwPlanModifierPackage = "setplanmodifier"
+			fwValidatorsPackage = "setvalidator"
 		}
+		fwPlanModifierType = blockType
+		fwValidatorType = blockType
 
-	case schema.TypeSet:
 		switch v := property.Elem.(type) {
 		case *schema.Resource:
-			fwPlanModifierPackage = "setplanmodifier"
-			fwPlanModifierType = "Set"
-			fwValidatorsPackage = "setvalidator"
-			fwValidatorType = "Set"
+			modelName := e.modelName(path)
+
+			fprintf(e.SchemaWriter, "schema.%sNestedBlock{\n", blockType)
+
+			if !e.IsPriorSchema {
+				e.ImportProviderFrameworkTypes = true
+
+				fprintf(e.SchemaWriter, "CustomType:fwtypes.New%sNestedObjectTypeOf[%s](ctx),\n", blockType, modelName)
+			}
 
-			fprintf(e.SchemaWriter, "schema.SetNestedBlock{\n")
 			fprintf(e.SchemaWriter, "NestedObject:schema.NestedBlockObject{\n")
 
-			err := e.emitAttributesAndBlocks(path, v.Schema)
+			sbStruct := strings.Builder{}
+
+			err := e.emitAttributesAndBlocks(path, v.Schema, &sbStruct)
 
 			if err != nil {
 				return err
 			}
 
 			fprintf(e.SchemaWriter, "},\n")
+			fprintf(structWriter, "fwtypes.%sNestedObjectValueOf[%s]", blockType, modelName)
+			fprintf(e.ModelsWriter, "type %s struct {\n%s}\n\n", modelName, sbStruct.String())
 
 		default:
-			return unsupportedTypeError(path, fmt.Sprintf("(Block) set of %T", v))
+			return unsupportedTypeError(path, fmt.Sprintf("(Block) %s of %T", strings.ToLower(blockType), v))
 		}
 
 	default:
@@ -687,6 +803,13 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 		property.MinItems = 0
 	}
 
+	// A prior schema only describes the structure of state.
+	if e.IsPriorSchema {
+		fprintf(e.SchemaWriter, "}")
+
+		return nil
+	}
+
 	if description := property.Description; description != "" {
 		fprintf(e.SchemaWriter, "Description:%q,\n", description)
 	}
@@ -853,6 +976,113 @@ func (e *emitter) emitComputedOnlyBlockProperty(path []string, property *schema.
 	return nil
 }
 
+// emitComputedOnlyModel generates the model struct for a Plugin SDK Computed-only nested block
+// and emits the generated code to the emitter's ModelsWriter.
+// Field types must fully describe the attribute types as the nested block's element type is derived from the model.
+// Property names are sorted prior to code generation to reduce diffs.
+func (e *emitter) emitComputedOnlyModel(path []string, schema map[string]*schema.Schema) error {
+	names := make([]string, 0)
+	for name := range schema {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	sbStruct := strings.Builder{}
+
+	for _, name := range names {
+		property := schema[name]
+
+		fieldType, err := e.computedOnlyModelFieldType(append(path, name), property)
+
+		if err != nil {
+			return err
+		}
+
+		fprintf(&sbStruct, "%s %s `tfsdk:%q`\n", naming.ToCamelCase(name), fieldType, name)
+	}
+
+	fprintf(e.ModelsWriter, "type %s struct {\n%s}\n\n", e.modelName(path), sbStruct.String())
+
+	return nil
+}
+
+// computedOnlyModelFieldType returns the model struct field type for a Plugin SDK Computed-only nested block's property.
+func (e *emitter) computedOnlyModelFieldType(path []string, property *schema.Schema) (string, error) {
+	switch v := property.Type; v {
+	//
+	// Primitive types.
+	//
+	case schema.TypeBool:
+		return "types.Bool", nil
+
+	case schema.TypeFloat:
+		return "types.Float64", nil
+
+	case schema.TypeInt:
+		return "types.Int64", nil
+
+	case schema.TypeString:
+		return "types.String", nil
+
+	//
+	// Complex types.
+	//
+	case schema.TypeList, schema.TypeMap, schema.TypeSet:
+		var typeName string
+
+		switch v {
+		case schema.TypeList:
+			typeName = "List"
+		case schema.TypeMap:
+			typeName = "Map"
+		case schema.TypeSet:
+			typeName = "Set"
+		}
+
+		switch v := property.Elem.(type) {
+		case *schema.Schema:
+			switch v := v.Type; v {
+			case schema.TypeBool:
+				return fmt.Sprintf("fwtypes.%sValueOf[types.Bool]", typeName), nil
+
+			case schema.TypeFloat:
+				return fmt.Sprintf("fwtypes.%sValueOf[types.Float64]", typeName), nil
+
+			case schema.TypeInt:
+				return fmt.Sprintf("fwtypes.%sValueOf[types.Int64]", typeName), nil
+
+			case schema.TypeString:
+				return fmt.Sprintf("fwtypes.%sValueOf[types.String]", typeName), nil
+
+			default:
+				return "", unsupportedTypeError(path, fmt.Sprintf("(ComputedOnlyModelField) %s of %s", typeName, v.String()))
+			}
+
+		case *schema.Resource:
+			if typeName == "Map" {
+				return "", unsupportedTypeError(path, fmt.Sprintf("(ComputedOnlyModelField) %s of %T", typeName, v))
+			}
+
+			if err := e.emitComputedOnlyModel(path, v.Schema); err != nil {
+				return "", err
+			}
+
+			return fmt.Sprintf("fwtypes.%sNestedObjectValueOf[%s]", typeName, e.modelName(path)), nil
+
+		default:
+			return "", unsupportedTypeError(path, fmt.Sprintf("(ComputedOnlyModelField) %s of %T", typeName, v))
+		}
+
+	default:
+		return "", unsupportedTypeError(path, v.String())
+	}
+}
+
+// modelName returns the name of the model struct for the nested block at the specified path.
+func (e *emitter) modelName(path []string) string {
+	return e.ModelNamePrefix + naming.ToCamelCase(strings.Join(path, "_")) + "Model"
+}
+
 // warnf emits a formatted warning message to the UI.
 func (e *emitter) warnf(format string, a ...interface{}) {
 	e.Generator.Warnf(format, a...)
@@ -900,6 +1130,25 @@ func unsupportedTypeError(path []string, typ string) error {
 	return fmt.Errorf("%s is of unsupported type: %s", strings.Join(path, "/"), typ)
 }
 
+// durationExpr returns a Go expression for the specified duration in nanoseconds, e.g. `20 * time.Minute`.
+func durationExpr(d int64) string {
+	for _, v := range []struct {
+		unit time.Duration
+		name string
+	}{
+		{time.Hour, "time.Hour"},
+		{time.Minute, "time.Minute"},
+		{time.Second, "time.Second"},
+		{time.Millisecond, "time.Millisecond"},
+	} {
+		if d%int64(v.unit) == 0 {
+			return fmt.Sprintf("%d * %s", d/int64(v.unit), v.name)
+		}
+	}
+
+	return fmt.Sprintf("%d * time.Nanosecond", d)
+}
+
 type templateData struct {
 	DefaultCreateTimeout          int64
 	DefaultReadTimeout            int64
@@ -908,15 +1157,21 @@ type templateData struct {
 	EmitResourceImportState       bool
 	EmitResourceModifyPlan        bool
 	EmitResourceUpdateSkeleton    bool
+	EmitResourceUpgradeState      bool
+	EmitResourceWithImportByID    bool
 	FrameworkPlanModifierPackages []string
 	FrameworkValidatorsPackages   []string
 	GoImports                     []goImport
 	HasTimeouts                   bool
 	ImportFrameworkAttr           bool
 	ImportProviderFrameworkTypes  bool
+	Models                        string // Nested model structs.
 	Name                          string // e.g. Instance
 	PackageName                   string // e.g. ec2
 	Schema                        string
+	SDKv2Schema                   string // Plugin Framework equivalent of the Plugin SDKv2 schema.
+	SDKv2SchemaVersion            int
+	SDKv2StateUpgraders           int // Number of Plugin SDKv2 state upgraders.
 	Struct                        string
 	TFTypeName                    string // e.g. aws_instance
 }
@@ -0,0 +1,272 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+func TestMigratorGolden(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		isDataSource bool
+		resource     func() *schema.Resource
+	}{
+		"resource_basic": {
+			resource: func() *schema.Resource {
+				return &schema.Resource{
+					CreateWithoutTimeout: noopContext,
+					ReadWithoutTimeout:   noopContext,
+					DeleteWithoutTimeout: noopContext,
+
+					Importer: &schema.ResourceImporter{
+						StateContext: schema.ImportStatePassthroughContext,
+					},
+
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"role_arn": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"subnet_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"tags": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"tags_all": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				}
+			},
+		},
+		"resource_blocks": {
+			resource: func() *schema.Resource {
+				return &schema.Resource{
+					CreateWithoutTimeout: noopContext,
+					ReadWithoutTimeout:   noopContext,
+					UpdateWithoutTimeout: noopContext,
+					DeleteWithoutTimeout: noopContext,
+
+					Importer: &schema.ResourceImporter{
+						StateContext: func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+							return []*schema.ResourceData{d}, nil
+						},
+					},
+
+					SchemaVersion: 1,
+					StateUpgraders: []schema.StateUpgrader{
+						{
+							Version: 0,
+						},
+					},
+
+					Timeouts: &schema.ResourceTimeout{
+						Create: schema.DefaultTimeout(20 * time.Minute),
+						Delete: schema.DefaultTimeout(90 * time.Second),
+					},
+
+					Schema: map[string]*schema.Schema{
+						"endpoint": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"address": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"ports": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeInt},
+									},
+								},
+							},
+						},
+						"logging": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"prefix": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"rule": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"filter": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"prefixes": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+									"priority": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				}
+			},
+		},
+		"data_source_basic": {
+			isDataSource: true,
+			resource: func() *schema.Resource {
+				return &schema.Resource{
+					ReadWithoutTimeout: noopContext,
+
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"versions": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"created": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"labels": {
+										Type:     schema.TypeMap,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				}
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			m := &migrator{
+				Generator:    common.NewGenerator(),
+				IsDataSource: testCase.isDataSource,
+				Name:         "Example",
+				PackageName:  "example",
+				Resource:     testCase.resource(),
+				Template:     resourceImpl,
+				TFTypeName:   "aws_example",
+			}
+			if testCase.isDataSource {
+				m.Template = datasourceImpl
+			}
+
+			got, err := m.render()
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			goldenFile := filepath.Join("testdata", name+".golden")
+
+			if *update {
+				if err := os.WriteFile(goldenFile, got, 0644); err != nil {
+					t.Fatalf("writing %s: %s", goldenFile, err)
+				}
+			}
+
+			want, err := os.ReadFile(goldenFile)
+
+			if err != nil {
+				t.Fatalf("reading %s: %s", goldenFile, err)
+			}
+
+			if diff := cmp.Diff(string(got), string(want)); diff != "" {
+				t.Errorf("unexpected diff (+want, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestDurationExpr(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		duration time.Duration
+		expected string
+	}{
+		{duration: 2 * time.Hour, expected: "2 * time.Hour"},
+		{duration: 90 * time.Minute, expected: "90 * time.Minute"},
+		{duration: 90 * time.Second, expected: "90 * time.Second"},
+		{duration: 1500 * time.Millisecond, expected: "1500 * time.Millisecond"},
+		{duration: 7, expected: "7 * time.Nanosecond"},
+	}
+
+	for _, testCase := range testCases {
+		if got, want := durationExpr(int64(testCase.duration)), testCase.expected; got != want {
+			t.Errorf("durationExpr(%s) = %q, want %q", testCase.duration, got, want)
+		}
+	}
+}
+
+func noopContext(context.Context, *schema.ResourceData, any) diag.Diagnostics {
+	return nil
+}